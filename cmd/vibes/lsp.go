@@ -30,28 +30,53 @@ var jsonNull = json.RawMessage("null")
 var lspKeywords = ast.Keywords()
 
 var lspBuiltins = []string{
+	"abs",
 	"assert",
+	"benchmark",
+	"bigint",
+	"blank?",
+	"capabilities",
+	"ceil",
+	"clamp",
+	"env",
+	"floor",
 	"format",
+	"locals",
 	"loop",
+	"max",
+	"memoize",
+	"min",
 	"money",
 	"money_cents",
 	"now",
 	"p",
+	"pluralize",
+	"pp",
+	"present?",
 	"print",
 	"puts",
 	"rand",
 	"random_id",
 	"require",
+	"round",
+	"sign",
 	"sleep",
 	"sprintf",
 	"srand",
 	"to_float",
 	"to_int",
+	"to_table",
 	"uuid",
 	"warn",
+	"with_timeout",
+	"Array",
+	"Digest",
+	"Float",
 	"Hash",
+	"Integer",
 	"JSON",
 	"Regex",
+	"String",
 	"Time",
 }
 
@@ -1254,25 +1279,48 @@ func appendAssignmentTargetNames(names *[]string, target ast.Expression) {
 // signatures. Entries are validated against the engine's registered
 // builtins by tests so the table cannot go stale against renames.
 var builtinSignatures = map[string]string{
-	"assert":      "assert(condition, message = nil) -> nil",
-	"format":      "format(format_string, *values) -> string",
-	"loop":        "loop { ... } -> value",
-	"money":       `money("12.34 USD") -> money`,
-	"money_cents": "money_cents(cents, currency) -> money",
-	"now":         "now -> string",
-	"p":           "p(*values) -> value",
-	"print":       "print(*values) -> nil",
-	"puts":        "puts(*values) -> nil",
-	"rand":        "rand(max = nil) -> number",
-	"random_id":   "random_id(length = 16) -> string",
-	"require":     `require(module, as: nil) -> object`,
-	"sleep":       "sleep(seconds) -> int",
-	"sprintf":     "sprintf(format_string, *values) -> string",
-	"srand":       "srand(seed = nil) -> int | nil",
-	"to_float":    "to_float(value) -> float",
-	"to_int":      "to_int(value) -> int",
-	"uuid":        "uuid -> string",
-	"warn":        "warn(*values) -> nil",
+	"abs":          "abs(number) -> int | float | money",
+	"assert":       "assert(condition, message = nil) -> nil",
+	"benchmark":    "benchmark { ... } -> hash",
+	"bigint":       "bigint(value) -> bigint",
+	"blank?":       "blank?(value) -> bool",
+	"capabilities": "capabilities -> array<string>",
+	"ceil":         "ceil(number, digits = 0) -> int | float",
+	"clamp":        "clamp(value, min, max) -> value",
+	"floor":        "floor(number, digits = 0) -> int | float",
+	"format":       "format(format_string, *values) -> string",
+	"locals":       "locals -> hash",
+	"loop":         "loop { ... } -> value",
+	"max":          "max(*values) -> value",
+	"memoize":      "memoize(key) { ... } -> value",
+	"min":          "min(*values) -> value",
+	"money":        `money("12.34 USD") -> money`,
+	"money_cents":  "money_cents(cents, currency) -> money",
+	"now":          "now -> string",
+	"p":            "p(*values) -> value",
+	"pluralize":    "pluralize(count, singular, plural = nil) -> string",
+	"pp":           "pp(*values) -> value",
+	"present?":     "present?(value) -> bool",
+	"print":        "print(*values) -> nil",
+	"puts":         "puts(*values) -> nil",
+	"rand":         "rand(max = nil) -> number",
+	"random_id":    "random_id(length = 16) -> string",
+	"require":      `require(module, as: nil) -> object`,
+	"round":        "round(number, digits = 0) -> int | float",
+	"sign":         "sign(number) -> int",
+	"sleep":        "sleep(seconds) -> int",
+	"sprintf":      "sprintf(format_string, *values) -> string",
+	"srand":        "srand(seed = nil) -> int | nil",
+	"to_float":     "to_float(value) -> float",
+	"to_int":       "to_int(value) -> int",
+	"to_table":     "to_table(rows, headers:) -> string",
+	"uuid":         "uuid(version: 7) -> string",
+	"warn":         "warn(*values) -> nil",
+	"with_timeout": "with_timeout(duration, steps: nil) { ... } -> value",
+	"Array":        "Array(value) -> array",
+	"Float":        "Float(value) -> float",
+	"Integer":      "Integer(value, base = nil) -> int",
+	"String":       "String(value) -> string",
 }
 
 // signatureHelpAt resolves the innermost call around the cursor and
@@ -1574,6 +1622,15 @@ func exactDefinitionLocation(program *ast.Program, uri string, sourceLines []str
 					return anchoredLocation(uri, sourceLines, method.Position, method.Name)
 				}
 			}
+		case *ast.ModuleStmt:
+			if st.Name == word {
+				return anchoredLocation(uri, sourceLines, st.Position, st.Name)
+			}
+			for _, method := range st.Methods {
+				if method.Name == word {
+					return anchoredLocation(uri, sourceLines, method.Position, method.Name)
+				}
+			}
 		case *ast.EnumStmt:
 			if st.Name == word {
 				return anchoredLocation(uri, sourceLines, st.Position, st.Name)
@@ -1751,6 +1808,12 @@ func documentSymbols(program *ast.Program, sourceLines []string) []lspDocumentSy
 				children = appendSymbol(children, "self."+method.Name, 6, method.Position, method.Name, nil)
 			}
 			symbols = appendSymbol(symbols, st.Name, 5, st.Position, st.Name, children)
+		case *ast.ModuleStmt:
+			children := make([]lspDocumentSymbol, 0, len(st.Methods))
+			for _, method := range st.Methods {
+				children = appendSymbol(children, method.Name, 6, method.Position, method.Name, nil)
+			}
+			symbols = appendSymbol(symbols, st.Name, 2, st.Position, st.Name, children)
 		case *ast.EnumStmt:
 			children := make([]lspDocumentSymbol, 0, len(st.Members))
 			for _, member := range st.Members {
@@ -122,6 +122,11 @@ func executeScript(ctx context.Context, inv runInvocation, out io.Writer) error
 	if err != nil {
 		return fmt.Errorf("read script: %w", err)
 	}
+	if inv.checkOnly {
+		if err := reportSyntaxIssues(out, inv.scriptPath, engine.Check(string(input))); err != nil {
+			return err
+		}
+	}
 	script, err := engine.CompileSnippet(string(input), scriptEntrypointFunction)
 	if err != nil {
 		return fmt.Errorf("compile failed: %w", err)
@@ -215,6 +220,25 @@ func evalSnippet(ctx context.Context, snippet string, modulePaths []string, chec
 	return printResult(out, result)
 }
 
+// reportSyntaxIssues prints each issue as path:line:column: message, the
+// same shape analyze uses, and fails when there is at least one.
+func reportSyntaxIssues(out io.Writer, scriptPath string, issues []vibes.ParseIssue) error {
+	if len(issues) == 0 {
+		return nil
+	}
+	for _, issue := range issues {
+		if issue.Pos.Line == 0 {
+			fmt.Fprintf(out, "%s: %s\n", scriptPath, issue.Message)
+			continue
+		}
+		fmt.Fprintf(out, "%s:%d:%d: %s\n", scriptPath, issue.Pos.Line, issue.Pos.Column, issue.Message)
+	}
+	if len(issues) == 1 {
+		return errors.New("check failed: 1 syntax error")
+	}
+	return fmt.Errorf("check failed: %d syntax errors", len(issues))
+}
+
 func stringArgs(raw []string) []value.Value {
 	out := make([]value.Value, len(raw))
 	for i, arg := range raw {
@@ -254,7 +278,7 @@ func printUsage() {
 	fmt.Fprintln(os.Stderr, "  -function string")
 	fmt.Fprintln(os.Stderr, "    function to invoke after compilation (default \"run\")")
 	fmt.Fprintln(os.Stderr, "  -check")
-	fmt.Fprintln(os.Stderr, "    only compile the script without executing")
+	fmt.Fprintln(os.Stderr, "    only compile the script without executing, listing every syntax error")
 	fmt.Fprintln(os.Stderr, "  -e <snippet>")
 	fmt.Fprintln(os.Stderr, "    evaluate an inline snippet instead of a script file")
 	fmt.Fprintln(os.Stderr, "  -watch")
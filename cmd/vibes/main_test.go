@@ -170,6 +170,38 @@ end
 	}
 }
 
+func TestRunCommandCheckReportsEverySyntaxError(t *testing.T) {
+	t.Parallel()
+	scriptPath := writeVibeScript(t, `def stats(values)
+  sum = )
+  values.size
+end
+
+def mean(values)
+  mean = ]
+  values.size
+end`)
+	out, err := captureStdout(t, func() error {
+		return runCommand([]string{"-check", scriptPath})
+	})
+	if err == nil || !strings.Contains(err.Error(), "check failed: 2 syntax errors") {
+		t.Fatalf("runCommand(-check) err = %v, want two syntax errors", err)
+	}
+	lines := strings.Split(strings.TrimSpace(out), "\n")
+	want := []string{
+		scriptPath + ":2:9: unexpected token \")\"",
+		scriptPath + ":7:10: unexpected token \"]\"",
+	}
+	if len(lines) != len(want) {
+		t.Fatalf("runCommand(-check) stdout = %q, want %d lines", out, len(want))
+	}
+	for i := range want {
+		if !strings.HasPrefix(lines[i], want[i]) {
+			t.Fatalf("stdout line %d = %q, want prefix %q", i, lines[i], want[i])
+		}
+	}
+}
+
 func TestRunCommandInlineEval(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
@@ -339,7 +371,7 @@ func TestRunCommandInlineEvalPreservesModuleRuntimeFrame(t *testing.T) {
 		t.Fatal("runCommand(-e module runtime error) err = nil, want runtime error")
 	}
 	msg := err.Error()
-	for _, want := range []string{"execution failed", "division by zero", "1 / 0", "at boom (2:"} {
+	for _, want := range []string{"execution failed", "division by zero", "1 / 0", "at helper.boom (2:"} {
 		if !strings.Contains(msg, want) {
 			t.Fatalf("runCommand(-e module runtime error) err = %q, want substring %q", msg, want)
 		}
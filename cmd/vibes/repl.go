@@ -139,21 +139,33 @@ var keys = keyMap{
 
 var replBuiltinCompletions = []string{
 	"assert",
+	"benchmark",
 	"money",
 	"money_cents",
+	"memoize",
 	"require",
 	"now",
 	"p",
+	"pluralize",
+	"pp",
 	"print",
 	"puts",
 	"uuid",
 	"random_id",
 	"to_int",
 	"to_float",
+	"to_table",
 	"warn",
+	"with_timeout",
+	"env",
+	"Array",
+	"Digest",
+	"Float",
 	"Hash",
+	"Integer",
 	"JSON",
 	"Regex",
+	"String",
 	"Time",
 }
 
@@ -194,10 +206,14 @@ var replCommandCompletions = []string{
 
 var replBuiltinFunctionNames = []string{
 	"assert",
+	"benchmark",
 	"money",
 	"money_cents",
+	"memoize",
 	"now",
 	"p",
+	"pluralize",
+	"pp",
 	"print",
 	"puts",
 	"require",
@@ -205,8 +221,18 @@ var replBuiltinFunctionNames = []string{
 	"random_id",
 	"to_int",
 	"to_float",
+	"to_table",
 	"warn",
+	"with_timeout",
+	"env.fetch",
+	"env.get",
+	"Array",
+	"Float",
+	"Integer",
+	"String",
+	"JSON.dig",
 	"JSON.parse",
+	"JSON.parse_lines",
 	"JSON.stringify",
 	"Regex.match",
 	"Regex.replace",
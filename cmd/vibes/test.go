@@ -2,7 +2,6 @@ package main
 
 import (
 	"context"
-	"errors"
 	"flag"
 	"fmt"
 	"io"
@@ -16,9 +15,6 @@ import (
 	"github.com/mgomes/vibescript/vibes"
 )
 
-// testFunctionPrefix marks the functions a *_test.vibe file exposes as tests.
-const testFunctionPrefix = "test_"
-
 func testCommand(args []string) error {
 	flags := flag.NewFlagSet("test", flag.ContinueOnError)
 	flags.SetOutput(new(flagErrorSink))
@@ -156,55 +152,29 @@ func runTestFile(ctx context.Context, file string, modulePaths pathList, filter
 		return summary
 	}
 
-	names := testFunctionNames(script, filter)
-	if len(names) == 0 {
+	var match func(string) bool
+	if filter != nil {
+		match = filter.MatchString
+	}
+	results := script.RunTestsMatching(ctx, match)
+	if len(results) == 0 {
 		fmt.Fprintf(out, "ok   %s (no test functions)\n", file)
 		return summary
 	}
 
-	for _, name := range names {
-		if err := runTestFunction(ctx, script, name); err != nil {
-			failTest(name, err)
+	for _, result := range results {
+		if !result.Passed {
+			failTest(result.Name, result.Err)
 			continue
 		}
 		summary.passed++
 	}
 	if summary.failed == 0 {
-		fmt.Fprintf(out, "ok   %s (%d test(s))\n", file, len(names))
+		fmt.Fprintf(out, "ok   %s (%d test(s))\n", file, len(results))
 	}
 	return summary
 }
 
-// testFunctionNames returns the script's test_ functions in deterministic
-// order, narrowed by the optional -run filter.
-func testFunctionNames(script *vibes.Script, filter *regexp.Regexp) []string {
-	var names []string
-	for _, fn := range script.Functions() {
-		if !strings.HasPrefix(fn.Name, testFunctionPrefix) {
-			continue
-		}
-		if filter != nil && !filter.MatchString(fn.Name) {
-			continue
-		}
-		names = append(names, fn.Name)
-	}
-	return names
-}
-
-func runTestFunction(ctx context.Context, script *vibes.Script, name string) error {
-	fn, ok := script.Function(name)
-	if !ok {
-		return fmt.Errorf("function %s not found", name)
-	}
-	for _, param := range fn.Params {
-		if (param.Kind == vibes.ParamNormal || param.Kind == vibes.ParamKeyword) && param.DefaultVal == nil {
-			return errors.New("test functions must not require parameters")
-		}
-	}
-	_, err := script.Call(ctx, name, nil, vibes.CallOptions{})
-	return err
-}
-
 func indentLines(text, prefix string) string {
 	lines := strings.Split(strings.TrimRight(text, "\n"), "\n")
 	for i, line := range lines {
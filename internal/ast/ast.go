@@ -9,9 +9,40 @@ import "github.com/mgomes/vibescript/vibes/source"
 // receive positions without importing the source package directly.
 type Position = source.Position
 
-// Node is the interface implemented by all AST nodes.
+// Node is the interface implemented by all AST nodes. Pos is the position
+// diagnostics point at: the start of most nodes, but the operator of a binary
+// expression. EndPos is the exclusive end of the node's source text, so the
+// full range of a binary expression runs from Left.Pos() to EndPos().
 type Node interface {
 	Pos() Position
+	EndPos() Position
+}
+
+// Span records the exclusive end of a node's source text. Node types embed
+// it next to their Position field and the parser stamps it once the node's
+// last token has been consumed.
+type Span struct {
+	EndPosition Position
+}
+
+// EndPos returns the exclusive end of the node, or the zero Position for
+// nodes the parser did not produce (such as ones synthesized by the runtime).
+func (s *Span) EndPos() Position { return s.EndPosition }
+
+// SetEndPos records pos as the node's end unless the node already ends
+// later. Keeping the furthest end lets a node that is re-stamped by an
+// enclosing construct, such as a grouped expression, widen but never shrink.
+func (s *Span) SetEndPos(pos Position) {
+	if positionBefore(s.EndPosition, pos) {
+		s.EndPosition = pos
+	}
+}
+
+func positionBefore(a, b Position) bool {
+	if a.Line != b.Line {
+		return a.Line < b.Line
+	}
+	return a.Column < b.Column
 }
 
 // Statement is the interface implemented by all statement AST nodes.
@@ -38,6 +69,13 @@ func (p *Program) Pos() Position {
 	return p.Statements[0].Pos()
 }
 
+func (p *Program) EndPos() Position {
+	if len(p.Statements) == 0 {
+		return Position{}
+	}
+	return p.Statements[len(p.Statements)-1].EndPos()
+}
+
 // ParamKind identifies how a function parameter receives values.
 type ParamKind int
 
@@ -83,6 +83,7 @@ func cloneStatement(stmt Statement) Statement {
 	case *RaiseStmt:
 		clone := *s
 		clone.Value = cloneExpression(s.Value)
+		clone.Message = cloneExpression(s.Message)
 		return &clone
 	case *AssignStmt:
 		clone := *s
@@ -117,6 +118,9 @@ func cloneStatement(stmt Statement) Statement {
 	case *NextStmt:
 		clone := *s
 		return &clone
+	case *RetryStmt:
+		clone := *s
+		return &clone
 	case *TryStmt:
 		clone := *s
 		clone.Body = cloneStatements(s.Body)
@@ -131,6 +135,11 @@ func cloneStatement(stmt Statement) Statement {
 		clone.ClassMethods = cloneFunctionStmts(s.ClassMethods)
 		clone.Properties = clonePropertyDecls(s.Properties)
 		clone.Body = cloneStatements(s.Body)
+		clone.Includes = append([]string(nil), s.Includes...)
+		return &clone
+	case *ModuleStmt:
+		clone := *s
+		clone.Methods = cloneFunctionStmts(s.Methods)
 		return &clone
 	case *EnumStmt:
 		clone := *s
@@ -307,6 +316,9 @@ func cloneExpression(expr Expression) Expression {
 		clone := *e
 		clone.Args = cloneExpressions(e.Args)
 		return &clone
+	case *SuperExpr:
+		clone := *e
+		return &clone
 	case *InterpolatedString:
 		clone := *e
 		clone.Parts = cloneStringParts(e.Parts)
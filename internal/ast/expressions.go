@@ -4,6 +4,7 @@ package ast
 type Identifier struct {
 	Name     string
 	Position Position
+	Span
 }
 
 func (e *Identifier) exprNode()     {}
@@ -13,6 +14,7 @@ func (e *Identifier) Pos() Position { return e.Position }
 type IntegerLiteral struct {
 	Value    int64
 	Position Position
+	Span
 }
 
 func (e *IntegerLiteral) exprNode()     {}
@@ -22,6 +24,7 @@ func (e *IntegerLiteral) Pos() Position { return e.Position }
 type FloatLiteral struct {
 	Value    float64
 	Position Position
+	Span
 }
 
 func (e *FloatLiteral) exprNode()     {}
@@ -31,6 +34,7 @@ func (e *FloatLiteral) Pos() Position { return e.Position }
 type StringLiteral struct {
 	Value    string
 	Position Position
+	Span
 }
 
 func (e *StringLiteral) exprNode()     {}
@@ -40,6 +44,7 @@ func (e *StringLiteral) Pos() Position { return e.Position }
 type BoolLiteral struct {
 	Value    bool
 	Position Position
+	Span
 }
 
 func (e *BoolLiteral) exprNode()     {}
@@ -48,6 +53,7 @@ func (e *BoolLiteral) Pos() Position { return e.Position }
 // NilLiteral represents the nil literal.
 type NilLiteral struct {
 	Position Position
+	Span
 }
 
 func (e *NilLiteral) exprNode()     {}
@@ -57,6 +63,7 @@ func (e *NilLiteral) Pos() Position { return e.Position }
 type SymbolLiteral struct {
 	Name     string
 	Position Position
+	Span
 }
 
 func (e *SymbolLiteral) exprNode()     {}
@@ -66,6 +73,7 @@ func (e *SymbolLiteral) Pos() Position { return e.Position }
 type ArrayLiteral struct {
 	Elements []Expression
 	Position Position
+	Span
 }
 
 func (e *ArrayLiteral) exprNode()     {}
@@ -81,6 +89,7 @@ type HashPair struct {
 type HashLiteral struct {
 	Pairs    []HashPair
 	Position Position
+	Span
 }
 
 func (e *HashLiteral) exprNode()     {}
@@ -112,6 +121,7 @@ type CallExpr struct {
 	Safe     bool
 	Block    *BlockLiteral
 	Position Position
+	Span
 }
 
 func (e *CallExpr) exprNode()     {}
@@ -132,6 +142,7 @@ type MemberExpr struct {
 	// short-circuits the access to nil instead of looking up the member.
 	Safe     bool
 	Position Position
+	Span
 }
 
 func (e *MemberExpr) exprNode()     {}
@@ -142,6 +153,7 @@ type ScopeExpr struct {
 	Object   Expression
 	Property string
 	Position Position
+	Span
 }
 
 func (e *ScopeExpr) exprNode()     {}
@@ -155,6 +167,7 @@ type IndexExpr struct {
 	Object   Expression
 	Indices  []Expression
 	Position Position
+	Span
 }
 
 func (e *IndexExpr) exprNode()     {}
@@ -182,6 +195,7 @@ type DestructureElement struct {
 type DestructureTarget struct {
 	Elements []DestructureElement
 	Position Position
+	Span
 }
 
 func (e *DestructureTarget) exprNode()     {}
@@ -191,6 +205,7 @@ func (e *DestructureTarget) Pos() Position { return e.Position }
 type IvarExpr struct {
 	Name     string
 	Position Position
+	Span
 }
 
 func (e *IvarExpr) exprNode()     {}
@@ -200,6 +215,7 @@ func (e *IvarExpr) Pos() Position { return e.Position }
 type ClassVarExpr struct {
 	Name     string
 	Position Position
+	Span
 }
 
 func (e *ClassVarExpr) exprNode()     {}
@@ -210,6 +226,7 @@ type UnaryExpr struct {
 	Operator TokenType
 	Right    Expression
 	Position Position
+	Span
 }
 
 func (e *UnaryExpr) exprNode()     {}
@@ -221,6 +238,7 @@ type BinaryExpr struct {
 	Operator TokenType
 	Right    Expression
 	Position Position
+	Span
 }
 
 func (e *BinaryExpr) exprNode()     {}
@@ -233,6 +251,7 @@ type ConditionalExpr struct {
 	Consequent Expression
 	Alternate  Expression
 	Position   Position
+	Span
 }
 
 func (e *ConditionalExpr) exprNode()     {}
@@ -251,6 +270,7 @@ type IfExpr struct {
 	ElseIf     []IfExprBranch
 	Alternate  Expression
 	Position   Position
+	Span
 }
 
 func (e *IfExpr) exprNode()     {}
@@ -262,6 +282,7 @@ type RangeExpr struct {
 	End       Expression
 	Exclusive bool
 	Position  Position
+	Span
 }
 
 func (e *RangeExpr) exprNode()     {}
@@ -287,6 +308,7 @@ type CaseExpr struct {
 	Clauses  []CaseWhenClause
 	ElseExpr Expression
 	Position Position
+	Span
 }
 
 func (e *CaseExpr) exprNode()     {}
@@ -298,6 +320,7 @@ type BlockLiteral struct {
 	ImplicitParams []string
 	Body           []Statement
 	Position       Position
+	Span
 }
 
 func (b *BlockLiteral) exprNode()     {}
@@ -307,15 +330,29 @@ func (b *BlockLiteral) Pos() Position { return b.Position }
 type YieldExpr struct {
 	Args     []Expression
 	Position Position
+	Span
 }
 
 func (y *YieldExpr) exprNode()     {}
 func (y *YieldExpr) Pos() Position { return y.Position }
 
+// SuperExpr represents the super keyword inside a method. On its own it calls
+// the superclass method of the same name with the arguments the current method
+// received; as the callee of a CallExpr (super(...)) it passes the call's
+// arguments instead.
+type SuperExpr struct {
+	Position Position
+	Span
+}
+
+func (s *SuperExpr) exprNode()     {}
+func (s *SuperExpr) Pos() Position { return s.Position }
+
 // InterpolatedString represents a string containing embedded expressions.
 type InterpolatedString struct {
 	Parts    []StringPart
 	Position Position
+	Span
 }
 
 // StringPart is the interface for parts of an interpolated string.
@@ -347,6 +384,7 @@ func (s *InterpolatedString) Pos() Position { return s.Position }
 type InterpolatedSymbol struct {
 	Parts    []StringPart
 	Position Position
+	Span
 }
 
 func (s *InterpolatedSymbol) exprNode()     {}
@@ -10,6 +10,7 @@ type FunctionStmt struct {
 	Exported      bool
 	Private       bool
 	Position      Position
+	Span
 }
 
 func (s *FunctionStmt) stmtNode()     {}
@@ -19,15 +20,19 @@ func (s *FunctionStmt) Pos() Position { return s.Position }
 type ReturnStmt struct {
 	Value    Expression
 	Position Position
+	Span
 }
 
 func (s *ReturnStmt) stmtNode()     {}
 func (s *ReturnStmt) Pos() Position { return s.Position }
 
-// RaiseStmt represents a raise statement that throws an error.
+// RaiseStmt represents a raise statement that throws an error. Message is
+// set for the two-operand form `raise ErrorClass, message`.
 type RaiseStmt struct {
 	Value    Expression
+	Message  Expression
 	Position Position
+	Span
 }
 
 func (s *RaiseStmt) stmtNode()     {}
@@ -41,6 +46,7 @@ type AssignStmt struct {
 	// operator for compound assignment.
 	Operator TokenType
 	Position Position
+	Span
 }
 
 func (s *AssignStmt) stmtNode()     {}
@@ -50,6 +56,7 @@ func (s *AssignStmt) Pos() Position { return s.Position }
 type ExprStmt struct {
 	Expr     Expression
 	Position Position
+	Span
 }
 
 func (s *ExprStmt) stmtNode()     {}
@@ -62,6 +69,7 @@ type IfStmt struct {
 	ElseIf     []*IfStmt
 	Alternate  []Statement
 	Position   Position
+	Span
 }
 
 func (s *IfStmt) stmtNode()     {}
@@ -73,6 +81,7 @@ type ForStmt struct {
 	Iterable Expression
 	Body     []Statement
 	Position Position
+	Span
 }
 
 func (s *ForStmt) stmtNode()     {}
@@ -83,6 +92,7 @@ type WhileStmt struct {
 	Condition Expression
 	Body      []Statement
 	Position  Position
+	Span
 }
 
 func (s *WhileStmt) stmtNode()     {}
@@ -93,6 +103,7 @@ type UntilStmt struct {
 	Condition Expression
 	Body      []Statement
 	Position  Position
+	Span
 }
 
 func (s *UntilStmt) stmtNode()     {}
@@ -102,14 +113,26 @@ func (s *UntilStmt) Pos() Position { return s.Position }
 type BreakStmt struct {
 	Value    Expression
 	Position Position
+	Span
 }
 
 func (s *BreakStmt) stmtNode()     {}
 func (s *BreakStmt) Pos() Position { return s.Position }
 
+// RetryStmt represents a retry statement, which restarts the begin block
+// whose rescue clause contains it.
+type RetryStmt struct {
+	Position Position
+	Span
+}
+
+func (s *RetryStmt) stmtNode()     {}
+func (s *RetryStmt) Pos() Position { return s.Position }
+
 // NextStmt represents a next statement that skips to the next loop iteration.
 type NextStmt struct {
 	Position Position
+	Span
 }
 
 func (s *NextStmt) stmtNode()     {}
@@ -125,6 +148,7 @@ type TryStmt struct {
 	Else           []Statement
 	Ensure         []Statement
 	Position       Position
+	Span
 }
 
 func (s *TryStmt) stmtNode()     {}
@@ -140,16 +164,31 @@ type PropertyDecl struct {
 // ClassStmt represents a class definition.
 type ClassStmt struct {
 	Name         string
+	Superclass   string
+	Includes     []string
 	Methods      []*FunctionStmt
 	ClassMethods []*FunctionStmt
 	Properties   []PropertyDecl
 	Body         []Statement
 	Position     Position
+	Span
 }
 
 func (s *ClassStmt) stmtNode()     {}
 func (s *ClassStmt) Pos() Position { return s.Position }
 
+// ModuleStmt represents a mixin module definition: instance methods that
+// classes merge into their own method table with include.
+type ModuleStmt struct {
+	Name     string
+	Methods  []*FunctionStmt
+	Position Position
+	Span
+}
+
+func (s *ModuleStmt) stmtNode()     {}
+func (s *ModuleStmt) Pos() Position { return s.Position }
+
 // EnumMemberStmt represents a single member in an enum definition.
 type EnumMemberStmt struct {
 	Name     string
@@ -161,6 +200,7 @@ type EnumStmt struct {
 	Name     string
 	Members  []EnumMemberStmt
 	Position Position
+	Span
 }
 
 func (s *EnumStmt) stmtNode()     {}
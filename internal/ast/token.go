@@ -64,6 +64,9 @@ const (
 	TokenAnd            TokenType = "&&"
 	TokenOr             TokenType = "||"
 	TokenAmpersand      TokenType = "&"
+	TokenCaret          TokenType = "^"
+	TokenTilde          TokenType = "~"
+	TokenShiftRight     TokenType = ">>"
 	TokenQuestion       TokenType = "?"
 
 	TokenComma     TokenType = ","
@@ -88,6 +91,7 @@ const (
 
 	TokenDef      TokenType = "DEF"
 	TokenClass    TokenType = "CLASS"
+	TokenModule   TokenType = "MODULE"
 	TokenEnum     TokenType = "ENUM"
 	TokenExport   TokenType = "EXPORT"
 	TokenSelf     TokenType = "SELF"
@@ -102,6 +106,7 @@ const (
 	TokenEnd      TokenType = "END"
 	TokenReturn   TokenType = "RETURN"
 	TokenYield    TokenType = "YIELD"
+	TokenSuper    TokenType = "SUPER"
 	TokenDo       TokenType = "DO"
 	TokenThen     TokenType = "THEN"
 	TokenFor      TokenType = "FOR"
@@ -140,6 +145,7 @@ type Token struct {
 var keywordTokenTypes = map[string]TokenType{
 	"def":      TokenDef,
 	"class":    TokenClass,
+	"module":   TokenModule,
 	"enum":     TokenEnum,
 	"export":   TokenExport,
 	"self":     TokenSelf,
@@ -154,6 +160,7 @@ var keywordTokenTypes = map[string]TokenType{
 	"end":      TokenEnd,
 	"return":   TokenReturn,
 	"yield":    TokenYield,
+	"super":    TokenSuper,
 	"do":       TokenDo,
 	"then":     TokenThen,
 	"for":      TokenFor,
@@ -25,6 +25,7 @@ func TestKeywords(t *testing.T) {
 		"getter",
 		"if",
 		"in",
+		"module",
 		"next",
 		"nil",
 		"private",
@@ -34,6 +35,7 @@ func TestKeywords(t *testing.T) {
 		"return",
 		"self",
 		"setter",
+		"super",
 		"then",
 		"true",
 		"unless",
@@ -0,0 +1,100 @@
+package parser
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/mgomes/vibescript/internal/ast"
+)
+
+// TestParserBitwiseOperatorPrecedence pins the binding strengths of the
+// bitwise operators: "<<"/">>" bind looser than "+", "&" looser than the
+// shifts, "|"/"^" looser than "&", and all of them tighter than comparison.
+func TestParserBitwiseOperatorPrecedence(t *testing.T) {
+	t.Parallel()
+
+	ident := func(name string) ast.Expression { return &ast.Identifier{Name: name} }
+	binary := func(left ast.Expression, op ast.TokenType, right ast.Expression) ast.Expression {
+		return &ast.BinaryExpr{Left: left, Operator: op, Right: right}
+	}
+
+	tests := []struct {
+		name   string
+		source string
+		want   ast.Expression
+	}{
+		{
+			name:   "shift_right",
+			source: "a >> b",
+			want:   binary(ident("a"), ast.TokenShiftRight, ident("b")),
+		},
+		{
+			name:   "plus_binds_tighter_than_shift_right",
+			source: "a + b >> c",
+			want:   binary(binary(ident("a"), ast.TokenPlus, ident("b")), ast.TokenShiftRight, ident("c")),
+		},
+		{
+			name:   "shifts_are_left_associative",
+			source: "a << b >> c",
+			want:   binary(binary(ident("a"), ast.TokenShovel, ident("b")), ast.TokenShiftRight, ident("c")),
+		},
+		{
+			name:   "and_binds_tighter_than_or",
+			source: "a | b & c",
+			want:   binary(ident("a"), ast.TokenPipe, binary(ident("b"), ast.TokenAmpersand, ident("c"))),
+		},
+		{
+			name:   "or_and_xor_share_precedence",
+			source: "a ^ b | c",
+			want:   binary(binary(ident("a"), ast.TokenCaret, ident("b")), ast.TokenPipe, ident("c")),
+		},
+		{
+			name:   "or_binds_tighter_than_comparison",
+			source: "a | b > c",
+			want:   binary(binary(ident("a"), ast.TokenPipe, ident("b")), ast.TokenGT, ident("c")),
+		},
+		{
+			name:   "separated_greater_than_is_not_a_shift",
+			source: "a > b",
+			want:   binary(ident("a"), ast.TokenGT, ident("b")),
+		},
+		{
+			name:   "complement",
+			source: "~a & b",
+			want:   binary(&ast.UnaryExpr{Operator: ast.TokenTilde, Right: ident("a")}, ast.TokenAmpersand, ident("b")),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			source := "def run\n  " + tc.source + "\nend"
+			got, errs := parseSource(t, source)
+			if len(errs) > 0 {
+				t.Fatalf("parseSource(%q) errors = %v, want none", source, errs)
+			}
+			wantBody := []ast.Statement{&ast.ExprStmt{Expr: tc.want}}
+			if diff := cmp.Diff(wantBody, parsedFunctionBody(t, got), astCmpOpts); diff != "" {
+				t.Fatalf("function body mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+// TestParserBitwiseOperatorsKeepPipeAndGenericSyntax confirms the new infix
+// operators do not disturb block parameters, union types, or nested generic
+// types that close with adjacent ">" tokens.
+func TestParserBitwiseOperatorsKeepPipeAndGenericSyntax(t *testing.T) {
+	t.Parallel()
+
+	source := `def run(rows: array<array<int>>, mask: int | nil) -> hash<string, array<int>>
+  rows.map do |row|
+    row.map { |n| n | 1 }
+  end
+end`
+
+	if _, errs := parseSource(t, source); len(errs) > 0 {
+		t.Fatalf("parseSource(%q) errors = %v, want none", source, errs)
+	}
+}
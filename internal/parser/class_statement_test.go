@@ -3,6 +3,8 @@ package parser
 import (
 	"strings"
 	"testing"
+
+	"github.com/mgomes/vibescript/internal/ast"
 )
 
 func TestParserRejectsSingletonClassSyntax(t *testing.T) {
@@ -21,3 +23,214 @@ end
 		t.Fatalf("unexpected parse error: %v", errs[0])
 	}
 }
+
+func TestParserAcceptsOperatorMethodNames(t *testing.T) {
+	t.Parallel()
+	program, errs := parseSource(t, `
+class Vec
+  def +(other)
+    other
+  end
+
+  def ==(other)
+    true
+  end
+
+  def <=>(other)
+    0
+  end
+
+  def <<(item)
+    self
+  end
+
+  def [](key)
+    key
+  end
+
+  def []=(key, value)
+    value
+  end
+end
+`)
+	if len(errs) > 0 {
+		t.Fatalf("unexpected parse errors: %v", errs)
+	}
+	class, ok := program.Statements[0].(*ast.ClassStmt)
+	if !ok {
+		t.Fatalf("expected class statement, got %T", program.Statements[0])
+	}
+	want := []string{"+", "==", "<=>", "<<", "[]", "[]="}
+	if len(class.Methods) != len(want) {
+		t.Fatalf("expected %d methods, got %d", len(want), len(class.Methods))
+	}
+	for i, name := range want {
+		if class.Methods[i].Name != name {
+			t.Fatalf("method %d name = %q, want %q", i, class.Methods[i].Name, name)
+		}
+	}
+}
+
+func TestParserRejectsOperatorMethodOutsideClass(t *testing.T) {
+	t.Parallel()
+	_, errs := parseSource(t, `
+def +(other)
+  other
+end
+`)
+	if len(errs) == 0 {
+		t.Fatal("expected parse error for top-level operator method")
+	}
+}
+
+func TestParserClassSuperclassAndSuper(t *testing.T) {
+	t.Parallel()
+	program, errs := parseSource(t, `
+class Dog < Animal
+  def initialize(name)
+    super(name)
+  end
+
+  def speak
+    super
+  end
+end
+`)
+	if len(errs) > 0 {
+		t.Fatalf("unexpected parse errors: %v", errs)
+	}
+	class, ok := program.Statements[0].(*ast.ClassStmt)
+	if !ok {
+		t.Fatalf("expected class statement, got %T", program.Statements[0])
+	}
+	if class.Name != "Dog" || class.Superclass != "Animal" {
+		t.Fatalf("class = %s < %s, want Dog < Animal", class.Name, class.Superclass)
+	}
+	initBody, ok := class.Methods[0].Body[0].(*ast.ExprStmt)
+	if !ok {
+		t.Fatalf("expected expression statement, got %T", class.Methods[0].Body[0])
+	}
+	call, ok := initBody.Expr.(*ast.CallExpr)
+	if !ok {
+		t.Fatalf("expected super call, got %T", initBody.Expr)
+	}
+	if _, ok := call.Callee.(*ast.SuperExpr); !ok || len(call.Args) != 1 {
+		t.Fatalf("expected super(name), got callee %T with %d args", call.Callee, len(call.Args))
+	}
+	speakBody, ok := class.Methods[1].Body[0].(*ast.ExprStmt)
+	if !ok {
+		t.Fatalf("expected expression statement, got %T", class.Methods[1].Body[0])
+	}
+	if _, ok := speakBody.Expr.(*ast.SuperExpr); !ok {
+		t.Fatalf("expected bare super, got %T", speakBody.Expr)
+	}
+}
+
+func TestParserRejectsMissingSuperclassName(t *testing.T) {
+	t.Parallel()
+	_, errs := parseSource(t, `
+class Dog <
+end
+`)
+	if len(errs) == 0 {
+		t.Fatal("expected parse error for missing superclass name")
+	}
+}
+
+func TestParserModuleAndInclude(t *testing.T) {
+	t.Parallel()
+	program, errs := parseSource(t, `
+module Greeting
+  def greet
+    "hi"
+  end
+
+  private def secret
+    1
+  end
+end
+
+class User
+  include Greeting, Audited
+
+  def name
+    "user"
+  end
+end
+`)
+	if len(errs) > 0 {
+		t.Fatalf("unexpected parse errors: %v", errs)
+	}
+	module, ok := program.Statements[0].(*ast.ModuleStmt)
+	if !ok {
+		t.Fatalf("expected module statement, got %T", program.Statements[0])
+	}
+	if module.Name != "Greeting" || len(module.Methods) != 2 {
+		t.Fatalf("module = %s with %d methods, want Greeting with 2", module.Name, len(module.Methods))
+	}
+	if module.Methods[0].Private || !module.Methods[1].Private {
+		t.Fatalf("expected only secret to be private")
+	}
+	class, ok := program.Statements[1].(*ast.ClassStmt)
+	if !ok {
+		t.Fatalf("expected class statement, got %T", program.Statements[1])
+	}
+	if len(class.Includes) != 2 || class.Includes[0] != "Greeting" || class.Includes[1] != "Audited" {
+		t.Fatalf("includes = %v, want [Greeting Audited]", class.Includes)
+	}
+	if len(class.Body) != 0 {
+		t.Fatalf("include should not add class body statements, got %d", len(class.Body))
+	}
+}
+
+func TestParserModuleErrors(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name   string
+		source string
+		want   string
+	}{
+		{
+			name: "class method",
+			source: `
+module Helpers
+  def self.build
+    1
+  end
+end
+`,
+			want: "module methods cannot be defined on self",
+		},
+		{
+			name: "statement in body",
+			source: `
+module Helpers
+  x = 1
+end
+`,
+			want: "module Helpers may only contain method definitions",
+		},
+		{
+			name: "nested in class",
+			source: `
+class Outer
+  module Inner
+  end
+end
+`,
+			want: "module is only supported at the top level",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			_, errs := parseSource(t, tt.source)
+			if len(errs) == 0 {
+				t.Fatalf("expected parse error")
+			}
+			if !strings.Contains(errs[0].Error(), tt.want) {
+				t.Fatalf("error = %v, want %q", errs[0], tt.want)
+			}
+		})
+	}
+}
@@ -51,6 +51,7 @@ func (p *parser) parseExpressionWithLineLimit(precedence, limitLine int, lineLim
 	if left == nil {
 		return nil
 	}
+	p.markEnd(left)
 
 	return p.continueExpressionParse(left, precedence, limitLine, lineLimited)
 }
@@ -79,6 +80,7 @@ func (p *parser) continueExpressionParse(left ast.Expression, precedence, limitL
 			if left == nil {
 				return nil
 			}
+			p.markEnd(left)
 			if lineLimited {
 				limitLine = p.curToken.Pos.Line
 			}
@@ -97,6 +99,7 @@ func (p *parser) continueExpressionParse(left ast.Expression, precedence, limitL
 		if left == nil {
 			return nil
 		}
+		p.markEnd(left)
 		if lineLimited {
 			limitLine = p.curToken.Pos.Line
 		}
@@ -339,6 +342,7 @@ const (
 	prefixParserHashLiteral
 	prefixParserPrefixExpression
 	prefixParserYieldExpression
+	prefixParserSuperExpression
 	prefixParserIfExpression
 	prefixParserCaseExpression
 )
@@ -381,10 +385,12 @@ func prefixParserKind(tt ast.TokenType) prefixParseKind {
 		return prefixParserArrayLiteral
 	case ast.TokenLBrace:
 		return prefixParserHashLiteral
-	case ast.TokenBang, ast.TokenNot, ast.TokenMinus, ast.TokenPlus:
+	case ast.TokenBang, ast.TokenNot, ast.TokenMinus, ast.TokenPlus, ast.TokenTilde:
 		return prefixParserPrefixExpression
 	case ast.TokenYield:
 		return prefixParserYieldExpression
+	case ast.TokenSuper:
+		return prefixParserSuperExpression
 	case ast.TokenIf:
 		return prefixParserIfExpression
 	case ast.TokenCase:
@@ -436,6 +442,8 @@ func (p *parser) parsePrefix(kind prefixParseKind) ast.Expression {
 		return p.parsePrefixExpression()
 	case prefixParserYieldExpression:
 		return p.parseYieldExpression()
+	case prefixParserSuperExpression:
+		return &ast.SuperExpr{Position: p.curToken.Pos}
 	case prefixParserIfExpression:
 		return p.parseIfExpression()
 	case prefixParserCaseExpression:
@@ -463,7 +471,7 @@ func infixParserKind(tt ast.TokenType) infixParseKind {
 	switch tt {
 	case ast.TokenPlus, ast.TokenMinus, ast.TokenSlash, ast.TokenAsterisk, ast.TokenPower, ast.TokenPercent,
 		ast.TokenEQ, ast.TokenCaseEQ, ast.TokenNotEQ, ast.TokenLT, ast.TokenLTE, ast.TokenGT, ast.TokenGTE,
-		ast.TokenSpaceship, ast.TokenAnd, ast.TokenOr, ast.TokenShovel, ast.TokenAmpersand:
+		ast.TokenSpaceship, ast.TokenAnd, ast.TokenOr, ast.TokenShovel, ast.TokenAmpersand, ast.TokenPipe, ast.TokenCaret:
 		return infixParserInfixExpression
 	case ast.TokenQuestion:
 		return infixParserConditionalExpression
@@ -509,7 +517,7 @@ func (p *parser) parseInfix(kind infixParseKind, left ast.Expression) ast.Expres
 
 func (p *parser) lineLimitedContinuationToken(tok ast.Token) bool {
 	switch tok.Type {
-	case ast.TokenDot, ast.TokenSafeNav, ast.TokenScope, ast.TokenSlash, ast.TokenPower, ast.TokenPercent, ast.TokenRange, ast.TokenRangeExcl, ast.TokenEQ, ast.TokenCaseEQ, ast.TokenNotEQ, ast.TokenLT, ast.TokenLTE, ast.TokenGT, ast.TokenGTE, ast.TokenSpaceship, ast.TokenAnd, ast.TokenOr, ast.TokenQuestion, ast.TokenShovel, ast.TokenAmpersand:
+	case ast.TokenDot, ast.TokenSafeNav, ast.TokenScope, ast.TokenSlash, ast.TokenPower, ast.TokenPercent, ast.TokenRange, ast.TokenRangeExcl, ast.TokenEQ, ast.TokenCaseEQ, ast.TokenNotEQ, ast.TokenLT, ast.TokenLTE, ast.TokenGT, ast.TokenGTE, ast.TokenSpaceship, ast.TokenAnd, ast.TokenOr, ast.TokenQuestion, ast.TokenShovel, ast.TokenAmpersand, ast.TokenPipe, ast.TokenCaret:
 		return true
 	case ast.TokenAsterisk:
 		// A line that begins with "*" continues the previous expression as a
@@ -1014,6 +1022,7 @@ func (p *parser) parsePercentArrayLiteralArgument() ast.Expression {
 	p.reprimeAt(endOffset, ast.Token{Type: litType, Pos: pos, End: end})
 
 	array := &ast.ArrayLiteral{Elements: elements, Position: pos}
+	p.markEnd(array)
 	// Continue parsing so trailing postfixes (such as `[i]` or `.member`) and
 	// operators bind to the literal, matching how other parenless arguments are
 	// parsed through the normal expression continuation rather than returning
@@ -1276,6 +1285,12 @@ func (p *parser) parseInfixExpression(left ast.Expression) ast.Expression {
 	pos := p.curToken.Pos
 	operator := p.curToken.Type
 	precedence := p.curPrecedence()
+	if operator == ast.TokenGT && p.peekToken.Type == ast.TokenGT &&
+		p.peekToken.Pos.Line == pos.Line && p.peekToken.Pos.Column == pos.Column+1 {
+		p.nextToken()
+		operator = ast.TokenShiftRight
+		precedence = precShift
+	}
 	p.nextToken()
 	rightPrecedence := precedence
 	if operator == ast.TokenPower {
@@ -1605,7 +1620,12 @@ func (p *parser) parseBlockLiteral() *ast.BlockLiteral {
 	if !hasExplicitParams {
 		p.declareNumberedImplicitBlockParamCandidates()
 	}
+	// A block body runs outside the rescue clause that encloses it, so retry
+	// cannot restart the begin block from there.
+	rescueDepth := p.rescueDepth
+	p.rescueDepth = 0
 	body := p.parseBlock(stopToken)
+	p.rescueDepth = rescueDepth
 	p.popLocalScope()
 	if p.curToken.Type != stopToken {
 		p.errorExpected(p.curToken, stopName)
@@ -1616,7 +1636,9 @@ func (p *parser) parseBlockLiteral() *ast.BlockLiteral {
 		implicitParams = inferImplicitBlockParams(body, inferImplicitIt)
 	}
 
-	return &ast.BlockLiteral{Params: params, ImplicitParams: implicitParams, Body: body, Position: pos}
+	block := &ast.BlockLiteral{Params: params, ImplicitParams: implicitParams, Body: body, Position: pos}
+	p.markEnd(block)
+	return block
 }
 
 func (p *parser) parseBlockParameters() ([]ast.Param, bool) {
@@ -2000,9 +2022,9 @@ func (p *parser) recoverUnsupportedCallArgument() {
 func isLabelNameToken(tok ast.Token) bool {
 	switch tok.Type {
 	case ast.TokenIdent,
-		ast.TokenDef, ast.TokenClass, ast.TokenEnum, ast.TokenExport, ast.TokenSelf, ast.TokenPrivate, ast.TokenProperty, ast.TokenGetter, ast.TokenSetter,
+		ast.TokenDef, ast.TokenClass, ast.TokenModule, ast.TokenEnum, ast.TokenExport, ast.TokenSelf, ast.TokenPrivate, ast.TokenProperty, ast.TokenGetter, ast.TokenSetter,
 		ast.TokenBegin, ast.TokenRescue, ast.TokenEnsure, ast.TokenRaise,
-		ast.TokenEnd, ast.TokenReturn, ast.TokenYield, ast.TokenDo, ast.TokenThen, ast.TokenFor, ast.TokenWhile, ast.TokenUntil,
+		ast.TokenEnd, ast.TokenReturn, ast.TokenYield, ast.TokenSuper, ast.TokenDo, ast.TokenThen, ast.TokenFor, ast.TokenWhile, ast.TokenUntil,
 		ast.TokenBreak, ast.TokenNext, ast.TokenIn, ast.TokenIf, ast.TokenUnless, ast.TokenCase, ast.TokenWhen, ast.TokenElsif, ast.TokenElse,
 		ast.TokenTrue, ast.TokenFalse, ast.TokenNil:
 		return true
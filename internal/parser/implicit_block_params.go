@@ -44,12 +44,13 @@ func (u *implicitBlockParamUsage) visitStatements(stmts []ast.Statement) {
 
 func (u *implicitBlockParamUsage) visitStatement(stmt ast.Statement) {
 	switch s := stmt.(type) {
-	case *ast.FunctionStmt, *ast.ClassStmt, *ast.EnumStmt:
+	case *ast.FunctionStmt, *ast.ClassStmt, *ast.ModuleStmt, *ast.EnumStmt:
 		return
 	case *ast.ReturnStmt:
 		u.visitExpression(s.Value, false)
 	case *ast.RaiseStmt:
 		u.visitExpression(s.Value, false)
+		u.visitExpression(s.Message, false)
 	case *ast.AssignStmt:
 		u.recordAssignedTarget(s.Target)
 		u.visitExpression(s.Value, false)
@@ -85,7 +86,7 @@ func (u *implicitBlockParamUsage) visitStatement(stmt ast.Statement) {
 		u.visitStatements(s.Ensure)
 	case *ast.BreakStmt:
 		u.visitExpression(s.Value, false)
-	case *ast.NextStmt:
+	case *ast.NextStmt, *ast.RetryStmt:
 		return
 	}
 }
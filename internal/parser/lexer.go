@@ -435,6 +435,12 @@ func (l *lexer) scanToken() ast.Token {
 			tok = l.makeToken(ast.TokenAmpersand, "&")
 			l.readRune()
 		}
+	case '^':
+		tok = l.makeToken(ast.TokenCaret, "^")
+		l.readRune()
+	case '~':
+		tok = l.makeToken(ast.TokenTilde, "~")
+		l.readRune()
 	case '?':
 		tok = l.makeToken(ast.TokenQuestion, "?")
 		l.ternaryStack = append(l.ternaryStack, ternaryFrame{bracketDepth: l.bracketDepth})
@@ -1491,7 +1497,7 @@ func canEndExpressionToken(tt ast.TokenType) bool {
 	case ast.TokenIdent, ast.TokenInt, ast.TokenFloat, ast.TokenString, ast.TokenInterpolatedString,
 		ast.TokenSymbol, ast.TokenWords, ast.TokenSymbols, ast.TokenInterpWords, ast.TokenInterpSymbols,
 		ast.TokenTrue, ast.TokenFalse, ast.TokenNil,
-		ast.TokenSelf, ast.TokenIvar, ast.TokenClassVar, ast.TokenRParen, ast.TokenRBracket,
+		ast.TokenSelf, ast.TokenSuper, ast.TokenIvar, ast.TokenClassVar, ast.TokenRParen, ast.TokenRBracket,
 		ast.TokenRBrace, ast.TokenEnd:
 		return true
 	default:
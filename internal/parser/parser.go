@@ -29,6 +29,11 @@ type parser struct {
 	typeDepth        int
 	localScopes      []localScope
 
+	// rescueDepth counts the rescue bodies enclosing the current statement,
+	// outside any block literal; `retry` is a statement only when it is
+	// positive.
+	rescueDepth int
+
 	// shapeStructurallyInvalid records that the most recent parseTypeShape
 	// rejected a brace group whose field values all parsed as types but whose
 	// shape structure was malformed (a duplicate field or a missing field
@@ -36,6 +41,18 @@ type parser struct {
 	// bracedGroupIsShapeType keep such a clearly-shape-like diagnostic instead
 	// of silently reinterpreting the braces as a hash-literal default.
 	shapeStructurallyInvalid bool
+
+	// rescueClassRefs collects rescue types that are not built-in error types.
+	// They must name a class declared somewhere in the program, which is only
+	// known once parsing finishes (see checkRescueClassRefs).
+	rescueClassRefs []rescueClassRef
+}
+
+// rescueClassRef is a rescue type naming a script class, with the position
+// of its rescue clause for error reporting.
+type rescueClassRef struct {
+	name string
+	pos  ast.Position
 }
 
 // localScope records the local names declared within a single lexical
@@ -219,6 +236,22 @@ func Parse(source string) (*ast.Program, []error) {
 	return newParser(source).parseProgram()
 }
 
+// ParseType parses a standalone type annotation such as "string",
+// "int?", or "hash<string, array<int>>" using the same grammar as
+// parameter annotations. It reports the first parse error, including
+// trailing input after a complete type.
+func ParseType(source string) (*ast.TypeExpr, error) {
+	p := newParser(source)
+	ty := p.parseTypeExpr()
+	if len(p.errors) == 0 && p.peekToken.Type != ast.TokenEOF {
+		p.errorUnexpected(p.peekToken)
+	}
+	if len(p.errors) > 0 {
+		return nil, p.errors[0]
+	}
+	return ty, nil
+}
+
 func (p *parser) parseProgram() (*ast.Program, []error) {
 	program := &ast.Program{}
 
@@ -234,6 +267,7 @@ func (p *parser) parseProgram() (*ast.Program, []error) {
 		p.nextToken()
 	}
 
+	p.checkRescueClassRefs(program)
 	p.addOmittedParseError()
 	return program, p.errors
 }
@@ -247,6 +281,7 @@ const (
 	precEquality
 	precComparison
 	precRange
+	precBitOr
 	precBitAnd
 	precShift
 	precSum
@@ -270,6 +305,8 @@ var precedences = map[ast.TokenType]int{
 	ast.TokenSpaceship: precComparison,
 	ast.TokenRange:     precRange,
 	ast.TokenRangeExcl: precRange,
+	ast.TokenPipe:      precBitOr,
+	ast.TokenCaret:     precBitOr,
 	ast.TokenAmpersand: precBitAnd,
 	ast.TokenShovel:    precShift,
 	ast.TokenPlus:      precSum,
@@ -295,12 +332,24 @@ func (p *parser) curPrecedence() int {
 }
 
 func (p *parser) peekPrecedence() int {
+	if p.peekStartsShiftRight() {
+		return precShift
+	}
 	if prec, ok := precedences[p.peekToken.Type]; ok {
 		return prec
 	}
 	return lowestPrec
 }
 
+// peekStartsShiftRight reports whether the peek token is the first `>` of a
+// `>>` shift operator. The lexer always emits single `>` tokens so nested
+// generic types such as `array<array<int>>` close normally; two directly
+// adjacent `>` tokens in expression position are joined here instead.
+func (p *parser) peekStartsShiftRight() bool {
+	return p.peekToken.Type == ast.TokenGT && p.peekPeek.Type == ast.TokenGT &&
+		p.peekPeek.Pos.Line == p.peekToken.Pos.Line && p.peekPeek.Pos.Column == p.peekToken.Pos.Column+1
+}
+
 func (p *parser) expectPeek(tt ast.TokenType) bool {
 	if p.peekToken.Type == tt {
 		p.nextToken()
@@ -396,6 +445,15 @@ func (p *parser) codeFrameFormatter() *source.CodeFrameFormatter {
 	return p.codeFrames
 }
 
+// markEnd stamps node with the end of the current token. Parse functions
+// leave the current token on the last token of the construct they consumed,
+// so calling this after one returns records where that construct ends.
+func (p *parser) markEnd(node ast.Node) {
+	if spanned, ok := node.(interface{ SetEndPos(ast.Position) }); ok {
+		spanned.SetEndPos(tokenEnd(p.curToken))
+	}
+}
+
 // tokenEnd returns the lexer-stamped exclusive end of the token. EOF
 // carries no span, yielding the zero Position.
 func tokenEnd(tok ast.Token) ast.Position {
@@ -436,6 +494,8 @@ func tokenLabel(tt ast.TokenType) string {
 		return "'def'"
 	case ast.TokenClass:
 		return "'class'"
+	case ast.TokenModule:
+		return "'module'"
 	case ast.TokenEnum:
 		return "'enum'"
 	case ast.TokenExport:
@@ -458,6 +518,8 @@ func tokenLabel(tt ast.TokenType) string {
 		return "'return'"
 	case ast.TokenYield:
 		return "'yield'"
+	case ast.TokenSuper:
+		return "'super'"
 	case ast.TokenDo:
 		return "'do'"
 	case ast.TokenThen:
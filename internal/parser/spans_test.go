@@ -0,0 +1,59 @@
+package parser
+
+import (
+	"testing"
+
+	"github.com/mgomes/vibescript/internal/ast"
+)
+
+// TestParserRecordsNodeEndPositions checks that the parser stamps each node
+// with the exclusive end of its last token, so Pos and EndPos bound the
+// node's source text.
+func TestParserRecordsNodeEndPositions(t *testing.T) {
+	t.Parallel()
+	source := "def run\n  total = a + b * 2\n  items.fetch(10, default: 1)\n  (x + y)\n  [1, 2].map do |n|\n    n\n  end\nend"
+	program, errs := parseSource(t, source)
+	if len(errs) > 0 {
+		t.Fatalf("parseSource(%q) errors = %v, want none", source, errs)
+	}
+	body := parsedFunctionBody(t, program)
+	pos := func(line, column int) ast.Position { return ast.Position{Line: line, Column: column} }
+
+	assign := body[0].(*ast.AssignStmt)
+	sum := assign.Value.(*ast.BinaryExpr)
+	product := sum.Right.(*ast.BinaryExpr)
+	call := body[1].(*ast.ExprStmt).Expr.(*ast.CallExpr)
+	grouped := body[2].(*ast.ExprStmt).Expr.(*ast.BinaryExpr)
+	blockCall := body[3].(*ast.ExprStmt).Expr.(*ast.CallExpr)
+
+	tests := []struct {
+		name  string
+		node  ast.Node
+		start ast.Position
+		end   ast.Position
+	}{
+		{name: "assignment", node: assign, start: pos(2, 3), end: pos(2, 20)},
+		{name: "binary_left_operand", node: sum.Left, start: pos(2, 11), end: pos(2, 12)},
+		{name: "binary", node: sum, start: pos(2, 13), end: pos(2, 20)},
+		{name: "nested_binary", node: product, start: pos(2, 17), end: pos(2, 20)},
+		{name: "call", node: call, start: pos(3, 3), end: pos(3, 30)},
+		{name: "call_callee", node: call.Callee, start: pos(3, 3), end: pos(3, 14)},
+		{name: "call_argument", node: call.Args[0], start: pos(3, 15), end: pos(3, 17)},
+		{name: "grouped_binary_includes_paren", node: grouped, start: pos(4, 6), end: pos(4, 10)},
+		{name: "call_with_block", node: blockCall, start: pos(5, 3), end: pos(7, 6)},
+		{name: "block", node: blockCall.Block, start: pos(5, 14), end: pos(7, 6)},
+		{name: "function", node: program.Statements[0], start: pos(1, 1), end: pos(8, 4)},
+		{name: "program", node: program, start: pos(1, 1), end: pos(8, 4)},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			if got := tc.node.Pos(); got != tc.start {
+				t.Fatalf("Pos() = %v, want %v", got, tc.start)
+			}
+			if got := tc.node.EndPos(); got != tc.end {
+				t.Fatalf("EndPos() = %v, want %v", got, tc.end)
+			}
+		})
+	}
+}
@@ -14,6 +14,8 @@ func (p *parser) parseStatement() ast.Statement {
 		stmt = p.parseFunctionStatement()
 	case ast.TokenClass:
 		stmt = p.parseClassStatement()
+	case ast.TokenModule:
+		stmt = p.parseModuleStatement()
 	case ast.TokenEnum:
 		stmt = p.parseEnumStatement()
 	case ast.TokenExport:
@@ -43,13 +45,19 @@ func (p *parser) parseStatement() ast.Statement {
 	case ast.TokenIdent:
 		if p.curToken.Literal == "assert" {
 			stmt = p.parseAssertStatement()
+		} else if p.curToken.Literal == "retry" && p.rescueDepth > 0 && p.peekEndsStatement(p.curToken.Pos) {
+			stmt = &ast.RetryStmt{Position: p.curToken.Pos}
 		} else {
 			stmt = p.parseExpressionOrAssignStatement()
 		}
 	default:
 		stmt = p.parseExpressionOrAssignStatement()
 	}
-	return p.parseStatementModifier(stmt)
+	stmt = p.parseStatementModifier(stmt)
+	if stmt != nil {
+		p.markEnd(stmt)
+	}
+	return stmt
 }
 
 func (p *parser) skipStatementSeparators() {
@@ -130,7 +138,16 @@ func (p *parser) parseRaiseStatement() ast.Statement {
 	if value == nil {
 		return nil
 	}
-	return &ast.RaiseStmt{Value: value, Position: pos}
+	stmt := &ast.RaiseStmt{Value: value, Position: pos}
+	if p.peekToken.Type == ast.TokenComma && p.peekToken.Pos.Line == p.curToken.Pos.Line {
+		p.nextToken()
+		p.nextToken()
+		stmt.Message = p.parseLineExpression(lowestPrec)
+		if stmt.Message == nil {
+			return nil
+		}
+	}
+	return stmt
 }
 
 func (p *parser) parseBlock(stop ...ast.TokenType) []ast.Statement {
@@ -355,7 +372,9 @@ func (p *parser) parseRescueElseEnsureTail(pos ast.Position, body []ast.Statemen
 		if rescueBinding != "" {
 			p.declareLocal(rescueBinding)
 		}
+		p.rescueDepth++
 		rescueBody = p.parseBlock(ast.TokenElse, ast.TokenEnsure, ast.TokenEnd)
+		p.rescueDepth--
 		if rescueBinding != "" && !bindingWasLocal {
 			p.undeclareLocal(rescueBinding)
 		}
@@ -521,17 +540,39 @@ func (p *parser) validateRescueTypeExpr(ty *ast.TypeExpr, pos ast.Position) bool
 		return false
 	}
 	if _, ok := ast.CanonicalRuntimeErrorType(ty.Name); !ok {
-		p.addParseError(pos, fmt.Sprintf("unknown rescue error type %s", ty.Name))
-		return false
+		// The name may be a script error class declared later in the file, so
+		// it is resolved once the whole program has been parsed.
+		p.rescueClassRefs = append(p.rescueClassRefs, rescueClassRef{name: ty.Name, pos: pos})
 	}
 	return true
 }
 
+// checkRescueClassRefs reports each rescue type that is neither a built-in
+// error type nor a class declared in program.
+func (p *parser) checkRescueClassRefs(program *ast.Program) {
+	if len(p.rescueClassRefs) == 0 {
+		return
+	}
+	classes := make(map[string]struct{})
+	for _, stmt := range program.Statements {
+		if class, ok := stmt.(*ast.ClassStmt); ok {
+			classes[class.Name] = struct{}{}
+		}
+	}
+	for _, ref := range p.rescueClassRefs {
+		if _, ok := classes[ref.name]; !ok {
+			p.addParseError(ref.pos, fmt.Sprintf("unknown rescue error type %s", ref.name))
+		}
+	}
+	p.rescueClassRefs = nil
+}
+
 func (p *parser) parseFunctionStatement() ast.Statement {
 	pos := p.curToken.Pos
 	p.nextToken()
 
 	isClassMethod := false
+	isOperator := false
 	var name string
 	if p.curToken.Type == ast.TokenSelf && p.peekToken.Type == ast.TokenDot {
 		isClassMethod = true
@@ -541,6 +582,19 @@ func (p *parser) parseFunctionStatement() ast.Statement {
 		}
 		name = p.curToken.Literal
 		p.nextToken()
+	} else if p.insideClass && isOperatorMethodName(p.curToken.Type) {
+		// Operator methods (def +(other), def <=>(other)) are instance methods
+		// the runtime dispatches binary operators to. The name is the operator
+		// itself, so it never takes a setter suffix.
+		name = p.curToken.Literal
+		isOperator = true
+		p.nextToken()
+	} else if p.insideClass && p.curToken.Type == ast.TokenLBracket && p.peekToken.Type == ast.TokenRBracket {
+		// Index methods: def [](key) and, with the setter suffix below,
+		// def []=(key, value).
+		p.nextToken()
+		name = "[]"
+		p.nextToken()
 	} else {
 		if p.curToken.Type != ast.TokenIdent {
 			p.errorExpected(p.curToken, "function name")
@@ -550,7 +604,7 @@ func (p *parser) parseFunctionStatement() ast.Statement {
 		p.nextToken()
 	}
 
-	if p.curToken.Type == ast.TokenAssign {
+	if !isOperator && p.curToken.Type == ast.TokenAssign {
 		name += "="
 		p.nextToken()
 	}
@@ -630,6 +684,13 @@ func (p *parser) parseClassStatement() ast.Statement {
 		Name:     name,
 		Position: pos,
 	}
+	if p.curToken.Type == ast.TokenLT {
+		if !p.expectPeek(ast.TokenIdent) {
+			return nil
+		}
+		stmt.Superclass = p.curToken.Literal
+		p.nextToken()
+	}
 
 	prevInside := p.insideClass
 	prevPrivate := p.privateNext
@@ -667,6 +728,15 @@ func (p *parser) parseClassStatement() ast.Statement {
 		case ast.TokenProperty, ast.TokenGetter, ast.TokenSetter:
 			decl := p.parsePropertyDecl(p.curToken.Type)
 			stmt.Properties = append(stmt.Properties, decl)
+		case ast.TokenIdent:
+			if p.curToken.Literal == "include" && p.peekToken.Type == ast.TokenIdent && p.peekToken.Pos.Line == p.curToken.Pos.Line {
+				stmt.Includes = append(stmt.Includes, p.parseIncludeNames()...)
+				break
+			}
+			s := p.parseStatement()
+			if s != nil {
+				stmt.Body = append(stmt.Body, s)
+			}
 		default:
 			s := p.parseStatement()
 			if s != nil {
@@ -686,6 +756,86 @@ func (p *parser) parseClassStatement() ast.Statement {
 	return stmt
 }
 
+// parseIncludeNames parses the module list of an `include A, B` line in a
+// class body, leaving the current token on the last name.
+func (p *parser) parseIncludeNames() []string {
+	p.nextToken()
+	names := []string{p.curToken.Literal}
+	for p.peekToken.Type == ast.TokenComma {
+		p.nextToken()
+		if !p.expectPeek(ast.TokenIdent) {
+			return names
+		}
+		names = append(names, p.curToken.Literal)
+	}
+	return names
+}
+
+// parseModuleStatement parses a top-level mixin module. Its body holds only
+// instance method definitions, optionally marked private; classes pull them
+// in with include.
+func (p *parser) parseModuleStatement() ast.Statement {
+	pos := p.curToken.Pos
+	if p.insideClass || p.statementNesting > 0 {
+		p.addParseError(pos, "module is only supported at the top level")
+		return nil
+	}
+	if !p.expectPeek(ast.TokenIdent) {
+		return nil
+	}
+	stmt := &ast.ModuleStmt{
+		Name:     p.curToken.Literal,
+		Position: pos,
+	}
+	p.nextToken()
+
+	prevInside := p.insideClass
+	prevPrivate := p.privateNext
+	p.insideClass = true
+	p.privateNext = false
+	p.statementNesting++
+	defer func() {
+		p.statementNesting--
+		p.insideClass = prevInside
+		p.privateNext = prevPrivate
+	}()
+
+	for p.curToken.Type != ast.TokenEnd && p.curToken.Type != ast.TokenEOF {
+		p.skipStatementSeparators()
+		if p.curToken.Type == ast.TokenEnd || p.curToken.Type == ast.TokenEOF {
+			break
+		}
+		switch p.curToken.Type {
+		case ast.TokenDef:
+			fnStmt := p.parseFunctionStatement()
+			if fnStmt == nil {
+				return nil
+			}
+			fn := fnStmt.(*ast.FunctionStmt)
+			if fn.IsClassMethod {
+				p.addParseError(fn.Position, "module methods cannot be defined on self")
+				return nil
+			}
+			stmt.Methods = append(stmt.Methods, fn)
+		case ast.TokenPrivate:
+			p.privateNext = true
+			if p.peekToken.Type == ast.TokenDef {
+				p.nextToken()
+				continue
+			}
+		default:
+			p.addParseError(p.curToken.Pos, fmt.Sprintf("module %s may only contain method definitions", stmt.Name))
+			return nil
+		}
+		p.nextToken()
+	}
+
+	if p.curToken.Type != ast.TokenEnd {
+		p.errorExpected(p.curToken, "end")
+	}
+	return stmt
+}
+
 func (p *parser) parseEnumStatement() ast.Statement {
 	pos := p.curToken.Pos
 	if p.insideClass || p.statementNesting > 0 {
@@ -936,6 +1086,20 @@ func (p *parser) parseParam(options paramParseOptions) (ast.Param, ast.Position,
 	return param, pos, true
 }
 
+// isOperatorMethodName reports whether tt can name an operator method in a
+// class body. These are the binary operators the runtime dispatches to an
+// instance's method of the same name.
+func isOperatorMethodName(tt ast.TokenType) bool {
+	switch tt {
+	case ast.TokenPlus, ast.TokenMinus, ast.TokenAsterisk, ast.TokenSlash, ast.TokenPercent, ast.TokenPower,
+		ast.TokenEQ, ast.TokenNotEQ, ast.TokenLT, ast.TokenLTE, ast.TokenGT, ast.TokenGTE, ast.TokenSpaceship,
+		ast.TokenShovel:
+		return true
+	default:
+		return false
+	}
+}
+
 func isFunctionParamStart(tt ast.TokenType) bool {
 	switch tt {
 	case ast.TokenIdent, ast.TokenIvar, ast.TokenAsterisk, ast.TokenPower, ast.TokenAmpersand:
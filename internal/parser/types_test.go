@@ -425,3 +425,37 @@ func TestParserNullableSuffixSuggestionParses(t *testing.T) {
 		})
 	}
 }
+
+func TestParseTypeStandaloneAnnotations(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		source  string
+		want    string
+		wantErr string
+	}{
+		{source: "string", want: "string"},
+		{source: "int?", want: "int?"},
+		{source: "hash<string, array<int>>", want: "hash<string, array<int>>"},
+		{source: "int | nil", want: "int | nil"},
+		{source: "string string", wantErr: "unexpected"},
+		{source: "", wantErr: "parse error"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.source, func(t *testing.T) {
+			t.Parallel()
+			ty, err := ParseType(tc.source)
+			if tc.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+					t.Fatalf("ParseType(%q) err = %v, want %q", tc.source, err, tc.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseType(%q) err = %v", tc.source, err)
+			}
+			if got := ast.FormatTypeExpr(ty); got != tc.want {
+				t.Fatalf("ParseType(%q) = %q, want %q", tc.source, got, tc.want)
+			}
+		})
+	}
+}
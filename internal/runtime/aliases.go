@@ -2,6 +2,7 @@ package runtime
 
 import (
 	"fmt"
+	"math/big"
 	"reflect"
 	"time"
 
@@ -40,9 +41,11 @@ type (
 	UntilStmt      = ast.UntilStmt
 	BreakStmt      = ast.BreakStmt
 	NextStmt       = ast.NextStmt
+	RetryStmt      = ast.RetryStmt
 	TryStmt        = ast.TryStmt
 	PropertyDecl   = ast.PropertyDecl
 	ClassStmt      = ast.ClassStmt
+	ModuleStmt     = ast.ModuleStmt
 	EnumMemberStmt = ast.EnumMemberStmt
 	EnumStmt       = ast.EnumStmt
 
@@ -75,6 +78,7 @@ type (
 	CaseExpr           = ast.CaseExpr
 	BlockLiteral       = ast.BlockLiteral
 	YieldExpr          = ast.YieldExpr
+	SuperExpr          = ast.SuperExpr
 	InterpolatedString = ast.InterpolatedString
 	InterpolatedSymbol = ast.InterpolatedSymbol
 	StringPart         = ast.StringPart
@@ -140,6 +144,9 @@ const (
 	tokenAnd       = ast.TokenAnd
 	tokenOr        = ast.TokenOr
 	tokenAmpersand = ast.TokenAmpersand
+	tokenCaret     = ast.TokenCaret
+	tokenTilde     = ast.TokenTilde
+	tokenShiftR    = ast.TokenShiftRight
 	tokenQuestion  = ast.TokenQuestion
 	tokenComma     = ast.TokenComma
 	tokenColon     = ast.TokenColon
@@ -243,6 +250,7 @@ const (
 	KindEnumValue = value.KindEnumValue
 	KindClass     = value.KindClass
 	KindInstance  = value.KindInstance
+	KindBigInt    = value.KindBigInt
 )
 
 // NewNil returns a nil Value.
@@ -270,6 +278,8 @@ func NewHash(h map[string]Value) Value { return value.NewHash(h) }
 // string-key compatibility map.
 func NewTypedHash(capacity int) Value { return value.NewTypedHash(capacity) }
 
+func sortHashEntries(entries []HashEntry) { value.SortHashEntries(entries) }
+
 // NewHashWithDefault returns a hash Value carrying Ruby-style default metadata
 // (a default value and/or a default proc consulted on missing-key lookup).
 func NewHashWithDefault(h map[string]Value, defaultValue, defaultProc Value) Value {
@@ -301,6 +311,9 @@ func NewObject(attrs map[string]Value) Value { return value.NewObject(attrs) }
 // NewMoney returns a money Value.
 func NewMoney(m Money) Value { return value.NewMoney(m) }
 
+// NewBigInt returns an arbitrary-precision integer Value holding a copy of n.
+func NewBigInt(n *big.Int) Value { return value.NewBigInt(n) }
+
 // NewDuration returns a duration Value.
 func NewDuration(d Duration) Value { return value.NewDuration(d) }
 
@@ -757,6 +770,7 @@ func cloneClassForHostWithState(classDef *ClassDef, state hostValueCloneState) *
 		owner:        classDef.owner,
 	}
 	state.classes[classDef] = classClone
+	classClone.Superclass = cloneClassForHostWithState(classDef.Superclass, state)
 	for name, val := range classDef.ClassVars {
 		classClone.ClassVars[name] = cloneValueForHostWithState(val, state)
 	}
@@ -961,6 +975,16 @@ type Builtin struct {
 	// `probe(clonedReceiver)` still reports identity. Builtins with no bound
 	// receiver leave this nil.
 	BoundReceiver *boundReceiverClone
+	// matchesKind is set on the registered conversion builtins (Integer,
+	// Float, String, and Array) and reports whether a value belongs to the
+	// kind the builtin is named after, so `when Integer` and `Integer === x`
+	// test the kind. A host builtin registered under the same name leaves it
+	// nil and is not treated as a kind.
+	matchesKind func(Value) bool
+	// Members holds the namespace members of a builtin that is also a
+	// namespace, such as Array, which converts when called and exposes
+	// Array.new as a member. Builtins without members leave this nil.
+	Members map[string]Value
 	// Capability marks a builtin a capability adapter exposed for a single
 	// Script.Call. Capability grants are per call: when a closure that captured
 	// one (for example a `Hash.new { ... }` default proc copying a capability
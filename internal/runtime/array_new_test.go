@@ -0,0 +1,85 @@
+package runtime
+
+import "testing"
+
+func TestArrayNewBuildsPresizedArrays(t *testing.T) {
+	t.Parallel()
+
+	script := compileScript(t, `def bare()
+  Array.new
+end
+
+def empty_call()
+  Array.new()
+end
+
+def sized(n)
+  Array.new(n)
+end
+
+def with_default(n, value)
+  Array.new(n, value)
+end
+
+def from_block(n)
+  Array.new(n) { |i| i * i }
+end
+
+def partial_fill()
+  Array.new(5, 0).fill(9, 1, 2)
+end`)
+
+	tests := []struct {
+		name string
+		fn   string
+		args []Value
+		want []Value
+	}{
+		{name: "bare", fn: "bare", want: []Value{}},
+		{name: "empty call", fn: "empty_call", want: []Value{}},
+		{name: "size only pads nil", fn: "sized", args: []Value{NewInt(2)}, want: []Value{NewNil(), NewNil()}},
+		{name: "zero size", fn: "with_default", args: []Value{NewInt(0), NewString("x")}, want: []Value{}},
+		{name: "default value", fn: "with_default", args: []Value{NewInt(3), NewString("x")}, want: []Value{NewString("x"), NewString("x"), NewString("x")}},
+		{name: "block", fn: "from_block", args: []Value{NewInt(4)}, want: []Value{NewInt(0), NewInt(1), NewInt(4), NewInt(9)}},
+		{name: "partial fill", fn: "partial_fill", want: []Value{NewInt(0), NewInt(9), NewInt(9), NewInt(0), NewInt(0)}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			compareArrays(t, callFunc(t, script, tc.fn, tc.args), tc.want)
+		})
+	}
+}
+
+func TestArrayNewArgumentErrors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		expr string
+		want string
+	}{
+		{name: "negative size", expr: "Array.new(-1)", want: "Array.new size must be a non-negative integer"},
+		{name: "non-integer size", expr: `Array.new("3")`, want: "Array.new size must be a non-negative integer"},
+		{name: "too many args", expr: "Array.new(1, 2, 3)", want: "Array.new expects a size and an optional default value"},
+		{name: "default and block", expr: "Array.new(2, 0) { |i| i }", want: "Array.new cannot take both a default value and a block"},
+		{name: "block without size", expr: "Array.new() { |i| i }", want: "Array.new with a block expects a size"},
+		{name: "keywords", expr: "Array.new(2, fill: 0)", want: "Array.new does not accept keyword arguments"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			script := compileScript(t, "def run()\n  "+tc.expr+"\nend")
+			requireCallErrorContains(t, script, "run", nil, CallOptions{}, tc.want)
+		})
+	}
+}
+
+func TestArrayNewStepQuota(t *testing.T) {
+	t.Parallel()
+
+	script := compileScriptWithConfig(t, Config{StepQuota: 200, MemoryQuotaBytes: 4 << 20}, `def run()
+  Array.new(10_000, 0)
+end`)
+	requireCallErrorContains(t, script, "run", nil, CallOptions{}, "step quota exceeded")
+}
@@ -0,0 +1,138 @@
+package runtime
+
+import (
+	"fmt"
+	"math"
+	"slices"
+)
+
+func arrayMemberStatistics(property string) (Value, error) {
+	name := "array." + property
+	switch property {
+	case "average", "mean":
+		return NewAutoBuiltin(name, func(exec *Execution, receiver Value, args []Value, kwargs map[string]Value, block Value) (Value, error) {
+			values, err := arrayStatisticsValues(exec, name, receiver, args, kwargs, block)
+			if err != nil {
+				return NewNil(), err
+			}
+			return NewFloat(floatMean(values)), nil
+		}), nil
+	case "median":
+		return NewAutoBuiltin(name, func(exec *Execution, receiver Value, args []Value, kwargs map[string]Value, block Value) (Value, error) {
+			values, err := arrayStatisticsValues(exec, name, receiver, args, kwargs, block)
+			if err != nil {
+				return NewNil(), err
+			}
+			if slices.ContainsFunc(values, math.IsNaN) {
+				return NewNil(), fmt.Errorf("%s cannot order NaN", name)
+			}
+			slices.Sort(values)
+			mid := len(values) / 2
+			if len(values)%2 == 1 {
+				return NewFloat(values[mid]), nil
+			}
+			return NewFloat((values[mid-1] + values[mid]) / 2), nil
+		}), nil
+	case "variance", "standard_deviation":
+		return NewAutoBuiltin(name, func(exec *Execution, receiver Value, args []Value, kwargs map[string]Value, block Value) (Value, error) {
+			sample := false
+			if flag, ok := kwargs["sample"]; ok {
+				if flag.Kind() != KindBool {
+					return NewNil(), fmt.Errorf("%s sample must be true or false", name)
+				}
+				sample = flag.Bool()
+			}
+			values, err := arrayStatisticsValues(exec, name, receiver, args, kwargs, block, "sample")
+			if err != nil {
+				return NewNil(), err
+			}
+			variance, err := floatVariance(name, values, sample)
+			if err != nil {
+				return NewNil(), err
+			}
+			if property == "standard_deviation" {
+				return NewFloat(math.Sqrt(variance)), nil
+			}
+			return NewFloat(variance), nil
+		}), nil
+	default:
+		return NewNil(), fmt.Errorf("unknown array method %s", property)
+	}
+}
+
+// arrayStatisticsValues collects the numbers a statistics member works on:
+// the receiver's elements, or with a block the block's result for each
+// element, so players.average { |p| p[:score] } needs no separate map. Every
+// value must be an int or float, and an empty receiver is an error because
+// its statistics are undefined. One step is charged per element. keywords
+// lists the keyword arguments the member accepts.
+func arrayStatisticsValues(exec *Execution, name string, receiver Value, args []Value, kwargs map[string]Value, block Value, keywords ...string) ([]float64, error) {
+	if len(args) > 0 {
+		return nil, fmt.Errorf("%s does not take arguments", name)
+	}
+	for key := range kwargs {
+		if !slices.Contains(keywords, key) {
+			return nil, fmt.Errorf("%s does not accept keyword %s", name, key)
+		}
+	}
+	arr := receiver.Array()
+	if len(arr) == 0 {
+		return nil, fmt.Errorf("%s requires a non-empty array", name)
+	}
+	var runner *blockCallRunner
+	if valueBlock(block) != nil {
+		var err error
+		runner, err = newBlockCallRunner(exec, block, name, receiver, args, kwargs)
+		if err != nil {
+			return nil, err
+		}
+	}
+	values := make([]float64, 0, len(arr))
+	var blockArg [1]Value
+	for _, item := range arr {
+		if err := exec.step(); err != nil {
+			return nil, err
+		}
+		if runner != nil {
+			blockArg[0] = item
+			result, err := runner.call(blockArg[:])
+			if err != nil {
+				return nil, err
+			}
+			item = result
+		}
+		switch item.Kind() {
+		case KindInt, KindFloat:
+			values = append(values, item.Float())
+		default:
+			return nil, fmt.Errorf("%s expects numeric values, got %s", name, item.Kind())
+		}
+	}
+	return values, nil
+}
+
+func floatMean(values []float64) float64 {
+	total := 0.0
+	for _, v := range values {
+		total += v
+	}
+	return total / float64(len(values))
+}
+
+// floatVariance is the population variance of values, or with sample the
+// sample variance, which divides by n-1 and so needs at least two values.
+func floatVariance(name string, values []float64, sample bool) (float64, error) {
+	n := float64(len(values))
+	if sample {
+		if len(values) < 2 {
+			return 0, fmt.Errorf("%s sample: requires at least two values", name)
+		}
+		n--
+	}
+	mean := floatMean(values)
+	squares := 0.0
+	for _, v := range values {
+		squares += (v - mean) * (v - mean)
+	}
+	return squares / n, nil
+}
@@ -0,0 +1,98 @@
+package runtime
+
+import "testing"
+
+func TestArrayAverageAndMedian(t *testing.T) {
+	t.Parallel()
+
+	script := compileScript(t, `def players()
+  [
+    { name: "alex", score: 5 },
+    { name: "bea", score: 9 },
+    { name: "cam", score: 7 }
+  ]
+end
+
+def run()
+  [
+    players.average { |p| p[:score] },
+    players.mean { |p| p[:score] },
+    [1, 2].average,
+    [1.5, 2.5, 4].mean,
+    players.median { |p| p[:score] },
+    [4, 1, 3, 2].median,
+    [7].median
+  ]
+end
+
+def empty_average()
+  [].average
+end
+
+def non_numeric()
+  [1, "2"].mean
+end
+
+def keyword()
+  [1, 2].average(sample: true)
+end
+
+def median_by_name()
+  players.median { |p| p[:name] }
+end`)
+
+	compareArrays(t, callFunc(t, script, "run", nil), []Value{
+		NewFloat(7),
+		NewFloat(7),
+		NewFloat(1.5),
+		NewFloat(8.0 / 3.0),
+		NewFloat(7),
+		NewFloat(2.5),
+		NewFloat(7),
+	})
+	requireCallErrorContains(t, script, "empty_average", nil, CallOptions{}, "array.average requires a non-empty array")
+	requireCallErrorContains(t, script, "non_numeric", nil, CallOptions{}, "array.mean expects numeric values, got string")
+	requireCallErrorContains(t, script, "keyword", nil, CallOptions{}, "array.average does not accept keyword sample")
+	requireCallErrorContains(t, script, "median_by_name", nil, CallOptions{}, "array.median expects numeric values, got string")
+}
+
+func TestArrayVarianceAndStandardDeviation(t *testing.T) {
+	t.Parallel()
+
+	script := compileScript(t, `def run()
+  data = [2, 4, 4, 4, 5, 5, 7, 9]
+  rows = data.map { |n| { value: n } }
+  [
+    data.variance,
+    data.standard_deviation,
+    data.variance(sample: true),
+    rows.variance { |r| r[:value] },
+    rows.standard_deviation(sample: false) { |r| r[:value] },
+    [3.5].variance
+  ]
+end
+
+def empty_variance()
+  [].variance
+end
+
+def single_sample()
+  [1].standard_deviation(sample: true)
+end
+
+def bad_keyword()
+  [1, 2].variance(population: true)
+end`)
+
+	compareArrays(t, callFunc(t, script, "run", nil), []Value{
+		NewFloat(4),
+		NewFloat(2),
+		NewFloat(32.0 / 7.0),
+		NewFloat(4),
+		NewFloat(2),
+		NewFloat(0),
+	})
+	requireCallErrorContains(t, script, "empty_variance", nil, CallOptions{}, "array.variance requires a non-empty array")
+	requireCallErrorContains(t, script, "single_sample", nil, CallOptions{}, "array.standard_deviation sample: requires at least two values")
+	requireCallErrorContains(t, script, "bad_keyword", nil, CallOptions{}, "array.variance does not accept keyword population")
+}
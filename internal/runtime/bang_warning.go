@@ -0,0 +1,99 @@
+package runtime
+
+import (
+	"fmt"
+	"strings"
+)
+
+// discardedMutatorNames lists the non-bang methods that mutate the receiver
+// in Ruby but return a new array or hash here, so discarding their result is
+// the same mistake as discarding a bang call.
+var discardedMutatorNames = map[string]bool{
+	"delete_if": true,
+	"update":    true,
+	"replace":   true,
+	"store":     true,
+	"delete":    true,
+}
+
+// evalDiscardingStatement evaluates a statement whose value the enclosing
+// body does not use. A bang call in that position is almost always a
+// mistaken attempt at in-place mutation, so the call's member expression is
+// marked for warnDiscardedBang while the statement runs. A loop or if
+// statement in that position discards its body's value too, so it is marked
+// through discardBody and checks the final statement of its body as well.
+func (exec *Execution) evalDiscardingStatement(stmt Statement, env *Env) (Value, bool, error) {
+	switch stmt.(type) {
+	case *IfStmt, *ForStmt, *WhileStmt, *UntilStmt:
+		exec.discardBody = true
+		return exec.evalStatement(stmt, env)
+	}
+	member := discardedBangMember(stmt)
+	if member == nil {
+		return exec.evalStatement(stmt, env)
+	}
+	prev := exec.discardedBang
+	exec.discardedBang = member
+	defer func() { exec.discardedBang = prev }()
+	return exec.evalStatement(stmt, env)
+}
+
+// takeDiscardBody reports whether the loop or if statement being evaluated
+// was marked by evalDiscardingStatement and clears the mark, so it does not
+// leak into statements evaluated while the body runs.
+func (exec *Execution) takeDiscardBody() bool {
+	discard := exec.discardBody
+	exec.discardBody = false
+	return discard
+}
+
+// discardedBangMember returns the member expression of an expression
+// statement that calls a bang method or one of discardedMutatorNames, with or
+// without parentheses.
+func discardedBangMember(stmt Statement) *MemberExpr {
+	exprStmt, ok := stmt.(*ExprStmt)
+	if !ok {
+		return nil
+	}
+	var member *MemberExpr
+	switch e := exprStmt.Expr.(type) {
+	case *MemberExpr:
+		member = e
+	case *CallExpr:
+		member, _ = e.Callee.(*MemberExpr)
+	}
+	if member == nil || !strings.HasSuffix(member.Property, "!") && !discardedMutatorNames[member.Property] {
+		return nil
+	}
+	return member
+}
+
+// warnDiscardedBang writes a warning to the configured error writer when a
+// discarded call returns a new value instead of modifying its receiver: a
+// bang call on a string, array, or hash, or one of discardedMutatorNames on an
+// array or hash.
+func (exec *Execution) warnDiscardedBang(member *MemberExpr, receiver Value) {
+	method := member.Property
+	bang := strings.HasSuffix(method, "!")
+	var plural string
+	switch receiver.Kind() {
+	case KindString:
+		if !bang {
+			return
+		}
+		plural = "strings"
+	case KindArray:
+		plural = "arrays"
+	case KindHash:
+		plural = "hashes"
+	default:
+		return
+	}
+	writer := exec.engine.config.ErrorWriter
+	if writer == nil {
+		return
+	}
+	pos := member.Pos()
+	fmt.Fprintf(writer, "warning: %d:%d: result of %s %s is discarded; %s are immutable, so assign the result of %s instead\n",
+		pos.Line, pos.Column, receiver.Kind(), method, plural, strings.TrimSuffix(method, "!"))
+}
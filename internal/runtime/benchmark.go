@@ -0,0 +1,29 @@
+package runtime
+
+import "fmt"
+
+// builtinBenchmark runs its block once and reports what it cost: the block's
+// result, the interpreter steps it charged, and the elapsed time in seconds
+// as a float. The time comes from the call's clock, so it reads 0.0 under a
+// frozen CallOptions.Clock or during record and replay.
+func builtinBenchmark(exec *Execution, receiver Value, args []Value, kwargs map[string]Value, block Value) (Value, error) {
+	if len(args) > 0 || len(kwargs) > 0 {
+		return NewNil(), fmt.Errorf("benchmark does not take arguments")
+	}
+	runner, err := newBlockCallRunner(exec, block, "benchmark", NewNil(), nil, nil)
+	if err != nil {
+		return NewNil(), err
+	}
+	startSteps := exec.steps
+	start := exec.now()
+	result, err := runner.call(nil)
+	if err != nil {
+		return NewNil(), err
+	}
+	elapsed := exec.now().Sub(start)
+	return NewHash(map[string]Value{
+		"result":   result,
+		"steps":    NewInt(int64(exec.steps - startSteps)),
+		"duration": NewFloat(elapsed.Seconds()),
+	}), nil
+}
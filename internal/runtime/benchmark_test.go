@@ -0,0 +1,49 @@
+package runtime
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestBenchmarkReportsStepsAndResult(t *testing.T) {
+	t.Parallel()
+
+	script := compileScriptDefault(t, `def run
+  light = benchmark { 1 + 1 }
+  heavy = benchmark do
+    total = 0
+    for i in 1..50
+      total = total + i
+    end
+    total
+  end
+  [light, heavy]
+end
+
+def bad_args
+  benchmark(3) { 1 }
+end`)
+
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	ticks := 0
+	clock := func() time.Time {
+		ticks++
+		return start.Add(time.Duration(ticks) * 250 * time.Millisecond)
+	}
+	result := callScript(t, context.Background(), script, "run", nil, CallOptions{Clock: clock})
+	light := result.Array()[0].Hash()
+	heavy := result.Array()[1].Hash()
+
+	if !light["result"].Equal(NewInt(2)) || !heavy["result"].Equal(NewInt(1275)) {
+		t.Fatalf("results = %v, %v, want 2 and 1275", light["result"], heavy["result"])
+	}
+	if light["steps"].Int() <= 0 || heavy["steps"].Int() <= light["steps"].Int() {
+		t.Fatalf("steps light=%v heavy=%v, want heavy > light > 0", light["steps"], heavy["steps"])
+	}
+	if !light["duration"].Equal(NewFloat(0.25)) {
+		t.Fatalf("duration = %v, want 0.25 from the injected clock", light["duration"])
+	}
+
+	requireCallErrorContains(t, script, "bad_args", nil, CallOptions{}, "benchmark does not take arguments")
+}
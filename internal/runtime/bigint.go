@@ -0,0 +1,209 @@
+package runtime
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"strings"
+)
+
+// Bigints are arbitrary-precision integers. They are sticky: once a value is a
+// bigint, arithmetic with ints or other bigints stays a bigint even when the
+// result would fit in an int64, so a chain such as a factorial never falls
+// back into checked int64 arithmetic halfway through. `to_i` converts back
+// explicitly. Mixing a bigint with a float follows the int/float rule and
+// produces a float.
+
+func isIntegerValue(val Value) bool {
+	return val.Kind() == KindInt || val.Kind() == KindBigInt
+}
+
+func involvesBigInt(left, right Value) bool {
+	return left.Kind() == KindBigInt || right.Kind() == KindBigInt
+}
+
+// bigIntArithmetic applies +, -, *, /, or % when at least one operand is a
+// bigint. Division and modulo floor toward negative infinity like their int
+// counterparts. operation names the operator in unsupported-operand errors.
+func bigIntArithmetic(operator byte, operation string, left, right Value) (Value, error) {
+	if isIntegerValue(left) && isIntegerValue(right) {
+		l, r := left.BigInt(), right.BigInt()
+		switch operator {
+		case '+':
+			return NewBigInt(l.Add(l, r)), nil
+		case '-':
+			return NewBigInt(l.Sub(l, r)), nil
+		case '*':
+			return NewBigInt(l.Mul(l, r)), nil
+		case '/', '%':
+			if r.Sign() == 0 {
+				if operator == '%' {
+					return NewNil(), zeroDivisionErrorf("modulo by zero")
+				}
+				return NewNil(), newTypedRuntimeError(runtimeErrorTypeZeroDiv, errors.New("division by zero"))
+			}
+			quotient, remainder := l.QuoRem(l, r, new(big.Int))
+			if remainder.Sign() != 0 && remainder.Sign() != r.Sign() {
+				quotient.Sub(quotient, big.NewInt(1))
+				remainder.Add(remainder, r)
+			}
+			if operator == '%' {
+				return NewBigInt(remainder), nil
+			}
+			return NewBigInt(quotient), nil
+		}
+	}
+	if (isIntegerValue(left) || left.Kind() == KindFloat) && (isIntegerValue(right) || right.Kind() == KindFloat) && operator != '%' {
+		lf, rf := left.Float(), right.Float()
+		switch operator {
+		case '+':
+			return NewFloat(lf + rf), nil
+		case '-':
+			return NewFloat(lf - rf), nil
+		case '*':
+			return NewFloat(lf * rf), nil
+		case '/':
+			return NewFloat(lf / rf), nil
+		}
+	}
+	return NewNil(), fmt.Errorf("unsupported %s operands", operation)
+}
+
+// compareBigIntOrder orders a bigint against an int, float, or bigint. A NaN
+// operand is unordered.
+func compareBigIntOrder(left, right Value) (order int, ordered bool) {
+	if left.Kind() == KindFloat || right.Kind() == KindFloat {
+		lf, rf := new(big.Float), new(big.Float)
+		for _, pair := range []struct {
+			val Value
+			dst *big.Float
+		}{{left, lf}, {right, rf}} {
+			if pair.val.Kind() == KindFloat {
+				f := pair.val.Float()
+				if math.IsNaN(f) {
+					return 0, false
+				}
+				pair.dst.SetFloat64(f)
+			} else {
+				pair.dst.SetInt(pair.val.BigInt())
+			}
+		}
+		return lf.Cmp(rf), true
+	}
+	return left.BigInt().Cmp(right.BigInt()), true
+}
+
+// bigIntComparable reports whether a bigint can be ordered against the other
+// operand.
+func bigIntComparable(left, right Value) bool {
+	numeric := func(v Value) bool { return isIntegerValue(v) || v.Kind() == KindFloat }
+	return involvesBigInt(left, right) && numeric(left) && numeric(right)
+}
+
+// parseBigIntString parses a base-10 integer string with an optional sign and
+// `_` digit separators, matching the integer literal syntax.
+func parseBigIntString(text string) (*big.Int, bool) {
+	trimmed := strings.TrimSpace(text)
+	digits := strings.TrimLeft(trimmed, "+-")
+	if len(trimmed)-len(digits) > 1 || digits == "" || digits[0] == '_' || digits[len(digits)-1] == '_' || strings.Contains(digits, "__") {
+		return nil, false
+	}
+	n, ok := new(big.Int).SetString(strings.ReplaceAll(trimmed, "_", ""), 10)
+	return n, ok
+}
+
+// builtinBigInt implements bigint(value), which builds an arbitrary-precision
+// integer from an int, a bigint, an integral float, or a base-10 string.
+func builtinBigInt(exec *Execution, receiver Value, args []Value, kwargs map[string]Value, block Value) (Value, error) {
+	val, err := singleNumericBuiltinArg("bigint", args, kwargs, block)
+	if err != nil {
+		return NewNil(), err
+	}
+	switch val.Kind() {
+	case KindInt, KindBigInt:
+		return NewBigInt(val.BigInt()), nil
+	case KindFloat:
+		f := val.Float()
+		if math.IsNaN(f) || math.IsInf(f, 0) || f != math.Trunc(f) {
+			return NewNil(), fmt.Errorf("bigint argument must be an integral float")
+		}
+		n, _ := big.NewFloat(f).Int(nil)
+		return NewBigInt(n), nil
+	case KindString:
+		n, ok := parseBigIntString(val.String())
+		if !ok {
+			return NewNil(), fmt.Errorf("invalid bigint string %q", val.String())
+		}
+		return NewBigInt(n), nil
+	default:
+		return NewNil(), fmt.Errorf("bigint argument must be int, float, or string")
+	}
+}
+
+// bigintMemberNames lists the members exposed on bigint values. Keep it in
+// sync with bigintMember; it feeds "did you mean" suggestions and editor
+// completion.
+var bigintMemberNames = []string{"to_i", "to_int", "to_s", "string", "to_f", "zero?", "positive?", "negative?", "abs", "bit_length", "inspect"}
+
+func (exec *Execution) bigintMember(obj Value, property string, pos Position) (Value, error) {
+	switch property {
+	case "to_i", "to_int":
+		return NewAutoBuiltin("bigint."+property, func(exec *Execution, receiver Value, args []Value, kwargs map[string]Value, block Value) (Value, error) {
+			if err := requireNullaryCall("bigint."+property, args, kwargs, block); err != nil {
+				return NewNil(), err
+			}
+			n := receiver.BigInt()
+			if !n.IsInt64() {
+				return NewNil(), int64RangeError("bigint." + property)
+			}
+			return NewInt(n.Int64()), nil
+		}), nil
+	case "to_s", "string", "inspect":
+		return NewAutoBuiltin("bigint."+property, func(exec *Execution, receiver Value, args []Value, kwargs map[string]Value, block Value) (Value, error) {
+			if err := requireNullaryCall("bigint."+property, args, kwargs, block); err != nil {
+				return NewNil(), err
+			}
+			return NewString(receiver.String()), nil
+		}), nil
+	case "to_f":
+		return NewAutoBuiltin("bigint.to_f", func(exec *Execution, receiver Value, args []Value, kwargs map[string]Value, block Value) (Value, error) {
+			if err := requireNullaryCall("bigint.to_f", args, kwargs, block); err != nil {
+				return NewNil(), err
+			}
+			return NewFloat(receiver.Float()), nil
+		}), nil
+	case "zero?", "positive?", "negative?":
+		return NewAutoBuiltin("bigint."+property, func(exec *Execution, receiver Value, args []Value, kwargs map[string]Value, block Value) (Value, error) {
+			if err := requireNullaryCall("bigint."+property, args, kwargs, block); err != nil {
+				return NewNil(), err
+			}
+			sign := receiver.BigInt().Sign()
+			switch property {
+			case "zero?":
+				return NewBool(sign == 0), nil
+			case "positive?":
+				return NewBool(sign > 0), nil
+			default:
+				return NewBool(sign < 0), nil
+			}
+		}), nil
+	case "abs":
+		return NewAutoBuiltin("bigint.abs", func(exec *Execution, receiver Value, args []Value, kwargs map[string]Value, block Value) (Value, error) {
+			if err := requireNullaryCall("bigint.abs", args, kwargs, block); err != nil {
+				return NewNil(), err
+			}
+			n := receiver.BigInt()
+			return NewBigInt(n.Abs(n)), nil
+		}), nil
+	case "bit_length":
+		return NewAutoBuiltin("bigint.bit_length", func(exec *Execution, receiver Value, args []Value, kwargs map[string]Value, block Value) (Value, error) {
+			if err := requireNullaryCall("bigint.bit_length", args, kwargs, block); err != nil {
+				return NewNil(), err
+			}
+			return NewInt(int64(bigIntBitLength(receiver.BigInt()))), nil
+		}), nil
+	default:
+		return NewNil(), exec.errorAt(pos, "unknown bigint method %s%s", property, didYouMean(property, bigintMemberNames))
+	}
+}
@@ -0,0 +1,143 @@
+package runtime
+
+import (
+	"math/big"
+	"testing"
+)
+
+func mustBigIntValue(t *testing.T, digits string) Value {
+	t.Helper()
+	n, ok := new(big.Int).SetString(digits, 10)
+	if !ok {
+		t.Fatalf("invalid bigint digits %q", digits)
+	}
+	return NewBigInt(n)
+}
+
+// TestBigIntFactorial computes factorials past the int64 range exactly.
+func TestBigIntFactorial(t *testing.T) {
+	t.Parallel()
+
+	script := compileScript(t, `def factorial(n)
+  acc = bigint(1)
+  for i in 1..n
+    acc *= i
+  end
+  acc
+end
+
+def run()
+  {
+    f20: factorial(20),
+    f21: factorial(21),
+    f30: factorial(30),
+    f30_s: factorial(30).to_s,
+    ratio: factorial(30) / factorial(28),
+  }
+end`)
+	got := callFunc(t, script, "run", nil)
+	if got.Kind() != KindHash {
+		t.Fatalf("expected hash, got %v", got.Kind())
+	}
+	compareHash(t, got.Hash(), map[string]Value{
+		"f20":   mustBigIntValue(t, "2432902008176640000"),
+		"f21":   mustBigIntValue(t, "51090942171709440000"),
+		"f30":   mustBigIntValue(t, "265252859812191058636308480000000"),
+		"f30_s": NewString("265252859812191058636308480000000"),
+		"ratio": mustBigIntValue(t, "870"),
+	})
+	if kind := got.Hash()["f20"].Kind(); kind != KindBigInt {
+		t.Fatalf("factorial(20) kind = %v, want bigint", kind)
+	}
+}
+
+func TestBigIntOperators(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		expr string
+		want Value
+	}{
+		{"bigint(5) + 3", mustBigIntValue(t, "8")},
+		{"3 - bigint(5)", mustBigIntValue(t, "-2")},
+		{"bigint(9223372036854775807) * 2", mustBigIntValue(t, "18446744073709551614")},
+		{"bigint(-7) / 2", mustBigIntValue(t, "-4")},
+		{"bigint(-7) % 3", mustBigIntValue(t, "2")},
+		{"7 % bigint(-3)", mustBigIntValue(t, "-2")},
+		{"-bigint(\"18446744073709551616\")", mustBigIntValue(t, "-18446744073709551616")},
+		{"bigint(\"1_000_000_000_000_000_000_000\")", mustBigIntValue(t, "1000000000000000000000")},
+		{"bigint(2.0e20)", mustBigIntValue(t, "200000000000000000000")},
+		{"bigint(3) + 0.5", NewFloat(3.5)},
+		{"bigint(3) == 3", NewBool(true)},
+		{"bigint(3) == 3.0", NewBool(true)},
+		{"bigint(3).eql?(3)", NewBool(false)},
+		{"bigint(\"18446744073709551616\") > 9223372036854775807", NewBool(true)},
+		{"bigint(2) < 2.5", NewBool(true)},
+		{"bigint(2) <=> 3", NewInt(-1)},
+		{"bigint(2) <=> \"a\"", NewNil()},
+		{"[bigint(3), 1, 2.5].sort", NewArray([]Value{NewInt(1), NewFloat(2.5), mustBigIntValue(t, "3")})},
+		{"max(bigint(3), 10)", NewInt(10)},
+		{"[1, 2].include?(bigint(2))", NewBool(true)},
+		{"bigint(42).to_i", NewInt(42)},
+		{"bigint(-42).abs", mustBigIntValue(t, "42")},
+		{"bigint(0).zero?", NewBool(true)},
+		{"bigint(2).to_f", NewFloat(2)},
+		{"JSON.stringify([bigint(\"18446744073709551616\")])", NewString("[18446744073709551616]")},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.expr, func(t *testing.T) {
+			t.Parallel()
+			got := evalExpr(t, tc.expr)
+			if got.Kind() != tc.want.Kind() || !got.Equal(tc.want) {
+				t.Fatalf("%s = %v (%v), want %v (%v)", tc.expr, got, got.Kind(), tc.want, tc.want.Kind())
+			}
+		})
+	}
+}
+
+func TestBigIntErrors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		expr string
+		want string
+	}{
+		{"bigint(\"18446744073709551616\").to_i", "bigint.to_i result out of int64 range"},
+		{"bigint(1) / 0", "division by zero"},
+		{"bigint(1) % bigint(0)", "modulo by zero"},
+		{"bigint(1) + \"a\"", "unsupported addition operands"},
+		{"bigint(1) % 1.5", "unsupported modulo operands"},
+		{"bigint(\"12x\")", `invalid bigint string "12x"`},
+		{"bigint(1.5)", "bigint argument must be an integral float"},
+		{"bigint(nil)", "bigint argument must be int, float, or string"},
+		{"bigint(1, 2)", "bigint expects one value"},
+		{"bigint(1).to_i(2)", "bigint.to_i does not take arguments"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.expr, func(t *testing.T) {
+			t.Parallel()
+			script := compileScript(t, "def run()\n  "+tc.expr+"\nend")
+			requireCallErrorContains(t, script, "run", nil, CallOptions{}, tc.want)
+		})
+	}
+}
+
+// TestBigIntSatisfiesIntAnnotations checks that bigints pass `int` and
+// `number` parameter types, since both name Ruby's unified Integer.
+func TestBigIntSatisfiesIntAnnotations(t *testing.T) {
+	t.Parallel()
+
+	script := compileScript(t, `def double(n: int) -> number
+  n * 2
+end
+
+def run()
+  double(bigint("9223372036854775807"))
+end`)
+	got := callFunc(t, script, "run", nil)
+	if want := mustBigIntValue(t, "18446744073709551614"); !got.Equal(want) {
+		t.Fatalf("double = %v, want %v", got, want)
+	}
+}
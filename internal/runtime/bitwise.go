@@ -0,0 +1,150 @@
+package runtime
+
+import (
+	"fmt"
+	"math/big"
+	"math/bits"
+)
+
+// maxBigIntShiftBits caps the shift count of a left shift that produces a
+// bigint, so a script cannot allocate an enormous integer with one `<<`.
+const maxBigIntShiftBits = 1 << 20
+
+// Bitwise operators work on the two's-complement representation of ints and
+// bigints, as in Ruby. Like the arithmetic operators, a bigint operand makes
+// the result a bigint. Floats and every other kind raise.
+
+func bitwiseOperationName(operator TokenType) string {
+	switch operator {
+	case tokenAmpersand:
+		return "bitwise and"
+	case tokenPipe:
+		return "bitwise or"
+	case tokenCaret:
+		return "bitwise xor"
+	case tokenShovel:
+		return "left shift"
+	default:
+		return "right shift"
+	}
+}
+
+// bitwiseValues applies `&`, `|`, or `^` to two integer operands.
+func bitwiseValues(operator TokenType, left, right Value) (Value, error) {
+	if !isIntegerValue(left) || !isIntegerValue(right) {
+		return NewNil(), fmt.Errorf("unsupported %s operands", bitwiseOperationName(operator))
+	}
+	if left.Kind() == KindInt && right.Kind() == KindInt {
+		l, r := left.Int(), right.Int()
+		switch operator {
+		case tokenAmpersand:
+			return NewInt(l & r), nil
+		case tokenPipe:
+			return NewInt(l | r), nil
+		default:
+			return NewInt(l ^ r), nil
+		}
+	}
+	l, r := left.BigInt(), right.BigInt()
+	switch operator {
+	case tokenAmpersand:
+		return NewBigInt(l.And(l, r)), nil
+	case tokenPipe:
+		return NewBigInt(l.Or(l, r)), nil
+	default:
+		return NewBigInt(l.Xor(l, r)), nil
+	}
+}
+
+// shiftValues applies `<<` or `>>` to an integer. Right shifts are
+// arithmetic, so negative values keep their sign, and a negative count shifts
+// the other way. An int left shift that loses bits follows the execution's
+// integer overflow policy.
+func (exec *Execution) shiftValues(operator TokenType, left, right Value) (Value, error) {
+	operation := bitwiseOperationName(operator)
+	if !isIntegerValue(left) || !isIntegerValue(right) {
+		return NewNil(), fmt.Errorf("unsupported %s operands", operation)
+	}
+	countBig := right.BigInt()
+	if !countBig.IsInt64() {
+		return NewNil(), fmt.Errorf("%s count out of int64 range", operation)
+	}
+	count := countBig.Int64()
+	leftShift := operator == tokenShovel
+	if count < 0 {
+		leftShift = !leftShift
+		if count == -count {
+			return NewNil(), fmt.Errorf("%s count out of int64 range", operation)
+		}
+		count = -count
+	}
+
+	if left.Kind() == KindBigInt {
+		n := left.BigInt()
+		if leftShift {
+			if n.Sign() != 0 && count > maxBigIntShiftBits {
+				return NewNil(), guardLimitErrorf("%s count exceeds limit %d bits", operation, maxBigIntShiftBits)
+			}
+			if n.Sign() == 0 {
+				return NewBigInt(n), nil
+			}
+			return NewBigInt(n.Lsh(n, uint(count))), nil
+		}
+		return NewBigInt(n.Rsh(n, uint(count))), nil
+	}
+
+	n := left.Int()
+	if !leftShift {
+		return NewInt(n >> uint64(count)), nil
+	}
+	if n == 0 {
+		return NewInt(0), nil
+	}
+	if count < 64 {
+		if shifted := n << uint64(count); shifted>>uint64(count) == n {
+			return NewInt(shifted), nil
+		}
+	}
+	switch exec.intOverflow {
+	case IntOverflowWrap:
+		return NewInt(n << uint64(count)), nil
+	case IntOverflowPromote:
+		if count > maxBigIntShiftBits {
+			return NewNil(), guardLimitErrorf("%s count exceeds limit %d bits", operation, maxBigIntShiftBits)
+		}
+		promoted := big.NewInt(n)
+		return NewBigInt(promoted.Lsh(promoted, uint(count))), nil
+	default:
+		return NewNil(), int64RangeError("integer " + operation)
+	}
+}
+
+// complementValue applies unary `~`, which is `-n - 1` for every integer and
+// so never overflows.
+func complementValue(val Value) (Value, error) {
+	switch val.Kind() {
+	case KindInt:
+		return NewInt(^val.Int()), nil
+	case KindBigInt:
+		n := val.BigInt()
+		return NewBigInt(n.Not(n)), nil
+	default:
+		return NewNil(), fmt.Errorf("unsupported unary ~ operand")
+	}
+}
+
+// intBitLength returns the number of bits needed to represent n in two's
+// complement, excluding the sign bit, matching Ruby's Integer#bit_length.
+func intBitLength(n int64) int {
+	if n < 0 {
+		n = ^n
+	}
+	return bits.Len64(uint64(n))
+}
+
+func bigIntBitLength(n *big.Int) int {
+	if n.Sign() < 0 {
+		return new(big.Int).Not(n).BitLen()
+	}
+	return n.BitLen()
+}
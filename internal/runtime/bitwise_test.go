@@ -0,0 +1,132 @@
+package runtime
+
+import (
+	"context"
+	"math"
+	"strings"
+	"testing"
+)
+
+func TestBitwiseOperators(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		expr string
+		want Value
+	}{
+		{"0b1100 & 0b1010", NewInt(0b1000)},
+		{"0b1100 | 0b1010", NewInt(0b1110)},
+		{"0b1100 ^ 0b1010", NewInt(0b0110)},
+		{"~0", NewInt(-1)},
+		{"~5", NewInt(-6)},
+		{"-1 & 0xff", NewInt(0xff)},
+		{"0xdeadbeef & 0xffff", NewInt(0xbeef)},
+		{"(0xdeadbeef >> 16) & 0xffff", NewInt(0xdead)},
+		{"flags = 0\n  flags = flags | (1 << 3)\n  flags & (1 << 3) != 0", NewBool(true)},
+		{"1 << 10", NewInt(1024)},
+		{"1 << 62", NewInt(1 << 62)},
+		{"-1 << 63", NewInt(math.MinInt64)},
+		{"1024 >> 3", NewInt(128)},
+		{"-16 >> 2", NewInt(-4)},
+		{"-1 >> 100", NewInt(-1)},
+		{"1 >> 64", NewInt(0)},
+		{"1 >> -3", NewInt(8)},
+		{"8 << -3", NewInt(1)},
+		{"0 << 1000", NewInt(0)},
+		{"1 + 2 << 1", NewInt(6)},
+		{"6 & 3 == 2", NewBool(true)},
+		{"1 | 2 & 4", NewInt(1)},
+		{"bigint(1) << 70", mustBigIntValue(t, "1180591620717411303424")},
+		{"(bigint(1) << 70) >> 69", mustBigIntValue(t, "2")},
+		{"bigint(-5) & 0xff", mustBigIntValue(t, "251")},
+		{"~bigint(\"18446744073709551616\")", mustBigIntValue(t, "-18446744073709551617")},
+		{"0.bit_length", NewInt(0)},
+		{"255.bit_length", NewInt(8)},
+		{"256.bit_length", NewInt(9)},
+		{"(-256).bit_length", NewInt(8)},
+		{"(-257).bit_length", NewInt(9)},
+		{"9223372036854775807.bit_length", NewInt(63)},
+		{"(bigint(1) << 100).bit_length", NewInt(101)},
+		{"10.to_s(2)", NewString("1010")},
+		{"[1, 2, 3] & [2, 3, 4]", NewArray([]Value{NewInt(2), NewInt(3)})},
+		{"[1] << 2", NewArray([]Value{NewInt(1), NewInt(2)})},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.expr, func(t *testing.T) {
+			t.Parallel()
+			script := compileScript(t, "def run()\n  "+tc.expr+"\nend")
+			got := callFunc(t, script, "run", nil)
+			if got.Kind() != tc.want.Kind() || !got.Equal(tc.want) {
+				t.Fatalf("%s = %v (%v), want %v (%v)", tc.expr, got, got.Kind(), tc.want, tc.want.Kind())
+			}
+		})
+	}
+}
+
+func TestBitwiseOperatorErrors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		expr string
+		want string
+	}{
+		{"1.5 & 1", "unsupported bitwise and operands"},
+		{"1 | 2.0", "unsupported bitwise or operands"},
+		{"1 ^ \"a\"", "unsupported bitwise xor operands"},
+		{"1.0 << 2", "unsupported left shift operands"},
+		{"4 >> 1.0", "unsupported right shift operands"},
+		{"\"a\" >> 1", "unsupported right shift operands"},
+		{"~1.5", "unsupported unary ~ operand"},
+		{"1 << 63", "integer left shift result out of int64 range"},
+		{"3 << 62", "integer left shift result out of int64 range"},
+		{"1 & [1]", "unsupported intersection operands"},
+		{"bigint(1) << 2_000_000", "left shift count exceeds limit 1048576 bits"},
+		{"1.bit_length(2)", "int.bit_length does not take arguments"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.expr, func(t *testing.T) {
+			t.Parallel()
+			script := compileScript(t, "def run()\n  "+tc.expr+"\nend")
+			requireCallErrorContains(t, script, "run", nil, CallOptions{}, tc.want)
+		})
+	}
+}
+
+// TestLeftShiftOverflowPolicies checks that `<<` follows Config.IntOverflow
+// like the arithmetic operators do.
+func TestLeftShiftOverflowPolicies(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		policy IntOverflowPolicy
+		expr   string
+		want   Value
+	}{
+		{name: "wrap", policy: IntOverflowWrap, expr: "3 << 62", want: NewInt(math.MinInt64 + (1 << 62))},
+		{name: "wrap past width", policy: IntOverflowWrap, expr: "1 << 64", want: NewInt(0)},
+		{name: "promote", policy: IntOverflowPromote, expr: "1 << 64", want: mustBigIntValue(t, "18446744073709551616")},
+		{name: "promote negative", policy: IntOverflowPromote, expr: "-3 << 63", want: mustBigIntValue(t, "-27670116110564327424")},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			script := compileScriptWithConfig(t, Config{IntOverflow: tc.policy}, "def run()\n  "+tc.expr+"\nend")
+			got, err := script.Call(context.Background(), "run", nil, CallOptions{})
+			if err != nil {
+				t.Fatalf("call failed: %v", err)
+			}
+			if got.Kind() != tc.want.Kind() || !got.Equal(tc.want) {
+				t.Fatalf("%s = %v (%v), want %v (%v)", tc.expr, got, got.Kind(), tc.want, tc.want.Kind())
+			}
+		})
+	}
+
+	script := compileScriptWithConfig(t, Config{IntOverflow: IntOverflowPromote}, "def run()\n  1 << 2_000_000\nend")
+	if _, err := script.Call(context.Background(), "run", nil, CallOptions{}); err == nil || !strings.Contains(err.Error(), "left shift count exceeds") {
+		t.Fatalf("expected shift count limit error, got %v", err)
+	}
+}
@@ -0,0 +1,92 @@
+package runtime
+
+import "testing"
+
+func TestBuiltinDenyListHidesBuiltins(t *testing.T) {
+	t.Parallel()
+
+	script := compileScriptWithConfig(t, Config{BuiltinDenyList: []string{"rand", "require"}}, `def roll
+  rand(6)
+end
+
+def load
+  require("helper")
+end
+
+def shadow
+  rand = 4
+  rand + 1
+end
+
+def allowed
+  [abs(-3), max(1, 9), Integer("7")]
+end`)
+
+	requireCallErrorContains(t, script, "roll", nil, CallOptions{}, "undefined variable rand")
+	requireCallErrorContains(t, script, "load", nil, CallOptions{AllowRequire: true}, "undefined variable require")
+	if got := callFunc(t, script, "shadow", nil); !got.Equal(NewInt(5)) {
+		t.Fatalf("shadow = %v, want 5", got)
+	}
+	compareArrays(t, callFunc(t, script, "allowed", nil), []Value{NewInt(3), NewInt(9), NewInt(7)})
+}
+
+func TestBuiltinAllowListLimitsBuiltins(t *testing.T) {
+	t.Parallel()
+
+	engine := MustNewEngine(Config{
+		BuiltinAllowList: []string{"abs", "rand"},
+		BuiltinDenyList:  []string{"rand"},
+	})
+	engine.RegisterBuiltin("double", func(exec *Execution, receiver Value, args []Value, kwargs map[string]Value, block Value) (Value, error) {
+		return NewInt(args[0].Int() * 2), nil
+	})
+	engine.RegisterBuiltin("triple", func(exec *Execution, receiver Value, args []Value, kwargs map[string]Value, block Value) (Value, error) {
+		return NewInt(args[0].Int() * 3), nil
+	})
+	script := compileScriptWithEngine(t, engine, `def allowed
+  [abs(-2), double(4)]
+end
+
+def unlisted
+  max(1, 2)
+end
+
+def host
+  triple(2)
+end
+
+def denied
+  rand(6)
+end`)
+
+	compareArrays(t, callFunc(t, script, "allowed", nil), []Value{NewInt(2), NewInt(8)})
+	requireCallErrorContains(t, script, "unlisted", nil, CallOptions{}, "undefined variable max")
+	if got := callFunc(t, script, "host", nil); !got.Equal(NewInt(6)) {
+		t.Fatalf("host = %v, want 6", got)
+	}
+	requireCallErrorContains(t, script, "denied", nil, CallOptions{}, "undefined variable rand")
+}
+
+func TestBuiltinListsRejectUnknownNames(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		cfg  Config
+		want string
+	}{
+		{name: "allow typo", cfg: Config{BuiltinAllowList: []string{"abs", "rnad"}}, want: `vibes: builtin allow-list names unknown builtin "rnad"`},
+		{name: "deny typo", cfg: Config{BuiltinDenyList: []string{"requre"}}, want: `vibes: builtin deny-list names unknown builtin "requre"`},
+		{name: "host builtin", cfg: Config{BuiltinDenyList: []string{"double"}}, want: `vibes: builtin deny-list names unknown builtin "double"`},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			_, err := NewEngine(tc.cfg)
+			if err == nil {
+				t.Fatalf("expected NewEngine to reject %+v", tc.cfg)
+			}
+			requireErrorContains(t, err, tc.want)
+		})
+	}
+}
@@ -114,18 +114,31 @@ func builtinWarn(exec *Execution, receiver Value, args []Value, kwargs map[strin
 }
 
 func builtinP(exec *Execution, receiver Value, args []Value, kwargs map[string]Value, block Value) (Value, error) {
+	return inspectOutput(exec, "p", args, kwargs, block)
+}
+
+// builtinPP is Ruby's pp: like p it writes each argument's inspect form and
+// returns the argument, so it can be spliced into an expression to debug it.
+func builtinPP(exec *Execution, receiver Value, args []Value, kwargs map[string]Value, block Value) (Value, error) {
+	return inspectOutput(exec, "pp", args, kwargs, block)
+}
+
+// inspectOutput writes each argument's inspect form on its own line and
+// returns nil for no arguments, the argument itself for one, and an array of
+// them for several.
+func inspectOutput(exec *Execution, name string, args []Value, kwargs map[string]Value, block Value) (Value, error) {
 	if len(kwargs) > 0 {
-		return NewNil(), fmt.Errorf("p does not accept keyword arguments")
+		return NewNil(), fmt.Errorf("%s does not accept keyword arguments", name)
 	}
 	if !block.IsNil() {
-		return NewNil(), fmt.Errorf("p does not accept blocks")
+		return NewNil(), fmt.Errorf("%s does not accept blocks", name)
 	}
 	writer := exec.engine.config.OutputWriter
 	if writer == nil {
-		return NewNil(), fmt.Errorf("p output writer is not configured")
+		return NewNil(), fmt.Errorf("%s output writer is not configured", name)
 	}
 	for _, arg := range args {
-		rendered, err := renderOutputValue(exec, "p", arg, true)
+		rendered, err := renderOutputValue(exec, name, arg, true)
 		if err != nil {
 			return NewNil(), err
 		}
@@ -143,10 +156,22 @@ func builtinP(exec *Execution, receiver Value, args []Value, kwargs map[string]V
 }
 
 func renderOutputValue(exec *Execution, method string, val Value, inspect bool) (string, error) {
+	return renderValueWithin(exec, method+" output", val, inspect, maxOutputHelperBytes)
+}
+
+// renderValueWithin renders val as to_s (or inspect) would, projecting the
+// size under the execution quotas first and rejecting a rendering larger than
+// limit bytes with a "<what> exceeds limit" error.
+func renderValueWithin(exec *Execution, what string, val Value, inspect bool, limit int) (string, error) {
 	var (
 		payload int
 		err     error
 	)
+	if !inspect {
+		if val, err = exec.instanceToS(val, Position{}); err != nil {
+			return "", err
+		}
+	}
 	if inspect {
 		payload, err = val.InspectByteLenBounded(exec.step)
 	} else {
@@ -155,16 +180,39 @@ func renderOutputValue(exec *Execution, method string, val Value, inspect bool)
 	if err != nil {
 		return "", err
 	}
-	if payload > maxOutputHelperBytes {
-		return "", guardLimitErrorf("%s output exceeds limit %d bytes", method, maxOutputHelperBytes)
+	if payload > limit {
+		return "", guardLimitErrorf("%s exceeds limit %d bytes", what, limit)
 	}
 	if err := exec.checkProjectedValueRendering(val, payload); err != nil {
 		return "", err
 	}
 	if inspect {
-		return val.InspectBounded(maxOutputHelperBytes)
+		return val.InspectBounded(limit)
 	}
-	return val.StringBounded(maxOutputHelperBytes)
+	return val.StringBounded(limit)
+}
+
+// instanceToS resolves the string form of an instance whose class defines
+// to_s, so puts, print, warn, and interpolation render it the way Ruby's
+// implicit to_s does. The method is called even when private, matching Ruby.
+// Any other value, or a to_s that returns a non-string, is returned unchanged
+// and renders with the default "<Class instance>" form.
+func (exec *Execution) instanceToS(val Value, pos Position) (Value, error) {
+	if val.Kind() != KindInstance {
+		return val, nil
+	}
+	fn, ok := valueInstance(val).Class.findMethod("to_s")
+	if !ok {
+		return val, nil
+	}
+	result, err := exec.callFunction(fn, val, nil, nil, NewNil(), pos)
+	if err != nil {
+		return NewNil(), err
+	}
+	if result.Kind() != KindString {
+		return val, nil
+	}
+	return result, nil
 }
 
 func builtinMoney(exec *Execution, receiver Value, args []Value, kwargs map[string]Value, block Value) (Value, error) {
@@ -211,7 +259,7 @@ func builtinNow(exec *Execution, receiver Value, args []Value, kwargs map[string
 	if len(args) > 0 {
 		return NewNil(), fmt.Errorf("now does not take arguments")
 	}
-	return NewString(time.Now().UTC().Format(time.RFC3339)), nil
+	return NewString(exec.now().UTC().Format(time.RFC3339)), nil
 }
 
 func builtinRand(exec *Execution, receiver Value, args []Value, kwargs map[string]Value, block Value) (Value, error) {
@@ -1327,19 +1375,34 @@ func builtinUUID(exec *Execution, receiver Value, args []Value, kwargs map[strin
 	if len(args) > 0 {
 		return NewNil(), fmt.Errorf("uuid does not take arguments")
 	}
-	if len(kwargs) > 0 {
-		return NewNil(), fmt.Errorf("uuid does not accept keyword arguments")
-	}
 	if !block.IsNil() {
 		return NewNil(), fmt.Errorf("uuid does not accept blocks")
 	}
-	raw, err := exec.engine.randomBytes(exec.Context(), 16)
+	version := int64(7)
+	for key, val := range kwargs {
+		if key != "version" {
+			return NewNil(), fmt.Errorf("uuid does not accept keyword %s", key)
+		}
+		if val.Kind() != KindInt || (val.Int() != 4 && val.Int() != 7) {
+			return NewNil(), fmt.Errorf("uuid version must be 4 or 7")
+		}
+		version = val.Int()
+	}
+	raw, err := exec.entropy(16)
 	if err != nil {
 		return NewNil(), err
 	}
 
+	if version == 4 {
+		// RFC 9562 v4: every non-version, non-variant bit is random, so a
+		// seeded call yields the same id regardless of the clock.
+		raw[6] = (raw[6] & 0x0f) | 0x40
+		raw[8] = (raw[8] & 0x3f) | 0x80
+		return NewString(formatUUID(raw)), nil
+	}
+
 	// RFC 9562 v7: unix timestamp milliseconds + random bits.
-	nowMillis := uint64(time.Now().UTC().UnixMilli())
+	nowMillis := uint64(exec.now().UTC().UnixMilli())
 	raw[0] = byte(nowMillis >> 40)
 	raw[1] = byte(nowMillis >> 32)
 	raw[2] = byte(nowMillis >> 24)
@@ -1380,7 +1443,7 @@ func builtinRandomID(exec *Execution, receiver Value, args []Value, kwargs map[s
 	stalledReads := 0
 	for int64(len(chars)) < length {
 		needed := int(length) - len(chars)
-		raw, err := exec.engine.randomBytes(exec.Context(), needed)
+		raw, err := exec.entropy(needed)
 		if err != nil {
 			return NewNil(), err
 		}
@@ -1440,6 +1503,95 @@ func builtinJSONParse(exec *Execution, receiver Value, args []Value, kwargs map[
 	return value, nil
 }
 
+// builtinJSONParseLines parses newline-delimited JSON, one value per
+// non-blank line. A malformed line fails the whole call with its 1-based line
+// number and the column where parsing stopped.
+func builtinJSONParseLines(exec *Execution, receiver Value, args []Value, kwargs map[string]Value, block Value) (Value, error) {
+	if len(args) != 1 || args[0].Kind() != KindString {
+		return NewNil(), fmt.Errorf("JSON.parse_lines expects a single string argument")
+	}
+	if len(kwargs) > 0 {
+		return NewNil(), fmt.Errorf("JSON.parse_lines does not accept keyword arguments")
+	}
+	if !block.IsNil() {
+		return NewNil(), fmt.Errorf("JSON.parse_lines does not accept blocks")
+	}
+
+	raw := args[0].String()
+	if len(raw) > maxJSONPayloadBytes {
+		return NewNil(), guardLimitErrorf("JSON.parse_lines input exceeds limit %d bytes", maxJSONPayloadBytes)
+	}
+
+	values := []Value{}
+	for i, line := range strings.Split(raw, "\n") {
+		line = strings.TrimSuffix(line, "\r")
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		if err := exec.step(); err != nil {
+			return NewNil(), err
+		}
+		parser := jsonValueParser{raw: line, exec: exec}
+		value, err := parser.parse()
+		if err != nil {
+			return NewNil(), fmt.Errorf("JSON.parse_lines invalid JSON at line %d, column %d: %w", i+1, parser.pos+1, err)
+		}
+		values = append(values, value)
+	}
+	return NewArray(values), nil
+}
+
+// builtinJSONDig walks a dotted path such as "items.0.name" through parsed
+// JSON data. Integer segments index arrays, negative ones from the end, and
+// every other segment is a string hash key. Any miss, including indexing a
+// scalar, returns nil.
+func builtinJSONDig(exec *Execution, receiver Value, args []Value, kwargs map[string]Value, block Value) (Value, error) {
+	if len(args) != 2 || args[1].Kind() != KindString {
+		return NewNil(), fmt.Errorf("JSON.dig expects a value and a string path")
+	}
+	if len(kwargs) > 0 {
+		return NewNil(), fmt.Errorf("JSON.dig does not accept keyword arguments")
+	}
+	if !block.IsNil() {
+		return NewNil(), fmt.Errorf("JSON.dig does not accept blocks")
+	}
+
+	current := args[0]
+	path := args[1].String()
+	if path == "" {
+		return current, nil
+	}
+	for _, segment := range strings.Split(path, ".") {
+		switch current.Kind() {
+		case KindArray:
+			index, err := strconv.Atoi(segment)
+			if err != nil {
+				return NewNil(), nil
+			}
+			elems := current.Array()
+			if index < 0 {
+				index += len(elems)
+			}
+			if index < 0 || index >= len(elems) {
+				return NewNil(), nil
+			}
+			current = elems[index]
+		case KindHash, KindObject:
+			val, ok, err := hashGet(current, NewString(segment))
+			if err != nil {
+				return NewNil(), err
+			}
+			if !ok {
+				return NewNil(), nil
+			}
+			current = val
+		default:
+			return NewNil(), nil
+		}
+	}
+	return current, nil
+}
+
 func builtinJSONStringify(exec *Execution, receiver Value, args []Value, kwargs map[string]Value, block Value) (Value, error) {
 	if len(args) != 1 {
 		return NewNil(), fmt.Errorf("JSON.stringify expects a single value argument")
@@ -1558,6 +1710,29 @@ func builtinRegexpLastMatch(exec *Execution, receiver Value, args []Value, kwarg
 	return NewNil(), nil
 }
 
+func builtinBlank(exec *Execution, receiver Value, args []Value, kwargs map[string]Value, block Value) (Value, error) {
+	return blankBuiltinResult("blank?", false, args, kwargs, block)
+}
+
+func builtinPresent(exec *Execution, receiver Value, args []Value, kwargs map[string]Value, block Value) (Value, error) {
+	return blankBuiltinResult("present?", true, args, kwargs, block)
+}
+
+// blankBuiltinResult backs the top-level blank?(value) and present?(value),
+// which answer like the value's own blank?/present? members.
+func blankBuiltinResult(name string, present bool, args []Value, kwargs map[string]Value, block Value) (Value, error) {
+	if len(args) != 1 {
+		return NewNil(), fmt.Errorf("%s expects a single value argument", name)
+	}
+	if len(kwargs) > 0 {
+		return NewNil(), fmt.Errorf("%s does not accept keyword arguments", name)
+	}
+	if !block.IsNil() {
+		return NewNil(), fmt.Errorf("%s does not accept blocks", name)
+	}
+	return NewBool(valueBlank(args[0]) != present), nil
+}
+
 func builtinToInt(exec *Execution, receiver Value, args []Value, kwargs map[string]Value, block Value) (Value, error) {
 	if len(args) != 1 {
 		return NewNil(), fmt.Errorf("to_int expects a single value argument")
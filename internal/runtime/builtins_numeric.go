@@ -0,0 +1,131 @@
+package runtime
+
+import (
+	"cmp"
+	"fmt"
+	"math"
+)
+
+// The global abs, sign, round, floor, and ceil builtins are free-function forms
+// of the per-kind numeric members, for scripts that hold a number without
+// knowing whether it is an int or a float. They dispatch on the argument kind
+// and share the member implementations, so `round(x, 2)` and `x.round(2)` always
+// agree, including their int64 overflow checks.
+
+func builtinAbs(exec *Execution, receiver Value, args []Value, kwargs map[string]Value, block Value) (Value, error) {
+	val, err := singleNumericBuiltinArg("abs", args, kwargs, block)
+	if err != nil {
+		return NewNil(), err
+	}
+	switch val.Kind() {
+	case KindInt:
+		n := val.Int()
+		if n == math.MinInt64 {
+			return NewNil(), fmt.Errorf("abs overflow")
+		}
+		if n < 0 {
+			return NewInt(-n), nil
+		}
+		return val, nil
+	case KindFloat:
+		return NewFloat(math.Abs(val.Float())), nil
+	case KindMoney:
+		m := val.Money()
+		if m.Cents() >= 0 {
+			return val, nil
+		}
+		negated, err := m.MulInt(-1)
+		if err != nil {
+			return NewNil(), fmt.Errorf("abs overflow")
+		}
+		return NewMoney(negated), nil
+	default:
+		return NewNil(), fmt.Errorf("abs argument must be int, float, or money")
+	}
+}
+
+// builtinSign returns -1, 0, or 1 as an int for the sign of an int, float, or
+// money value. A NaN has no sign and raises.
+func builtinSign(exec *Execution, receiver Value, args []Value, kwargs map[string]Value, block Value) (Value, error) {
+	val, err := singleNumericBuiltinArg("sign", args, kwargs, block)
+	if err != nil {
+		return NewNil(), err
+	}
+	switch val.Kind() {
+	case KindInt:
+		return NewInt(int64(cmp.Compare(val.Int(), 0))), nil
+	case KindFloat:
+		f := val.Float()
+		switch {
+		case math.IsNaN(f):
+			return NewNil(), fmt.Errorf("sign argument must not be NaN")
+		case f < 0:
+			return NewInt(-1), nil
+		case f > 0:
+			return NewInt(1), nil
+		default:
+			return NewInt(0), nil
+		}
+	case KindMoney:
+		return NewInt(int64(cmp.Compare(val.Money().Cents(), 0))), nil
+	default:
+		return NewNil(), fmt.Errorf("sign argument must be int, float, or money")
+	}
+}
+
+func builtinRound(exec *Execution, receiver Value, args []Value, kwargs map[string]Value, block Value) (Value, error) {
+	return roundNumericBuiltin("round", args, kwargs, block)
+}
+
+func builtinFloor(exec *Execution, receiver Value, args []Value, kwargs map[string]Value, block Value) (Value, error) {
+	return roundNumericBuiltin("floor", args, kwargs, block)
+}
+
+func builtinCeil(exec *Execution, receiver Value, args []Value, kwargs map[string]Value, block Value) (Value, error) {
+	return roundNumericBuiltin("ceil", args, kwargs, block)
+}
+
+// roundNumericBuiltin implements round(x, places = 0), floor(x, places = 0),
+// and ceil(x, places = 0) with the same precision rules and result kinds as
+// the int and float members of the same name.
+func roundNumericBuiltin(name string, args []Value, kwargs map[string]Value, block Value) (Value, error) {
+	if len(kwargs) > 0 {
+		return NewNil(), fmt.Errorf("%s does not accept keyword arguments", name)
+	}
+	if valueBlock(block) != nil {
+		return NewNil(), fmt.Errorf("%s does not accept blocks", name)
+	}
+	if len(args) == 0 || len(args) > 2 {
+		return NewNil(), fmt.Errorf("%s expects a number and optional precision", name)
+	}
+	ndigits, err := roundDigitsArg(name, args[1:])
+	if err != nil {
+		return NewNil(), err
+	}
+	mode := roundModeFor(name)
+	switch args[0].Kind() {
+	case KindInt:
+		result, err := intRound(args[0].Int(), ndigits, mode, name)
+		if err != nil {
+			return NewNil(), err
+		}
+		return NewInt(result), nil
+	case KindFloat:
+		return floatRound(args[0].Float(), ndigits, mode, name)
+	default:
+		return NewNil(), fmt.Errorf("%s argument must be int or float", name)
+	}
+}
+
+func singleNumericBuiltinArg(name string, args []Value, kwargs map[string]Value, block Value) (Value, error) {
+	if len(kwargs) > 0 {
+		return NewNil(), fmt.Errorf("%s does not accept keyword arguments", name)
+	}
+	if valueBlock(block) != nil {
+		return NewNil(), fmt.Errorf("%s does not accept blocks", name)
+	}
+	if len(args) != 1 {
+		return NewNil(), fmt.Errorf("%s expects one value", name)
+	}
+	return args[0], nil
+}
@@ -0,0 +1,87 @@
+package runtime
+
+import "testing"
+
+// TestPolymorphicNumericBuiltins covers the free-function numeric helpers
+// across int, float, and money arguments.
+func TestPolymorphicNumericBuiltins(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		expr string
+		want Value
+	}{
+		{"abs negative int", `abs(-5)`, NewInt(5)},
+		{"abs positive int", `abs(5)`, NewInt(5)},
+		{"abs negative float", `abs(-2.5)`, NewFloat(2.5)},
+		{"sign negative int", `sign(-7)`, NewInt(-1)},
+		{"sign zero int", `sign(0)`, NewInt(0)},
+		{"sign positive float", `sign(0.25)`, NewInt(1)},
+		{"sign negative float", `sign(-0.25)`, NewInt(-1)},
+		{"sign zero float", `sign(0.0)`, NewInt(0)},
+		{"sign negative money", `sign(money("1.00 USD") - money("3.00 USD"))`, NewInt(-1)},
+		{"round float", `round(3.14159)`, NewInt(3)},
+		{"round float places", `round(3.14159, 2)`, NewFloat(3.14)},
+		{"round int negative places", `round(1250, -2)`, NewInt(1300)},
+		{"round int", `round(7)`, NewInt(7)},
+		{"floor float", `floor(-1.5)`, NewInt(-2)},
+		{"floor float places", `floor(2.675, 2)`, NewFloat(2.67)},
+		{"ceil float", `ceil(1.1)`, NewInt(2)},
+		{"ceil int negative places", `ceil(1234, -2)`, NewInt(1300)},
+		{"matches member", `round(2.5) == 2.5.round && floor(-3.7) == (-3.7).floor`, NewBool(true)},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			got := evalExpr(t, tc.expr)
+			if got.Kind() != tc.want.Kind() || !got.Equal(tc.want) {
+				t.Fatalf("%s = %v (%v), want %v (%v)", tc.expr, got, got.Kind(), tc.want, tc.want.Kind())
+			}
+		})
+	}
+}
+
+// TestAbsMoney verifies abs keeps the money kind and currency.
+func TestAbsMoney(t *testing.T) {
+	t.Parallel()
+
+	got := evalExpr(t, `abs(money("1.00 USD") - money("3.50 USD"))`)
+	want := mustMoneyValue(t, "2.50 USD")
+	if !got.Equal(want) {
+		t.Fatalf("abs(-2.50 USD) = %v, want %v", got, want)
+	}
+}
+
+// TestPolymorphicNumericBuiltinsReject verifies overflow checks and argument
+// validation match the per-kind members.
+func TestPolymorphicNumericBuiltinsReject(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		expr string
+		want string
+	}{
+		{"abs min int", `abs(-9223372036854775807 - 1)`, "abs overflow"},
+		{"abs string", `abs("1")`, "abs argument must be int, float, or money"},
+		{"abs arity", `abs(1, 2)`, "abs expects one value"},
+		{"sign nan", `sign(0.0 / 0.0)`, "sign argument must not be NaN"},
+		{"sign nil", `sign(nil)`, "sign argument must be int, float, or money"},
+		{"round money", `round(money("1.00 USD"))`, "round argument must be int or float"},
+		{"round float precision", `round(1.5, 1.0)`, "round precision must be an Integer"},
+		{"round arity", `round()`, "round expects a number and optional precision"},
+		{"floor overflow", `floor(1.0e300)`, "floor result out of int64 range"},
+		{"ceil int overflow", `ceil(9223372036854775807, -1)`, "ceil result out of int64 range"},
+		{"round kwargs", `round(1.5, digits: 1)`, "round does not accept keyword arguments"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			script := compileScript(t, "def run()\n  "+tc.expr+"\nend")
+			requireCallErrorContains(t, script, "run", nil, CallOptions{}, tc.want)
+		})
+	}
+}
@@ -0,0 +1,100 @@
+package runtime
+
+import "fmt"
+
+// The global min, max, and clamp builtins order values with the same natural
+// ordering as array.sort and array.min/array.max (arraySortCompareValues), so a
+// free-function call and the equivalent array method always agree. Mixing
+// kinds that cannot be ordered (for example a number with a string) or a NaN
+// operand raises instead of picking an arbitrary winner.
+
+func builtinMin(exec *Execution, receiver Value, args []Value, kwargs map[string]Value, block Value) (Value, error) {
+	return orderedExtremum(exec, "min", false, args, kwargs, block)
+}
+
+func builtinMax(exec *Execution, receiver Value, args []Value, kwargs map[string]Value, block Value) (Value, error) {
+	return orderedExtremum(exec, "max", true, args, kwargs, block)
+}
+
+// orderedExtremum implements min/max. The candidates are either every
+// positional argument or, when the only argument is an array, that array's
+// elements; an empty array yields nil like array.min. Ties resolve to the first
+// candidate, matching Ruby's Enumerable#min/#max.
+func orderedExtremum(exec *Execution, name string, wantMax bool, args []Value, kwargs map[string]Value, block Value) (Value, error) {
+	if len(kwargs) > 0 {
+		return NewNil(), fmt.Errorf("%s does not accept keyword arguments", name)
+	}
+	if valueBlock(block) != nil {
+		return NewNil(), fmt.Errorf("%s does not accept blocks", name)
+	}
+	if len(args) == 0 {
+		return NewNil(), fmt.Errorf("%s expects at least one value", name)
+	}
+	candidates := args
+	if len(args) == 1 && args[0].Kind() == KindArray {
+		candidates = args[0].Array()
+	}
+	if len(candidates) == 0 {
+		return NewNil(), nil
+	}
+	best := candidates[0]
+	for _, item := range candidates[1:] {
+		cmp, err := arraySortCompareValues(exec, item, best)
+		if err != nil {
+			if raisedByScript(err) {
+				return NewNil(), err
+			}
+			return NewNil(), fmt.Errorf("%s values are not comparable", name)
+		}
+		if (wantMax && cmp > 0) || (!wantMax && cmp < 0) {
+			best = item
+		}
+	}
+	return best, nil
+}
+
+// builtinClamp bounds value to the inclusive [lo, hi] interval. The bounds must
+// be ordered (lo <= hi) and comparable with the value.
+func builtinClamp(exec *Execution, receiver Value, args []Value, kwargs map[string]Value, block Value) (Value, error) {
+	if len(kwargs) > 0 {
+		return NewNil(), fmt.Errorf("clamp does not accept keyword arguments")
+	}
+	if valueBlock(block) != nil {
+		return NewNil(), fmt.Errorf("clamp does not accept blocks")
+	}
+	if len(args) != 3 {
+		return NewNil(), fmt.Errorf("clamp expects value, min, and max")
+	}
+	val, lo, hi := args[0], args[1], args[2]
+	bounds, err := arraySortCompareValues(exec, lo, hi)
+	if err != nil {
+		if raisedByScript(err) {
+			return NewNil(), err
+		}
+		return NewNil(), fmt.Errorf("clamp bounds are not comparable")
+	}
+	if bounds > 0 {
+		return NewNil(), fmt.Errorf("clamp min must be <= max")
+	}
+	below, err := arraySortCompareValues(exec, val, lo)
+	if err != nil {
+		if raisedByScript(err) {
+			return NewNil(), err
+		}
+		return NewNil(), fmt.Errorf("clamp value is not comparable with its bounds")
+	}
+	if below < 0 {
+		return lo, nil
+	}
+	above, err := arraySortCompareValues(exec, val, hi)
+	if err != nil {
+		if raisedByScript(err) {
+			return NewNil(), err
+		}
+		return NewNil(), fmt.Errorf("clamp value is not comparable with its bounds")
+	}
+	if above > 0 {
+		return hi, nil
+	}
+	return val, nil
+}
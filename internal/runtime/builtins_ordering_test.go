@@ -0,0 +1,95 @@
+package runtime
+
+import "testing"
+
+// TestOrderingBuiltins covers the global min, max, and clamp helpers over
+// numbers and strings, in both varargs and single-array forms.
+func TestOrderingBuiltins(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		expr string
+		want Value
+	}{
+		{"max ints", `max(3, 9, 4)`, NewInt(9)},
+		{"min ints", `min(3, 9, 4)`, NewInt(3)},
+		{"max mixed numeric", `max(1, 2.5, 2)`, NewFloat(2.5)},
+		{"min mixed numeric", `min(1.5, 1, 2)`, NewInt(1)},
+		{"max single value", `max(7)`, NewInt(7)},
+		{"min array", `min([2.5, 1, 7])`, NewInt(1)},
+		{"max array", `max([2.5, 1, 7])`, NewInt(7)},
+		{"min empty array", `min([])`, NewNil()},
+		{"max strings", `max("pear", "apple", "fig")`, NewString("pear")},
+		{"min strings", `min(["pear", "apple", "fig"])`, NewString("apple")},
+		{"min ties keep first", `min(1, 1.0)`, NewInt(1)},
+		{"clamp above", `clamp(15, 0, 10)`, NewInt(10)},
+		{"clamp below", `clamp(-3, 0, 10)`, NewInt(0)},
+		{"clamp inside", `clamp(4, 0, 10)`, NewInt(4)},
+		{"clamp float bounds", `clamp(4, 0.5, 2.5)`, NewFloat(2.5)},
+		{"clamp string", `clamp("m", "a", "f")`, NewString("f")},
+		{"clamp string inside", `clamp("c", "a", "f")`, NewString("c")},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			got := evalExpr(t, tc.expr)
+			if got.Kind() != tc.want.Kind() || !got.Equal(tc.want) {
+				t.Fatalf("%s = %v (%v), want %v (%v)", tc.expr, got, got.Kind(), tc.want, tc.want.Kind())
+			}
+		})
+	}
+}
+
+// TestOrderingBuiltinsRejectInvalidCalls verifies incomparable mixes and bad
+// call shapes raise instead of silently choosing a value.
+func TestOrderingBuiltinsRejectInvalidCalls(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		expr string
+		want string
+	}{
+		{"max number and string", `max(1, "a")`, "max values are not comparable"},
+		{"min array mix", `min([1, "a"])`, "min values are not comparable"},
+		{"min nan", `min(1, 0.0 / 0.0)`, "min values are not comparable"},
+		{"max no args", `max()`, "max expects at least one value"},
+		{"min kwargs", `min(1, by: 2)`, "min does not accept keyword arguments"},
+		{"max block", `max(1, 2) { |x| x }`, "max does not accept blocks"},
+		{"clamp arity", `clamp(1, 2)`, "clamp expects value, min, and max"},
+		{"clamp inverted bounds", `clamp(1, 10, 0)`, "clamp min must be <= max"},
+		{"clamp incomparable bounds", `clamp(1, 0, "z")`, "clamp bounds are not comparable"},
+		{"clamp incomparable value", `clamp("m", 0, 10)`, "clamp value is not comparable with its bounds"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			script := compileScript(t, "def run()\n  "+tc.expr+"\nend")
+			requireCallErrorContains(t, script, "run", nil, CallOptions{}, tc.want)
+		})
+	}
+}
+
+// TestOrderingBuiltinsYieldToScriptDefinitions verifies scripts that already
+// define their own min/max helpers or locals keep their meaning.
+func TestOrderingBuiltinsYieldToScriptDefinitions(t *testing.T) {
+	t.Parallel()
+
+	script := compileScript(t, `
+def max(a, b)
+  "script max"
+end
+
+def run()
+  min = 5
+  [max(1, 2), min]
+end
+`)
+	got := callFunc(t, script, "run", nil)
+	if got.Inspect() != `["script max", 5]` {
+		t.Fatalf("run() = %s, want [\"script max\", 5]", got.Inspect())
+	}
+}
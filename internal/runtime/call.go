@@ -120,6 +120,12 @@ func (exec *Execution) invokeCallable(callee, receiver Value, args []Value, kwar
 			}
 		}
 		contract, hasContract := exec.capabilityContracts[builtin]
+		// A dry run records mutating calls instead of running them, so strict
+		// effects, which only guards calls that reach the host, does not apply.
+		dryRun := hasContract && contract.Mutating && exec.callOptions.DryRun != nil
+		if hasContract && contract.Mutating && exec.strictEffects && !exec.callOptions.AllowEffects && !dryRun {
+			return NewNil(), exec.errorAt(pos, "strict effects: %s is disabled without CallOptions.AllowEffects", builtin.Name)
+		}
 		argsValidated := false
 		if hasContract && contract.ValidateArgs != nil {
 			if err := contract.ValidateArgs(args, kwargs, block); err != nil {
@@ -127,12 +133,33 @@ func (exec *Execution) invokeCallable(callee, receiver Value, args []Value, kwar
 			}
 			argsValidated = true
 		}
+		if hasContract {
+			if err := exec.spendCapabilityBudget(builtin.Name); err != nil {
+				return NewNil(), exec.wrapError(err, pos)
+			}
+			if exec.callOptions.CapabilityObserver != nil {
+				exec.callOptions.CapabilityObserver(builtin.Name, args)
+			}
+			if dryRun {
+				exec.callOptions.DryRun.record(builtin.Name, args, kwargs)
+				return NewNil(), nil
+			}
+		}
 
 		var popValidatedArgs func()
 		if argsValidated {
 			popValidatedArgs = exec.pushValidatedCapabilityArgs(builtin.Name)
 		}
-		result, err := builtin.Fn(exec, receiver, args, kwargs, block)
+		var result Value
+		var err error
+		if hasContract && exec.callOptions.Replay != nil {
+			result, err = exec.callOptions.replayCursor.take(exec.callOptions.Replay, builtin.Name)
+		} else {
+			result, err = builtin.Fn(exec, receiver, args, kwargs, block)
+			if hasContract && exec.callOptions.Record != nil {
+				exec.callOptions.Record.record(builtin.Name, result, err)
+			}
+		}
 		if popValidatedArgs != nil {
 			popValidatedArgs()
 		}
@@ -207,6 +234,9 @@ func (exec *Execution) callFunctionWithReturnValidation(fn *ScriptFunction, rece
 		callEnv.Define("self", receiver)
 	}
 	callEnv.setCallBlock(block)
+	if fn.className != "" {
+		callEnv.callMethod = &methodCall{fn: fn, args: args, kwargs: kwargs}
+	}
 	if err := exec.bindFunctionArgs(fn, callEnv, args, kwargs, pos); err != nil {
 		return NewNil(), err
 	}
@@ -216,7 +246,7 @@ func (exec *Execution) callFunctionWithReturnValidation(fn *ScriptFunction, rece
 		return NewNil(), err
 	}
 	exec.popEnv()
-	if err := exec.pushFrame(fn.Name, pos, exec.currentSourceScript(), fn.owner); err != nil {
+	if err := exec.pushFrame(fn.frameName(), pos, exec.currentSourceScript(), fn.owner); err != nil {
 		return NewNil(), err
 	}
 
@@ -636,6 +666,14 @@ func (r *callFunctionRebinder) rebindKeywords(kwargs map[string]Value) map[strin
 }
 
 func bindCapabilitiesForCall(exec *Execution, root *Env, rebinder *callFunctionRebinder, capabilities []CapabilityAdapter) error {
+	if exec.callOptions.Record != nil && exec.callOptions.Replay != nil {
+		return fmt.Errorf("CallOptions.Record and CallOptions.Replay are mutually exclusive")
+	}
+	for method, limit := range exec.callOptions.CapabilityBudgets {
+		if limit < 0 {
+			return fmt.Errorf("capability budget for %s must be non-negative", method)
+		}
+	}
 	if len(capabilities) == 0 {
 		return nil
 	}
@@ -651,6 +689,9 @@ func bindCapabilitiesForCall(exec *Execution, root *Env, rebinder *callFunctionR
 
 	binding := CapabilityBinding{Context: exec.ctx, Engine: exec.engine}
 	ambientEnvs := ambientEnvSet(root)
+	// provided tracks the globals bound so far, so a second adapter defining
+	// the same name fails instead of silently shadowing the first.
+	provided := make(map[string]struct{}, len(capabilities))
 	for _, adapter := range capabilities {
 		if adapter == nil {
 			continue
@@ -686,8 +727,13 @@ func bindCapabilitiesForCall(exec *Execution, root *Env, rebinder *callFunctionR
 			if err := exec.checkContext(); err != nil {
 				return err
 			}
+			if _, exists := provided[name]; exists {
+				return fmt.Errorf("capability global %q already provided", name)
+			}
+			provided[name] = struct{}{}
 			rebound := rebinder.rebindValue(val)
 			root.Define(name, rebound)
+			exec.recordCapability(name, rebound, scope.contracts)
 			if len(scope.contracts) > 0 {
 				scope.roots = append(scope.roots, rebound)
 			}
@@ -785,21 +831,44 @@ func prepareCallEnvForFunction(exec *Execution, root *Env, rebinder *callFunctio
 
 func newExecutionForCall(script *Script, ctx context.Context, root *Env, opts CallOptions) *Execution {
 	childCallOptions := CallOptions{
-		Globals:      opts.Globals,
-		Capabilities: opts.Capabilities,
-		AllowRequire: opts.AllowRequire,
+		Globals:            opts.Globals,
+		Capabilities:       opts.Capabilities,
+		AllowRequire:       opts.AllowRequire,
+		AllowEffects:       opts.AllowEffects,
+		CapabilityObserver: opts.CapabilityObserver,
+		CapabilityBudgets:  opts.CapabilityBudgets,
+		DryRun:             opts.DryRun,
+		Record:             opts.Record,
+		Replay:             opts.Replay,
+		Clock:              opts.Clock,
+		Cache:              opts.Cache,
+		Coverage:           opts.Coverage,
+		Profile:            opts.Profile,
+		capabilityBudget:   opts.capabilityBudget,
+		replayCursor:       opts.replayCursor,
+	}
+	if len(opts.CapabilityBudgets) > 0 && childCallOptions.capabilityBudget == nil {
+		childCallOptions.capabilityBudget = &capabilityBudget{spent: make(map[string]int, len(opts.CapabilityBudgets))}
+	}
+	if opts.Record != nil && opts.Replay == nil {
+		opts.Record.start(opts.Clock)
+	}
+	if opts.Replay != nil && childCallOptions.replayCursor == nil {
+		childCallOptions.replayCursor = &replayCursor{}
 	}
 	exec := &Execution{
-		engine:        script.engine,
-		script:        script,
-		ctx:           ctx,
-		quota:         script.engine.config.StepQuota,
-		memoryQuota:   script.engine.config.MemoryQuotaBytes,
-		recursionCap:  script.engine.config.RecursionLimit,
-		root:          root,
-		strictEffects: script.engine.config.StrictEffects,
-		allowRequire:  opts.AllowRequire,
-		callOptions:   childCallOptions,
+		engine:            script.engine,
+		script:            script,
+		ctx:               ctx,
+		quota:             script.engine.config.StepQuota,
+		memoryQuota:       script.engine.config.MemoryQuotaBytes,
+		recursionCap:      script.engine.config.RecursionLimit,
+		root:              root,
+		strictEffects:     script.engine.config.StrictEffects,
+		intOverflow:       script.engine.config.IntOverflow,
+		maxCollectionSize: script.engine.config.MaxCollectionSize,
+		allowRequire:      opts.AllowRequire,
+		callOptions:       childCallOptions,
 	}
 	// The module stacks stay nil: most calls never require a module,
 	// and append allocates them on first use.
@@ -807,6 +876,7 @@ func newExecutionForCall(script *Script, ctx context.Context, root *Env, opts Ca
 	exec.receiverStack = exec.receiverStackArr[:0]
 	exec.envStack = exec.envStackArr[:0]
 	exec.validatedCapabilityArgs = exec.validatedCapabilityArgsArr[:0]
+	exec.seedFromRecording()
 	return exec
 }
 
@@ -836,6 +906,11 @@ func (exec *Execution) evalCallTarget(call *CallExpr, env *Env) (Value, Value, e
 		return exec.evalIdentifierCallTarget(ident, env)
 	}
 
+	if super, ok := call.Callee.(*SuperExpr); ok {
+		callee, self, _, err := exec.resolveSuper(super, env)
+		return callee, self, err
+	}
+
 	callee, err := exec.evalExpressionWithAuto(call.Callee, env, false)
 	if err != nil {
 		return NewNil(), NewNil(), err
@@ -876,7 +951,7 @@ func (exec *Execution) evalDirectPublicMemberMethodCall(receiver Value, property
 			return NewNil(), false, nil
 		}
 		classDef := valueClass(receiver)
-		fn, ok := classDef.ClassMethods[property]
+		fn, ok := classDef.findClassMethod(property)
 		if !ok {
 			return NewNil(), false, nil
 		}
@@ -886,7 +961,7 @@ func (exec *Execution) evalDirectPublicMemberMethodCall(receiver Value, property
 		return NewFunction(fn), true, nil
 	case KindInstance:
 		instance := valueInstance(receiver)
-		fn, ok := instance.Class.Methods[property]
+		fn, ok := instance.Class.findMethod(property)
 		if !ok {
 			return NewNil(), false, nil
 		}
@@ -1134,6 +1209,13 @@ func (exec *Execution) evalCallExpr(call *CallExpr, env *Env) (Value, error) {
 	if err != nil {
 		return NewNil(), err
 	}
+	if _, ok := call.Callee.(*SuperExpr); ok && call.Block == nil {
+		// super(...) passes the running method's block along unless the call
+		// supplies its own, as in Ruby.
+		if current, ok := env.lookupCallBlock(); ok {
+			block = current
+		}
+	}
 	if err := exec.checkContext(); err != nil {
 		return NewNil(), err
 	}
@@ -1143,7 +1225,7 @@ func (exec *Execution) evalCallExpr(call *CallExpr, env *Env) (Value, error) {
 
 	result, callErr := exec.invokeCallable(callee, receiver, args, kwargs, block, call.Pos())
 	if callErr != nil {
-		return NewNil(), callErr
+		return NewNil(), exec.underlineCall(callErr, call)
 	}
 	if err := exec.checkMemoryWith(result); err != nil {
 		return NewNil(), err
@@ -1172,6 +1254,9 @@ func (exec *Execution) evalMemberCallExpr(call *CallExpr, member *MemberExpr, en
 	if call.Safe && receiver.Kind() == KindNil {
 		return NewNil(), nil
 	}
+	if member == exec.discardedBang {
+		exec.warnDiscardedBang(member, receiver)
+	}
 	if err := exec.checkMemoryWith(receiver); err != nil {
 		return NewNil(), err
 	}
@@ -1218,7 +1303,7 @@ func (exec *Execution) evalMemberCallExpr(call *CallExpr, member *MemberExpr, en
 
 	result, callErr := exec.invokeCallable(callee, receiver, args, kwargs, block, call.Pos())
 	if callErr != nil {
-		return NewNil(), callErr
+		return NewNil(), exec.underlineCall(callErr, call)
 	}
 	if err := exec.checkMemoryWith(result); err != nil {
 		return NewNil(), err
@@ -1257,7 +1342,7 @@ func (exec *Execution) evalDirectBuiltinMemberCallExpr(call *CallExpr, receiver
 		if ctxErr := exec.checkContext(); ctxErr != nil {
 			return NewNil(), ctxErr
 		}
-		return NewNil(), exec.wrapError(err, call.Pos())
+		return NewNil(), exec.underlineCall(exec.wrapError(err, call.Pos()), call)
 	}
 	if err := exec.checkContext(); err != nil {
 		return NewNil(), err
@@ -1282,7 +1367,7 @@ func canCallBuiltinMemberDirect(receiver Value, property string) bool {
 func callBuiltinMemberDirect(exec *Execution, receiver Value, property string, args []Value, kwargs map[string]Value, block Value) (Value, error) {
 	switch receiver.Kind() {
 	case KindDuration:
-		return callDurationMemberDirect(receiver.Duration(), property, args, kwargs, block)
+		return callDurationMemberDirect(exec, receiver.Duration(), property, args, kwargs, block)
 	case KindTime:
 		return callTimeMemberDirect(exec, receiver.Time(), property, args, kwargs, block)
 	default:
@@ -1334,7 +1419,10 @@ func bindLazyTaskGlobalsForCall(exec *Execution, root *Env, globals *taskLazyGlo
 }
 
 func executeFunctionForCall(exec *Execution, fn *ScriptFunction, callEnv *Env) (Value, error) {
-	if err := exec.pushFrame(fn.Name, fn.Pos, fn.owner, fn.owner); err != nil {
+	if exec.engine.config.AllowIntrospection && exec.root != nil {
+		exec.ambientNames = exec.root.bindingNameSet()
+	}
+	if err := exec.pushFrame(fn.frameName(), fn.Pos, fn.owner, fn.owner); err != nil {
 		return NewNil(), err
 	}
 	val, returned, err := exec.evalStatements(fn.Body, callEnv)
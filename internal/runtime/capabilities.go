@@ -22,6 +22,38 @@ type CapabilityMethodContract struct {
 	// that has already been validated and isolated from host-owned state.
 	ReturnValidatedByBuiltin bool
 	ValidateReturn           func(result Value) error
+	// Mutating marks a method that changes host state, such as an update,
+	// enqueue, or publish. Under Config.StrictEffects a mutating method fails
+	// unless CallOptions.AllowEffects is set; read-only methods always run.
+	Mutating bool
+	// Signature describes the method for introspection through
+	// Execution.CapabilityMethods. NewSignatureContract sets it; hand-written
+	// contracts may leave it nil.
+	Signature *CapabilitySignature
+}
+
+// CapabilityMethod describes one capability method bound into a call.
+type CapabilityMethod struct {
+	// Capability is the global name the adapter bound, for example "db".
+	Capability string
+	// Name is the method's qualified name, for example "db.find".
+	Name string
+	// Contracted reports whether a CapabilityMethodContract guards the method.
+	Contracted bool
+	// Mutating reports whether the method's contract declares it mutating.
+	Mutating bool
+	// Signature is the contract's declared signature, or nil when the method
+	// is uncontracted or its contract does not describe one.
+	Signature *CapabilitySignature
+}
+
+// String renders the method as its signature when one is declared, for
+// example "db.find(string, string) -> hash", and as its name otherwise.
+func (m CapabilityMethod) String() string {
+	if m.Signature == nil {
+		return m.Name
+	}
+	return m.Signature.Format(m.Name)
 }
 
 // CapabilityContractProvider exposes per-method contracts for capability adapters.
@@ -134,6 +134,7 @@ func (c *jobQueueCapability) CapabilityContracts() map[string]CapabilityMethodCo
 			ValidateArgs:             c.validateEnqueueContractArgs,
 			ReturnValidatedByBuiltin: true,
 			ValidateReturn:           capabilityValidateAnyReturn(name + ".enqueue"),
+			Mutating:                 true,
 		},
 	}
 	if c.inner.HasRetry() {
@@ -141,6 +142,7 @@ func (c *jobQueueCapability) CapabilityContracts() map[string]CapabilityMethodCo
 			ValidateArgs:             c.validateRetryContractArgs,
 			ReturnValidatedByBuiltin: true,
 			ValidateReturn:           capabilityValidateAnyReturn(name + ".retry"),
+			Mutating:                 true,
 		}
 	}
 	return contracts
@@ -285,10 +287,10 @@ func (a *dbCapabilityAdapter) Bind(_ CapabilityBinding) (map[string]Value, error
 func (a *dbCapabilityAdapter) CapabilityContracts() map[string]CapabilityMethodContract {
 	src := a.cap.Contracts()
 	out := make(map[string]CapabilityMethodContract, len(src))
-	for k := range src {
+	for k, contract := range src {
 		// DB methods validate and clone at the host boundary so payload graphs
 		// are not walked once by the runtime and again by the adapter.
-		out[k] = CapabilityMethodContract{}
+		out[k] = CapabilityMethodContract{Mutating: contract.Mutating}
 	}
 	return out
 }
@@ -341,6 +343,7 @@ func (c *eventsCapability) CapabilityContracts() map[string]CapabilityMethodCont
 		method: {
 			ValidateArgs:   c.validatePublishArgs,
 			ValidateReturn: c.inner.ValidatePublishReturn,
+			Mutating:       true,
 		},
 	}
 }
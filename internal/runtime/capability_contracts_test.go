@@ -268,9 +268,11 @@ type legacyFooCapability struct {
 	invokeCount *int
 }
 
+// Bind exposes a builtin that shares foo.call's name under its own global,
+// so a contract keyed by that name from another adapter must not reach it.
 func (c legacyFooCapability) Bind(binding CapabilityBinding) (map[string]Value, error) {
 	return map[string]Value{
-		"foo": NewObject(map[string]Value{
+		"legacy_foo": NewObject(map[string]Value{
 			"call": NewBuiltin("foo.call", func(exec *Execution, receiver Value, args []Value, kwargs map[string]Value, block Value) (Value, error) {
 				*c.invokeCount = *c.invokeCount + 1
 				if len(args) != 1 || args[0].Kind() != KindString {
@@ -715,7 +717,7 @@ end`)
 func TestCapabilityContractsAreScopedPerAdapter(t *testing.T) {
 	t.Parallel()
 	script := compileScriptDefault(t, `def run()
-  foo.call("ok")
+  legacy_foo.call("ok")
 end`)
 	var err error
 
@@ -1015,3 +1017,124 @@ end`)
 		t.Fatalf("contract should reject chunk path before invoke, got %d calls", chunkInvocations)
 	}
 }
+
+func TestCapabilityObserverSeesContractedCallsOnce(t *testing.T) {
+	t.Parallel()
+	script := compileScriptDefault(t, `def run()
+  row = db.find("Player", "p-1")
+  parsed = JSON.parse("{\"id\": \"p-1\"}")
+  [parsed["id"], row["id"]].map { |id| id.upcase }
+end
+
+def rejected()
+  db.find("Player", 1)
+end`)
+
+	var seen []string
+	observer := func(method string, args []Value) {
+		seen = append(seen, fmt.Sprintf("%s %v", method, args))
+	}
+	invocations := 0
+	opts := CallOptions{
+		Capabilities:       []CapabilityAdapter{signatureFindCapability{invokeCount: &invocations, result: NewHash(map[string]Value{"id": NewString("p-1")})}},
+		CapabilityObserver: observer,
+	}
+	if _, err := script.Call(context.Background(), "run", nil, opts); err != nil {
+		t.Fatalf("call failed: %v", err)
+	}
+	if len(seen) != 1 || seen[0] != "db.find [Player p-1]" {
+		t.Fatalf("observer saw %q, want one db.find", seen)
+	}
+
+	seen = nil
+	_, err := script.Call(context.Background(), "rejected", nil, opts)
+	requireErrorContains(t, err, "db.find argument 2 expected string")
+	if len(seen) != 0 {
+		t.Fatalf("observer saw rejected call: %q", seen)
+	}
+}
+
+func TestCapabilityBudgetsCapContractedCalls(t *testing.T) {
+	t.Parallel()
+	script := compileScriptDefault(t, `def run(n)
+  found = 0
+  n.times do
+    db.find("Player", "p-1")
+    found = found + 1
+  end
+  found
+end
+
+def rescued()
+  begin
+    run(5)
+  rescue
+    "rescued"
+  end
+end
+
+def lookup(id)
+  db.find("Player", id)
+end
+
+def fan_out()
+  Tasks.map(["a", "b", "c", "d"], max: 2, with: :lookup)
+end`)
+
+	newOpts := func(invocations *int) CallOptions {
+		return CallOptions{
+			Capabilities:      []CapabilityAdapter{signatureFindCapability{invokeCount: invocations, result: NewNil()}},
+			CapabilityBudgets: map[string]int{"db.find": 3, "db.query": 0},
+		}
+	}
+
+	invocations := 0
+	got, err := script.Call(context.Background(), "run", []Value{NewInt(3)}, newOpts(&invocations))
+	if err != nil || got.Int() != 3 {
+		t.Fatalf("run(3) = %v, %v; want 3 within budget", got, err)
+	}
+
+	invocations = 0
+	_, err = script.Call(context.Background(), "run", []Value{NewInt(5)}, newOpts(&invocations))
+	requireErrorContains(t, err, "capability budget exceeded for db.find (limit 3)")
+	requireRuntimeErrorType(t, err, runtimeErrorTypeLimit)
+	if invocations != 3 {
+		t.Fatalf("db.find ran %d times, want 3", invocations)
+	}
+
+	invocations = 0
+	_, err = script.Call(context.Background(), "rescued", nil, newOpts(&invocations))
+	requireErrorContains(t, err, "capability budget exceeded for db.find")
+
+	_, err = script.Call(context.Background(), "fan_out", nil, CallOptions{
+		Capabilities:      []CapabilityAdapter{budgetProbeCapability{}},
+		CapabilityBudgets: map[string]int{"db.find": 3},
+	})
+	requireErrorContains(t, err, "capability budget exceeded for db.find (limit 3)")
+
+	_, err = script.Call(context.Background(), "run", []Value{NewInt(1)}, CallOptions{
+		Capabilities:      []CapabilityAdapter{budgetProbeCapability{}},
+		CapabilityBudgets: map[string]int{"db.find": -1},
+	})
+	requireErrorContains(t, err, "capability budget for db.find must be non-negative")
+}
+
+// budgetProbeCapability exposes a contracted db.find that is safe to call
+// from concurrent tasks.
+type budgetProbeCapability struct{}
+
+func (budgetProbeCapability) Bind(binding CapabilityBinding) (map[string]Value, error) {
+	return map[string]Value{
+		"db": NewObject(map[string]Value{
+			"find": NewBuiltin("db.find", func(exec *Execution, receiver Value, args []Value, kwargs map[string]Value, block Value) (Value, error) {
+				return NewNil(), nil
+			}),
+		}),
+	}, nil
+}
+
+func (budgetProbeCapability) CapabilityContracts() map[string]CapabilityMethodContract {
+	return map[string]CapabilityMethodContract{
+		"db.find": MustNewSignatureContract("db.find", CapabilitySignature{Args: []string{"string", "string"}}),
+	}
+}
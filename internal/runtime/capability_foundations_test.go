@@ -17,6 +17,14 @@ func (adapter cancelingCapabilityAdapter) Bind(CapabilityBinding) (map[string]Va
 	}, nil
 }
 
+// globalsCapabilityAdapter binds a fixed set of globals and declares no
+// contracts.
+type globalsCapabilityAdapter map[string]Value
+
+func (adapter globalsCapabilityAdapter) Bind(CapabilityBinding) (map[string]Value, error) {
+	return adapter, nil
+}
+
 type cancelingInvalidReturnDB struct {
 	cancel context.CancelFunc
 }
@@ -147,6 +155,33 @@ end
 	}
 }
 
+func TestCapabilityGlobalConflictFailsBinding(t *testing.T) {
+	t.Parallel()
+
+	script := compileScript(t, `
+def run()
+  db.find("Player", "p-1")
+end
+`)
+	db := &dbCapabilityStub{findResult: NewString("player")}
+	_, err := script.Call(context.Background(), "run", nil, callOptionsWithCapabilities(
+		MustNewDBCapability("db", db),
+		globalsCapabilityAdapter{"db": NewString("shadow"), "cache": NewString("cache")},
+	))
+	requireErrorContains(t, err, `capability global "db" already provided`)
+	if len(db.findCalls) != 0 {
+		t.Fatalf("db.find ran %d times, want 0", len(db.findCalls))
+	}
+
+	result, err := script.Call(context.Background(), "run", nil, callOptionsWithCapabilities(
+		MustNewDBCapability("db", db),
+		globalsCapabilityAdapter{"cache": NewString("cache")},
+	))
+	if err != nil || !result.Equal(NewString("player")) {
+		t.Fatalf("run with distinct globals = %v, %v, want player", result, err)
+	}
+}
+
 func TestCapabilityAdaptersStopAfterHostCancellationBeforeReturnValidation(t *testing.T) {
 	t.Parallel()
 
@@ -307,6 +307,38 @@ end`,
 			queue:   &jobQueueStub{},
 			wantErr: "jobs.enqueue delay must be non-negative",
 		},
+		{
+			name: "string_delay",
+			source: `def run()
+  jobs.enqueue("demo", { foo: "bar" }, delay: "soon")
+end`,
+			queue:   &jobQueueStub{},
+			wantErr: "jobs.enqueue delay must be duration or numeric seconds",
+		},
+		{
+			name: "negative_duration_delay",
+			source: `def run()
+  jobs.enqueue("demo", { foo: "bar" }, delay: 5.seconds - 10.seconds)
+end`,
+			queue:   &jobQueueStub{},
+			wantErr: "jobs.enqueue delay must be non-negative",
+		},
+		{
+			name: "overflowing_delay",
+			source: `def run()
+  jobs.enqueue("demo", { foo: "bar" }, delay: 100000.days * 10)
+end`,
+			queue:   &jobQueueStub{},
+			wantErr: "jobs.enqueue delay is out of range",
+		},
+		{
+			name: "non_finite_delay",
+			source: `def run()
+  jobs.enqueue("demo", { foo: "bar" }, delay: 1.0 / 0.0)
+end`,
+			queue:   &jobQueueStub{},
+			wantErr: "jobs.enqueue delay must be finite",
+		},
 		{
 			name: "unexpected_enqueue_positional",
 			source: `def run()
@@ -0,0 +1,98 @@
+package runtime
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+)
+
+// Capabilities returns the sorted global names bound by the call's
+// capability adapters, for example ["db", "jobs"].
+func (exec *Execution) Capabilities() []string {
+	names := slices.Clone(exec.capabilityNames)
+	sort.Strings(names)
+	return slices.Compact(names)
+}
+
+// CapabilityMethods lists the callable methods bound by the call's
+// capability adapters, sorted by name. A method guarded by a contract built
+// with NewSignatureContract carries its declared signature.
+func (exec *Execution) CapabilityMethods() []CapabilityMethod {
+	methods := slices.Clone(exec.capabilityMethods)
+	sort.SliceStable(methods, func(i, j int) bool {
+		return methods[i].Name < methods[j].Name
+	})
+	return methods
+}
+
+// CapabilityMethods binds capabilities the way Script.Call would and lists
+// the methods they expose, without running any script code. Hosts use it to
+// show or log what a script will be able to reach.
+func (s *Script) CapabilityMethods(ctx context.Context, capabilities []CapabilityAdapter) ([]CapabilityMethod, error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	root := newEnvWithCapacity(nil, len(capabilities)*2)
+	s.engine.attachBuiltins(root, 0)
+	exec := newExecutionForCall(s, ctx, root, CallOptions{Capabilities: capabilities})
+	rebinder := newCallFunctionRebinder(s, root, nil, nil)
+	if err := bindCapabilitiesForCall(exec, root, rebinder, capabilities); err != nil {
+		return nil, err
+	}
+	return exec.CapabilityMethods(), nil
+}
+
+// recordCapability notes a capability global and the builtins it exposes,
+// either directly or as members of an object or hash, for Capabilities and
+// CapabilityMethods.
+func (exec *Execution) recordCapability(name string, val Value, contracts map[string]CapabilityMethodContract) {
+	exec.capabilityNames = append(exec.capabilityNames, name)
+	record := func(fallback string, member Value) {
+		builtin := valueBuiltin(member)
+		if builtin == nil {
+			return
+		}
+		method := CapabilityMethod{Capability: name, Name: builtin.Name}
+		if method.Name == "" {
+			method.Name = fallback
+		}
+		if contract, ok := contracts[method.Name]; ok {
+			method.Contracted = true
+			method.Mutating = contract.Mutating
+			method.Signature = contract.Signature
+		}
+		exec.capabilityMethods = append(exec.capabilityMethods, method)
+	}
+	switch val.Kind() {
+	case KindBuiltin:
+		record(name, val)
+	case KindObject, KindHash:
+		for key, member := range val.Hash() {
+			if member.Kind() == KindBuiltin {
+				record(name+"."+key, member)
+			}
+		}
+	}
+}
+
+func builtinCapabilities(exec *Execution, receiver Value, args []Value, kwargs map[string]Value, block Value) (Value, error) {
+	if len(args) > 0 {
+		return NewNil(), fmt.Errorf("capabilities does not take arguments")
+	}
+	if len(kwargs) > 0 {
+		return NewNil(), fmt.Errorf("capabilities does not accept keyword arguments")
+	}
+	if !block.IsNil() {
+		return NewNil(), fmt.Errorf("capabilities does not accept blocks")
+	}
+	names := exec.Capabilities()
+	out := make([]Value, len(names))
+	for i, name := range names {
+		out[i] = NewString(name)
+	}
+	return NewArray(out), nil
+}
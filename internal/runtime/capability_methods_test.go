@@ -0,0 +1,66 @@
+package runtime
+
+import (
+	"context"
+	"slices"
+	"testing"
+)
+
+func TestCapabilityMethodsListsBoundCapabilities(t *testing.T) {
+	t.Parallel()
+
+	invocations := 0
+	adapters := []CapabilityAdapter{
+		signatureFindCapability{invokeCount: &invocations, result: NewNil()},
+		contractProbeCapability{invokeCount: &invocations},
+	}
+	script := compileScriptDefault(t, `def run()
+  names = capabilities
+  {
+    names: names,
+    has_db: names.include?("db"),
+    has_mailer: capabilities().include?("mailer")
+  }
+end`)
+
+	methods, err := script.CapabilityMethods(context.Background(), adapters)
+	if err != nil {
+		t.Fatalf("CapabilityMethods: %v", err)
+	}
+	var listed []string
+	for _, method := range methods {
+		listed = append(listed, method.Capability+" "+method.String())
+	}
+	want := []string{
+		"db db.find(string, string, [int?], [fields: array<string>], [tenant: string]) -> { id: string } | nil",
+		"probe probe.call",
+	}
+	if !slices.Equal(listed, want) {
+		t.Fatalf("CapabilityMethods = %q, want %q", listed, want)
+	}
+	if !methods[1].Contracted || methods[1].Signature != nil {
+		t.Fatalf("probe.call = %+v, want contracted without signature", methods[1])
+	}
+
+	got, err := script.Call(context.Background(), "run", nil, CallOptions{Capabilities: adapters})
+	if err != nil {
+		t.Fatalf("call failed: %v", err)
+	}
+	result := got.Hash()
+	compareArrays(t, result["names"], []Value{NewString("db"), NewString("probe")})
+	if !result["has_db"].Bool() || result["has_mailer"].Bool() {
+		t.Fatalf("capability checks = %v", result)
+	}
+	if invocations != 0 {
+		t.Fatalf("listing invoked capabilities %d times", invocations)
+	}
+}
+
+func TestCapabilitiesBuiltinWithoutCapabilities(t *testing.T) {
+	t.Parallel()
+
+	script := compileScriptDefault(t, "def run()\n  capabilities\nend")
+	got := callFunc(t, script, "run", nil)
+	compareArrays(t, got, []Value{})
+	requireCallErrorContains(t, compileScriptDefault(t, "def run()\n  capabilities(1)\nend"), "run", nil, CallOptions{}, "capabilities does not take arguments")
+}
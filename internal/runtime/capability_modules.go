@@ -0,0 +1,79 @@
+package runtime
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// NewModulesCapability constructs a capability adapter that lets scripts pick
+// modules at runtime from a host-approved set. The object bound to name has
+// list, which returns the approved module names, and load(name), which
+// requires one of them and returns its exports object. Modules load through
+// the same resolution as require, so Config.ModulePaths and the module
+// allow and deny lists still apply; granting the capability stands in for
+// CallOptions.AllowRequire under Config.StrictEffects.
+func NewModulesCapability(name string, modules []string) (CapabilityAdapter, error) {
+	if strings.TrimSpace(name) == "" {
+		return nil, fmt.Errorf("vibes: modules capability name must be non-empty")
+	}
+	approved := make([]string, 0, len(modules))
+	for _, module := range modules {
+		module = strings.TrimSpace(module)
+		if module == "" {
+			return nil, fmt.Errorf("vibes: modules capability module names must be non-empty")
+		}
+		approved = append(approved, module)
+	}
+	slices.Sort(approved)
+	return &modulesCapability{name: name, modules: slices.Compact(approved)}, nil
+}
+
+// MustNewModulesCapability is the panicking variant of NewModulesCapability.
+func MustNewModulesCapability(name string, modules []string) CapabilityAdapter {
+	cap, err := NewModulesCapability(name, modules)
+	if err != nil {
+		panic(err)
+	}
+	return cap
+}
+
+type modulesCapability struct {
+	name    string
+	modules []string
+}
+
+func (c *modulesCapability) Bind(binding CapabilityBinding) (map[string]Value, error) {
+	methods := map[string]Value{
+		"list": NewBuiltin(c.name+".list", c.callList),
+		"load": NewBuiltin(c.name+".load", c.callLoad),
+	}
+	return map[string]Value{c.name: NewObject(methods)}, nil
+}
+
+func (c *modulesCapability) callList(exec *Execution, receiver Value, args []Value, kwargs map[string]Value, block Value) (Value, error) {
+	if len(args) > 0 || len(kwargs) > 0 || !block.IsNil() {
+		return NewNil(), fmt.Errorf("%s.list does not take arguments", c.name)
+	}
+	names := make([]Value, len(c.modules))
+	for i, module := range c.modules {
+		names[i] = NewString(module)
+	}
+	return NewArray(names), nil
+}
+
+func (c *modulesCapability) callLoad(exec *Execution, receiver Value, args []Value, kwargs map[string]Value, block Value) (Value, error) {
+	if len(args) != 1 || len(kwargs) > 0 || !block.IsNil() {
+		return NewNil(), fmt.Errorf("%s.load expects a single module name argument", c.name)
+	}
+	if args[0].Kind() != KindString && args[0].Kind() != KindSymbol {
+		return NewNil(), fmt.Errorf("%s.load expects a string or symbol module name", c.name)
+	}
+	module := args[0].String()
+	if _, ok := slices.BinarySearch(c.modules, module); !ok {
+		return NewNil(), fmt.Errorf("%s.load: module %q is not approved", c.name, module)
+	}
+	return exec.requireModule(module, "")
+}
+
+var _ CapabilityAdapter = (*modulesCapability)(nil)
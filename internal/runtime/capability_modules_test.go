@@ -0,0 +1,49 @@
+package runtime
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+)
+
+func TestModulesCapabilityLoadsApprovedModuleByName(t *testing.T) {
+	t.Parallel()
+
+	engine := MustNewEngine(Config{
+		ModulePaths:    []string{filepath.FromSlash(moduleFixturesRoot)},
+		ModuleDenyList: []string{"helper_alt"},
+		StrictEffects:  true,
+	})
+	script := compileScriptWithEngine(t, engine, `def run(kind, value)
+  plugin = plugins.load("#{kind}")
+  [plugins.list(), plugin.triple(value)]
+end
+
+def load(name)
+  plugins.load(name)
+end`)
+	opts := CallOptions{Capabilities: []CapabilityAdapter{
+		MustNewModulesCapability("plugins", []string{"helper", "helper_alt", "helper"}),
+	}}
+
+	result := callScript(t, context.Background(), script, "run", []Value{NewString("helper"), NewInt(4)}, opts)
+	compareArrays(t, result, []Value{
+		NewArray([]Value{NewString("helper"), NewString("helper_alt")}),
+		NewInt(12),
+	})
+
+	requireCallErrorContains(t, script, "load", []Value{NewString("shared/math")}, opts, `plugins.load: module "shared/math" is not approved`)
+	requireCallErrorContains(t, script, "load", []Value{NewString("helper_alt")}, opts, `require: module "helper_alt" denied by policy`)
+	requireCallErrorContains(t, script, "load", []Value{NewInt(1)}, opts, "plugins.load expects a string or symbol module name")
+}
+
+func TestNewModulesCapabilityValidatesNames(t *testing.T) {
+	t.Parallel()
+
+	if _, err := NewModulesCapability(" ", []string{"helper"}); err == nil {
+		t.Fatalf("expected empty capability name error")
+	}
+	if _, err := NewModulesCapability("plugins", []string{"helper", ""}); err == nil {
+		t.Fatalf("expected empty module name error")
+	}
+}
@@ -0,0 +1,252 @@
+package runtime
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/mgomes/vibescript/internal/parser"
+)
+
+// CapabilitySignature declares a capability method's call shape with script
+// type annotations, so hosts can describe a method such as
+// `db.find(string, string) -> hash` without writing ValidateArgs and
+// ValidateReturn closures. Types use the annotation grammar scripts use for
+// parameters: "string", "int?", "array<string>", "{ id: string }", and
+// unions like "string | int". Every value crossing the boundary must also be
+// data-only, as with the closure contracts.
+type CapabilitySignature struct {
+	// Args lists the types of the required positional arguments.
+	Args []string
+	// OptionalArgs lists the types of positional arguments that may follow
+	// the required ones.
+	OptionalArgs []string
+	// Keywords maps each accepted keyword argument to its type. Keywords not
+	// listed are rejected.
+	Keywords map[string]string
+	// RequiredKeywords names the Keywords entries a call must supply.
+	RequiredKeywords []string
+	// AcceptsBlock allows the call to pass a block.
+	AcceptsBlock bool
+	// Mutating marks the method as changing host state; see
+	// CapabilityMethodContract.Mutating.
+	Mutating bool
+	// Return is the type of the method's result. Empty means any data-only
+	// value.
+	Return string
+}
+
+// NewSignatureContract compiles sig into a CapabilityMethodContract for
+// method (for example "db.find"). Type annotations are parsed once here, so a
+// malformed signature fails when the adapter is built rather than on first
+// call. The closure form of CapabilityMethodContract remains available for
+// checks a signature cannot express.
+func NewSignatureContract(method string, sig CapabilitySignature) (CapabilityMethodContract, error) {
+	args, err := parseSignatureTypes(method, "argument", sig.Args)
+	if err != nil {
+		return CapabilityMethodContract{}, err
+	}
+	optional, err := parseSignatureTypes(method, "optional argument", sig.OptionalArgs)
+	if err != nil {
+		return CapabilityMethodContract{}, err
+	}
+	keywords := make(map[string]*TypeExpr, len(sig.Keywords))
+	for name, source := range sig.Keywords {
+		ty, err := parseSignatureType(method, "keyword "+name, source)
+		if err != nil {
+			return CapabilityMethodContract{}, err
+		}
+		keywords[name] = ty
+	}
+	for _, name := range sig.RequiredKeywords {
+		if _, ok := keywords[name]; !ok {
+			return CapabilityMethodContract{}, fmt.Errorf("%s signature requires undeclared keyword %s", method, name)
+		}
+	}
+	returnTy := capabilityTypeAny
+	if sig.Return != "" {
+		if returnTy, err = parseSignatureType(method, "return", sig.Return); err != nil {
+			return CapabilityMethodContract{}, err
+		}
+	}
+
+	required := append([]string(nil), sig.RequiredKeywords...)
+	sort.Strings(required)
+	acceptsBlock := sig.AcceptsBlock
+	described := sig.clone()
+	return CapabilityMethodContract{
+		Mutating:  sig.Mutating,
+		Signature: &described,
+		ValidateArgs: func(callArgs []Value, kwargs map[string]Value, block Value) error {
+			if len(callArgs) < len(args) || len(callArgs) > len(args)+len(optional) {
+				return fmt.Errorf("%s expects %s", method, signatureArityText(len(args), len(optional)))
+			}
+			for i, arg := range callArgs {
+				ty := optionalOrRequired(args, optional, i)
+				if err := validateCapabilityTypedValue(fmt.Sprintf("%s argument %d", method, i+1), arg, ty); err != nil {
+					return err
+				}
+			}
+			for name, val := range kwargs {
+				ty, ok := keywords[name]
+				if !ok {
+					return fmt.Errorf("%s does not accept keyword %s", method, name)
+				}
+				if err := validateCapabilityTypedValue(fmt.Sprintf("%s keyword %s", method, name), val, ty); err != nil {
+					return err
+				}
+			}
+			for _, name := range required {
+				if _, ok := kwargs[name]; !ok {
+					return fmt.Errorf("%s requires keyword %s", method, name)
+				}
+			}
+			if !acceptsBlock && !block.IsNil() {
+				return fmt.Errorf("%s does not accept blocks", method)
+			}
+			return nil
+		},
+		ValidateReturn: func(result Value) error {
+			return validateCapabilityTypedValue(method+" return value", result, returnTy)
+		},
+	}, nil
+}
+
+// MustNewSignatureContract is the panicking variant of NewSignatureContract,
+// for signatures fixed at compile time.
+func MustNewSignatureContract(method string, sig CapabilitySignature) CapabilityMethodContract {
+	contract, err := NewSignatureContract(method, sig)
+	if err != nil {
+		panic(err)
+	}
+	return contract
+}
+
+// Format renders sig as a call signature for method, for example
+// "db.find(string, string, [int?], [fields: array<string>]) -> hash".
+// Optional arguments and keywords are bracketed, and required keywords are
+// listed before optional ones, each group sorted by name.
+func (sig CapabilitySignature) Format(method string) string {
+	var b strings.Builder
+	b.WriteString(method)
+	b.WriteByte('(')
+	params := make([]string, 0, len(sig.Args)+len(sig.OptionalArgs)+len(sig.Keywords)+1)
+	params = append(params, sig.Args...)
+	for _, ty := range sig.OptionalArgs {
+		params = append(params, "["+ty+"]")
+	}
+	required := make(map[string]bool, len(sig.RequiredKeywords))
+	for _, name := range sig.RequiredKeywords {
+		required[name] = true
+	}
+	names := make([]string, 0, len(sig.Keywords))
+	for name := range sig.Keywords {
+		names = append(names, name)
+	}
+	sort.Slice(names, func(i, j int) bool {
+		if required[names[i]] != required[names[j]] {
+			return required[names[i]]
+		}
+		return names[i] < names[j]
+	})
+	for _, name := range names {
+		param := name + ": " + sig.Keywords[name]
+		if !required[name] {
+			param = "[" + param + "]"
+		}
+		params = append(params, param)
+	}
+	if sig.AcceptsBlock {
+		params = append(params, "&block")
+	}
+	b.WriteString(strings.Join(params, ", "))
+	b.WriteByte(')')
+	if sig.Return != "" {
+		b.WriteString(" -> ")
+		b.WriteString(sig.Return)
+	}
+	return b.String()
+}
+
+func (sig CapabilitySignature) clone() CapabilitySignature {
+	out := sig
+	out.Args = append([]string(nil), sig.Args...)
+	out.OptionalArgs = append([]string(nil), sig.OptionalArgs...)
+	out.RequiredKeywords = append([]string(nil), sig.RequiredKeywords...)
+	if sig.Keywords != nil {
+		out.Keywords = make(map[string]string, len(sig.Keywords))
+		for name, ty := range sig.Keywords {
+			out.Keywords[name] = ty
+		}
+	}
+	return out
+}
+
+func parseSignatureTypes(method, label string, sources []string) ([]*TypeExpr, error) {
+	types := make([]*TypeExpr, len(sources))
+	for i, source := range sources {
+		ty, err := parseSignatureType(method, fmt.Sprintf("%s %d", label, i+1), source)
+		if err != nil {
+			return nil, err
+		}
+		types[i] = ty
+	}
+	return types, nil
+}
+
+func parseSignatureType(method, label, source string) (*TypeExpr, error) {
+	ty, err := parser.ParseType(source)
+	if err != nil {
+		return nil, fmt.Errorf("%s signature %s type %q: %w", method, label, source, err)
+	}
+	if name, ok := unresolvedSignatureType(ty); ok {
+		return nil, fmt.Errorf("%s signature %s type %q: unknown type %s", method, label, source, name)
+	}
+	return ty, nil
+}
+
+// unresolvedSignatureType finds a named type a host signature cannot check.
+// Script enums and classes resolve against a script's declarations, which a
+// host-side contract does not have.
+func unresolvedSignatureType(ty *TypeExpr) (string, bool) {
+	if ty == nil {
+		return "", false
+	}
+	if ty.Kind == TypeUnknown || ty.Kind == TypeEnum {
+		return ty.Name, true
+	}
+	for _, arg := range ty.TypeArgs {
+		if name, ok := unresolvedSignatureType(arg); ok {
+			return name, true
+		}
+	}
+	for _, option := range ty.Union {
+		if name, ok := unresolvedSignatureType(option); ok {
+			return name, true
+		}
+	}
+	for _, field := range ty.Shape {
+		if name, ok := unresolvedSignatureType(field); ok {
+			return name, true
+		}
+	}
+	return "", false
+}
+
+func optionalOrRequired(required, optional []*TypeExpr, i int) *TypeExpr {
+	if i < len(required) {
+		return required[i]
+	}
+	return optional[i-len(required)]
+}
+
+func signatureArityText(required, optional int) string {
+	switch {
+	case optional == 0 && required == 1:
+		return "1 argument"
+	case optional == 0:
+		return fmt.Sprintf("%d arguments", required)
+	default:
+		return fmt.Sprintf("%d to %d arguments", required, required+optional)
+	}
+}
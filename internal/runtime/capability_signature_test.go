@@ -0,0 +1,109 @@
+package runtime
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+type signatureFindCapability struct {
+	invokeCount *int
+	result      Value
+}
+
+func (c signatureFindCapability) Bind(binding CapabilityBinding) (map[string]Value, error) {
+	return map[string]Value{
+		"db": NewObject(map[string]Value{
+			"find": NewBuiltin("db.find", func(exec *Execution, receiver Value, args []Value, kwargs map[string]Value, block Value) (Value, error) {
+				*c.invokeCount = *c.invokeCount + 1
+				return c.result, nil
+			}),
+		}),
+	}, nil
+}
+
+func (c signatureFindCapability) CapabilityContracts() map[string]CapabilityMethodContract {
+	return map[string]CapabilityMethodContract{
+		"db.find": MustNewSignatureContract("db.find", CapabilitySignature{
+			Args:         []string{"string", "string"},
+			OptionalArgs: []string{"int?"},
+			Keywords:     map[string]string{"fields": "array<string>", "tenant": "string"},
+			Return:       "{ id: string } | nil",
+		}),
+	}
+}
+
+func TestCapabilitySignatureContractValidatesCalls(t *testing.T) {
+	t.Parallel()
+
+	found := NewHash(map[string]Value{"id": NewString("p-1")})
+	tests := []struct {
+		name    string
+		call    string
+		result  Value
+		want    string
+		invokes int
+	}{
+		{name: "valid", call: `db.find("Player", "p-1")`, result: found, invokes: 1},
+		{name: "valid_optional_and_keywords", call: `db.find("Player", "p-1", 2, fields: ["id"], tenant: "t")`, result: found, invokes: 1},
+		{name: "nil_return", call: `db.find("Player", "p-1", nil)`, result: NewNil(), invokes: 1},
+		{name: "wrong_kind", call: `db.find("Player", 7)`, want: "db.find argument 2 expected string, got int"},
+		{name: "too_few", call: `db.find("Player")`, want: "db.find expects 2 to 3 arguments"},
+		{name: "too_many", call: `db.find("Player", "p-1", 1, 2)`, want: "db.find expects 2 to 3 arguments"},
+		{name: "keyword_type", call: `db.find("Player", "p-1", fields: [1])`, want: "db.find keyword fields expected array<string>"},
+		{name: "unknown_keyword", call: `db.find("Player", "p-1", limit: 1)`, want: "db.find does not accept keyword limit"},
+		{name: "block", call: `db.find("Player", "p-1") do |row| row end`, want: "db.find does not accept blocks"},
+		{name: "return_type", call: `db.find("Player", "p-1")`, result: NewHash(map[string]Value{"id": NewInt(1)}), want: "db.find return value expected", invokes: 1},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			script := compileScriptDefault(t, "def run()\n  "+tc.call+"\nend")
+			invocations := 0
+			_, err := script.Call(context.Background(), "run", nil, CallOptions{
+				Capabilities: []CapabilityAdapter{signatureFindCapability{invokeCount: &invocations, result: tc.result}},
+			})
+			if tc.want == "" {
+				if err != nil {
+					t.Fatalf("call failed: %v", err)
+				}
+			} else {
+				requireErrorContains(t, err, tc.want)
+			}
+			if invocations != tc.invokes {
+				t.Fatalf("capability invoked %d times, want %d", invocations, tc.invokes)
+			}
+		})
+	}
+}
+
+func TestNewSignatureContractRejectsBadSignatures(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		sig  CapabilitySignature
+		want string
+	}{
+		{name: "syntax", sig: CapabilitySignature{Args: []string{"array<string"}}, want: `db.find signature argument 1 type "array<string"`},
+		{name: "unknown", sig: CapabilitySignature{Args: []string{"Player"}}, want: "unknown type Player"},
+		{name: "nested_unknown", sig: CapabilitySignature{Return: "array<Player>"}, want: "db.find signature return type"},
+		{name: "required_keyword", sig: CapabilitySignature{RequiredKeywords: []string{"tenant"}}, want: "requires undeclared keyword tenant"},
+	}
+	for _, tc := range tests {
+		_, err := NewSignatureContract("db.find", tc.sig)
+		if err == nil || !strings.Contains(err.Error(), tc.want) {
+			t.Fatalf("%s: err = %v, want %q", tc.name, err, tc.want)
+		}
+	}
+
+	contract := MustNewSignatureContract("jobs.enqueue", CapabilitySignature{
+		Args:             []string{"string"},
+		Keywords:         map[string]string{"queue": "string"},
+		RequiredKeywords: []string{"queue"},
+	})
+	if err := contract.ValidateArgs([]Value{NewString("email")}, nil, NewNil()); err == nil || !strings.Contains(err.Error(), "jobs.enqueue requires keyword queue") {
+		t.Fatalf("missing required keyword err = %v", err)
+	}
+}
@@ -16,10 +16,10 @@ func TestCaseEqualityOperator(t *testing.T) {
 		{"scalar int equal", `1 === 1`, true},
 		{"scalar int unequal", `1 === 2`, false},
 		{"scalar float equal", `1.5 === 1.5`, true},
-		// `===` mirrors Vibescript's `==`, which treats int and float as distinct
-		// kinds, so `1 === 1.0` is false even though Ruby reports true. The
-		// scalar fallback intentionally tracks `==` rather than diverging.
-		{"scalar int vs float not equal", `1 === 1.0`, false},
+		// `===` mirrors Vibescript's `==`, which compares ints and floats
+		// numerically, so `1 === 1.0` is true just as in Ruby.
+		{"scalar int vs float equal", `1 === 1.0`, true},
+		{"scalar int vs fractional float", `1 === 1.5`, false},
 		{"scalar string equal", `"a" === "a"`, true},
 		{"scalar string unequal", `"a" === "b"`, false},
 		{"scalar bool equal", `true === true`, true},
@@ -150,3 +150,143 @@ end
 	}
 	requireCallErrorContains(t, script, "bad_splat", nil, CallOptions{}, "case when splat value must be an array")
 }
+
+// TestCaseWhenMatchesRangesAndTypes covers when clauses that match by range
+// membership, by class (is_a? semantics), and by enum, with the first matching
+// clause winning and the subject evaluated once.
+func TestCaseWhenMatchesRangesAndTypes(t *testing.T) {
+	t.Parallel()
+	script := compileScript(t, `
+class Donor
+end
+
+class Sponsor
+end
+
+enum Tier
+  Gold
+  Silver
+end
+
+def fundraising_badge(raised)
+  case raised
+  when 0 then "none"
+  when 1...100 then "starter"
+  when 100...1_000 then "supporter"
+  else "champion"
+  end
+end
+
+def describe(value)
+  case value
+  when Donor then "donor"
+  when Sponsor then "sponsor"
+  when Tier then "tier"
+  when 0..10, Tier::Gold then "small"
+  else "other"
+  end
+end
+
+def kinds
+  [describe(Donor.new), describe(Sponsor.new), describe(Tier::Gold), describe(5), describe(Donor), describe("x")]
+end
+
+def first_match_wins(calls)
+  case subject(calls)
+  when 1..10 then "range"
+  when 5 then "value"
+  end
+end
+
+def subject(calls)
+  calls[0] = calls[0] + 1
+  5
+end
+
+def run
+  calls = [0]
+  [first_match_wins(calls), calls[0]]
+end
+
+def class_operator
+  [Donor === Donor.new, Donor === Sponsor.new, Donor === Donor, Tier === Tier::Silver, Tier === :gold]
+end
+`)
+
+	badges := []struct {
+		raised int64
+		want   string
+	}{
+		{raised: 0, want: "none"},
+		{raised: 1, want: "starter"},
+		{raised: 99, want: "starter"},
+		{raised: 100, want: "supporter"},
+		{raised: 1_000, want: "champion"},
+		{raised: 50_000, want: "champion"},
+	}
+	for _, tc := range badges {
+		got := callFunc(t, script, "fundraising_badge", []Value{NewInt(tc.raised)})
+		if !got.Equal(NewString(tc.want)) {
+			t.Fatalf("fundraising_badge(%d) = %v, want %s", tc.raised, got, tc.want)
+		}
+	}
+
+	compareArrays(t, callFunc(t, script, "kinds", nil), []Value{
+		NewString("donor"), NewString("sponsor"), NewString("tier"), NewString("small"), NewString("other"), NewString("other"),
+	})
+	compareArrays(t, callFunc(t, script, "run", nil), []Value{NewString("range"), NewInt(1)})
+	compareArrays(t, callFunc(t, script, "class_operator", nil), []Value{
+		NewBool(true), NewBool(false), NewBool(false), NewBool(true), NewBool(false),
+	})
+}
+
+func TestCaseWhenMatchesBuiltinKinds(t *testing.T) {
+	t.Parallel()
+	script := compileScript(t, `
+def kind_of(value)
+  case value
+  when Integer then "integer"
+  when Float then "float"
+  when String then "string"
+  when Array then "array"
+  else "other"
+  end
+end
+
+def kinds
+  [kind_of(3), kind_of(bigint("92233720368547758070")), kind_of(2.5), kind_of("x"), kind_of([1]), kind_of(:x), kind_of(nil)]
+end
+
+def kind_operator
+  convert = Array
+  [Integer === 1, Integer === 1.0, Float === 1.0, String === "s", String === :s, Array === [1], convert === {}, [1, "a", 2.0].grep(Integer)]
+end
+
+def other_builtin_operator
+  puts === 1
+end
+
+def other_builtin_when(value)
+  case value
+  when format then "format"
+  else "other"
+  end
+end
+`)
+
+	compareArrays(t, callFunc(t, script, "kinds", nil), []Value{
+		NewString("integer"), NewString("integer"), NewString("float"), NewString("string"), NewString("array"), NewString("other"), NewString("other"),
+	})
+	compareArrays(t, callFunc(t, script, "kind_operator", nil), []Value{
+		NewBool(true), NewBool(false), NewBool(true), NewBool(true), NewBool(false), NewBool(true), NewBool(false), NewArray([]Value{NewInt(1)}),
+	})
+	requireCallErrorContains(t, script, "other_builtin_operator", nil, CallOptions{}, "cannot match against builtin puts")
+	requireCallErrorContains(t, script, "other_builtin_when", []Value{NewString("x")}, CallOptions{}, "cannot match against builtin format")
+
+	engine := MustNewEngine(Config{})
+	engine.RegisterBuiltin("Integer", func(_ *Execution, _ Value, _ []Value, _ map[string]Value, _ Value) (Value, error) {
+		return NewInt(0), nil
+	})
+	hosted := compileScriptWithEngine(t, engine, "def run()\n  Integer === 1\nend")
+	requireCallErrorContains(t, hosted, "run", nil, CallOptions{}, "cannot match against builtin Integer")
+}
@@ -0,0 +1,85 @@
+package runtime
+
+import (
+	"context"
+	"testing"
+)
+
+// TestMaxCollectionSizeRejectsOversizedResults checks that each materializing
+// builtin fails with the collection size error before building a result longer
+// than Config.MaxCollectionSize.
+func TestMaxCollectionSizeRejectsOversizedResults(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		expr string
+		want string
+	}{
+		{name: "range to_a", expr: "(1..11).to_a", want: "range.to_a collection size limit exceeded (10 elements)"},
+		{name: "range first", expr: "(1..1_000_000).first(11)", want: "range.first collection size limit exceeded"},
+		{name: "range last", expr: "(1..1_000_000).last(11)", want: "range.last collection size limit exceeded"},
+		{name: "range map", expr: "(1..1_000_000_000).map { |n| n }", want: "range.map collection size limit exceeded"},
+		{name: "split separator", expr: `"a,b,c,d,e,f,g,h,i,j,k".split(",")`, want: "string.split collection size limit exceeded"},
+		{name: "split whitespace", expr: `"a b c d e f g h i j k".split`, want: "string.split collection size limit exceeded"},
+		{name: "split chars", expr: `"abcdefghijk".split("")`, want: "string.split collection size limit exceeded"},
+		{name: "map", expr: "[1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11].map { |v| v }", want: "array.map collection size limit exceeded"},
+		{name: "chunk", expr: "[1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11].chunk(1)", want: "array.chunk collection size limit exceeded"},
+		{name: "window", expr: "[1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12].window(2)", want: "array.window collection size limit exceeded"},
+		{name: "fill", expr: "[1, 2].fill(0, 5, 6)", want: "array.fill collection size limit exceeded"},
+		{name: "Array.new", expr: "Array.new(11, 0)", want: "Array.new collection size limit exceeded"},
+		{name: "Array.new block", expr: "Array.new(11) { |i| i }", want: "Array.new collection size limit exceeded"},
+		{name: "merge", expr: "{ a: 1, b: 2, c: 3, d: 4, e: 5, f: 6 }.merge({ g: 7, h: 8, i: 9, j: 10, k: 11 })", want: "hash.merge collection size limit exceeded"},
+		{name: "to_h", expr: "[[1, 1], [2, 2], [3, 3], [4, 4], [5, 5], [6, 6], [7, 7], [8, 8], [9, 9], [10, 10], [11, 11]].to_h", want: "array.to_h collection size limit exceeded"},
+		{name: "group_by", expr: "[1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11].group_by { |n| n }", want: "array.group_by collection size limit exceeded"},
+		{name: "tally", expr: "[1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11].tally", want: "array.tally collection size limit exceeded"},
+		{name: "tally_by", expr: "[1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11].tally_by { |n| n }", want: "array.tally_by collection size limit exceeded"},
+		{name: "JSON.parse object", expr: `JSON.parse("{\"a\":1,\"b\":2,\"c\":3,\"d\":4,\"e\":5,\"f\":6,\"g\":7,\"h\":8,\"i\":9,\"j\":10,\"k\":11}")`, want: "object collection size limit exceeded"},
+		{name: "JSON.parse array", expr: `JSON.parse("[1,2,3,4,5,6,7,8,9,10,11]")`, want: "array collection size limit exceeded"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			script := compileScriptWithConfig(t, Config{MaxCollectionSize: 10}, "def run()\n  "+tc.expr+"\nend")
+			requireCallErrorContains(t, script, "run", nil, CallOptions{}, tc.want)
+		})
+	}
+}
+
+// TestMaxCollectionSizeAllowsResultsAtTheLimit checks the limit is inclusive
+// and that leaving it unset keeps collections unbounded by size.
+func TestMaxCollectionSizeAllowsResultsAtTheLimit(t *testing.T) {
+	t.Parallel()
+
+	source := `def run()
+  [
+    (1..10).to_a.size,
+    "a,b,c,d,e,f,g,h,i,j".split(",").size,
+    (1..10).map { |n| n * 2 }.size,
+    [1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11].window(2).size,
+    [1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 1, 2].tally.size
+  ]
+end`
+
+	tests := []struct {
+		name string
+		cfg  Config
+		want []Value
+	}{
+		{name: "at limit", cfg: Config{MaxCollectionSize: 10}, want: []Value{NewInt(10), NewInt(10), NewInt(10), NewInt(10), NewInt(10)}},
+		{name: "unlimited", cfg: Config{}, want: []Value{NewInt(10), NewInt(10), NewInt(10), NewInt(10), NewInt(10)}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			script := compileScriptWithConfig(t, tc.cfg, source)
+			got, err := script.Call(context.Background(), "run", nil, CallOptions{})
+			if err != nil {
+				t.Fatalf("call failed: %v", err)
+			}
+			compareArrays(t, got, tc.want)
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package runtime
 
 import (
+	"crypto/sha256"
 	"fmt"
 	"strings"
 	"unicode"
@@ -9,9 +10,52 @@ import (
 	"github.com/mgomes/vibescript/internal/parser"
 )
 
+// maxCompileCacheEntries bounds how many compiled scripts an engine keeps for
+// reuse. Once full, the oldest entry is evicted to make room.
+const maxCompileCacheEntries = 256
+
+// Compile parses and compiles source. Successful results are cached by the
+// SHA-256 of the source, so compiling identical source again returns the same
+// *Script without reparsing. A Script is immutable once compiled, and every
+// call clones the state it mutates, so sharing one across callers is safe.
 func (e *Engine) Compile(source string) (*Script, error) {
+	key := sha256.Sum256([]byte(source))
+	if script, ok := e.cachedCompile(key); ok {
+		return script, nil
+	}
 	script, _, _, err := CompileWithProgram(e, source)
-	return script, err
+	if err != nil {
+		return script, err
+	}
+	return e.storeCompiled(key, script), nil
+}
+
+func (e *Engine) cachedCompile(key [sha256.Size]byte) (*Script, bool) {
+	e.compileMu.Lock()
+	defer e.compileMu.Unlock()
+	script, ok := e.compileCache[key]
+	return script, ok
+}
+
+// storeCompiled caches script under key and returns the cached Script. When a
+// concurrent Compile of the same source stored first, its Script wins so every
+// caller observes one instance.
+func (e *Engine) storeCompiled(key [sha256.Size]byte, script *Script) *Script {
+	e.compileMu.Lock()
+	defer e.compileMu.Unlock()
+	if existing, ok := e.compileCache[key]; ok {
+		return existing
+	}
+	if e.compileCache == nil {
+		e.compileCache = make(map[[sha256.Size]byte]*Script)
+	}
+	if len(e.compileOrder) >= maxCompileCacheEntries {
+		delete(e.compileCache, e.compileOrder[0])
+		e.compileOrder = e.compileOrder[1:]
+	}
+	e.compileCache[key] = script
+	e.compileOrder = append(e.compileOrder, key)
+	return script
 }
 
 // CompileWithProgram compiles source and returns the parsed program from the
@@ -82,7 +126,7 @@ func snippetEntrypointProgram(program *ast.Program, entrypoint string) (*ast.Pro
 	pos := Position{Line: 1, Column: 1}
 	for _, stmt := range program.Statements {
 		switch typed := stmt.(type) {
-		case *FunctionStmt, *EnumStmt:
+		case *FunctionStmt, *ModuleStmt, *EnumStmt:
 			out.Statements = append(out.Statements, typed)
 		case *ClassStmt:
 			out.Statements = append(out.Statements, typed)
@@ -110,7 +154,7 @@ func snippetEntrypointProgram(program *ast.Program, entrypoint string) (*ast.Pro
 func snippetHasExecutableTopLevel(program *ast.Program) bool {
 	for _, stmt := range program.Statements {
 		switch stmt.(type) {
-		case *FunctionStmt, *ClassStmt, *EnumStmt:
+		case *FunctionStmt, *ClassStmt, *ModuleStmt, *EnumStmt:
 			continue
 		default:
 			return true
@@ -128,6 +172,7 @@ func compileParsed(e *Engine, source string, program *ast.Program) (*Script, err
 	classes := make(map[string]*ClassDef)
 	classOrder := make([]string, 0)
 	enums := make(map[string]*EnumDef)
+	mixins := make(map[string]*ModuleStmt)
 
 	for _, stmt := range program.Statements {
 		switch s := stmt.(type) {
@@ -141,6 +186,9 @@ func compileParsed(e *Engine, source string, program *ast.Program) (*Script, err
 			if _, exists := enums[s.Name]; exists {
 				return nil, fmt.Errorf("duplicate top-level name %s", s.Name)
 			}
+			if _, exists := mixins[s.Name]; exists {
+				return nil, fmt.Errorf("duplicate top-level name %s", s.Name)
+			}
 			functions[s.Name] = compileFunctionDef(s)
 		case *ClassStmt:
 			if _, exists := classes[s.Name]; exists {
@@ -152,7 +200,25 @@ func compileParsed(e *Engine, source string, program *ast.Program) (*Script, err
 			if _, exists := enums[s.Name]; exists {
 				return nil, fmt.Errorf("duplicate top-level name %s", s.Name)
 			}
-			classes[s.Name] = compileClassDef(s)
+			if _, exists := mixins[s.Name]; exists {
+				return nil, fmt.Errorf("duplicate top-level name %s", s.Name)
+			}
+			classDef := compileClassDef(s)
+			if err := includeMixins(classDef, s, mixins); err != nil {
+				return nil, err
+			}
+			if s.Superclass != "" {
+				superclass, ok := classes[s.Superclass]
+				if !ok && s.Superclass == errorClassName {
+					superclass, ok = newErrorBaseClass(), true
+					classes[errorClassName] = superclass
+				}
+				if !ok {
+					return nil, fmt.Errorf("class %s superclass %s must be a class defined earlier", s.Name, s.Superclass)
+				}
+				classDef.Superclass = superclass
+			}
+			classes[s.Name] = classDef
 			classOrder = append(classOrder, s.Name)
 		case *EnumStmt:
 			if _, exists := enums[s.Name]; exists {
@@ -164,11 +230,28 @@ func compileParsed(e *Engine, source string, program *ast.Program) (*Script, err
 			if _, exists := classes[s.Name]; exists {
 				return nil, fmt.Errorf("duplicate top-level name %s", s.Name)
 			}
+			if _, exists := mixins[s.Name]; exists {
+				return nil, fmt.Errorf("duplicate top-level name %s", s.Name)
+			}
 			enumDef, err := compileEnumDef(s)
 			if err != nil {
 				return nil, err
 			}
 			enums[s.Name] = enumDef
+		case *ModuleStmt:
+			if _, exists := mixins[s.Name]; exists {
+				return nil, fmt.Errorf("duplicate module %s", s.Name)
+			}
+			if _, exists := functions[s.Name]; exists {
+				return nil, fmt.Errorf("duplicate top-level name %s", s.Name)
+			}
+			if _, exists := classes[s.Name]; exists {
+				return nil, fmt.Errorf("duplicate top-level name %s", s.Name)
+			}
+			if _, exists := enums[s.Name]; exists {
+				return nil, fmt.Errorf("duplicate top-level name %s", s.Name)
+			}
+			mixins[s.Name] = s
 		default:
 			return nil, fmt.Errorf("unsupported top-level statement %T", stmt)
 		}
@@ -191,9 +274,10 @@ func compileClassDef(stmt *ClassStmt) *ClassDef {
 		for _, name := range prop.Names {
 			if prop.Kind == "property" || prop.Kind == "getter" {
 				getter := &ScriptFunction{
-					Name: name,
-					Body: []Statement{&ReturnStmt{Value: &IvarExpr{Name: name, Position: prop.Position}, Position: prop.Position}},
-					Pos:  prop.Position,
+					Name:      name,
+					Body:      []Statement{&ReturnStmt{Value: &IvarExpr{Name: name, Position: prop.Position}, Position: prop.Position}},
+					Pos:       prop.Position,
+					className: stmt.Name,
 				}
 				classDef.Methods[name] = getter
 			}
@@ -211,21 +295,49 @@ func compileClassDef(stmt *ClassStmt) *ClassDef {
 						},
 						&ReturnStmt{Value: &Identifier{Name: "value", Position: prop.Position}, Position: prop.Position},
 					},
-					Pos: prop.Position,
+					Pos:       prop.Position,
+					className: stmt.Name,
 				}
 				classDef.Methods[name+"="] = setter
 			}
 		}
 	}
 	for _, fn := range stmt.Methods {
-		classDef.Methods[fn.Name] = compileFunctionDef(fn)
+		method := compileFunctionDef(fn)
+		method.className = stmt.Name
+		classDef.Methods[fn.Name] = method
 	}
 	for _, fn := range stmt.ClassMethods {
-		classDef.ClassMethods[fn.Name] = compileFunctionDef(fn)
+		method := compileFunctionDef(fn)
+		method.className = stmt.Name
+		classDef.ClassMethods[fn.Name] = method
 	}
 	return classDef
 }
 
+// includeMixins merges the methods of each module the class includes into its
+// method table. Methods the class defines itself take precedence, and a later
+// include takes precedence over an earlier one. Each merged method is compiled
+// afresh for the class so super resolves against the class's superclass.
+func includeMixins(classDef *ClassDef, stmt *ClassStmt, mixins map[string]*ModuleStmt) error {
+	for i := len(stmt.Includes) - 1; i >= 0; i-- {
+		name := stmt.Includes[i]
+		mixin, ok := mixins[name]
+		if !ok {
+			return fmt.Errorf("class %s includes %s, which must be a module defined earlier", stmt.Name, name)
+		}
+		for _, fn := range mixin.Methods {
+			if _, exists := classDef.Methods[fn.Name]; exists {
+				continue
+			}
+			method := compileFunctionDef(fn)
+			method.className = stmt.Name
+			classDef.Methods[fn.Name] = method
+		}
+	}
+	return nil
+}
+
 func compileEnumDef(stmt *EnumStmt) (*EnumDef, error) {
 	if strings.HasSuffix(stmt.Name, "?") {
 		return nil, fmt.Errorf("enum name %s must not end with '?'", stmt.Name)
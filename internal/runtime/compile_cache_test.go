@@ -0,0 +1,119 @@
+package runtime
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+)
+
+func TestEngineCompileCachesIdenticalSource(t *testing.T) {
+	t.Parallel()
+
+	engine := MustNewEngine(Config{})
+	source := "def run(n)\n  n * 2\nend"
+
+	first, err := engine.Compile(source)
+	if err != nil {
+		t.Fatalf("compile failed: %v", err)
+	}
+	second, err := engine.Compile(source)
+	if err != nil {
+		t.Fatalf("recompile failed: %v", err)
+	}
+	if first != second {
+		t.Fatal("recompiling identical source should return the cached script")
+	}
+
+	other, err := engine.Compile(source + "\n")
+	if err != nil {
+		t.Fatalf("compile of changed source failed: %v", err)
+	}
+	if other == first {
+		t.Fatal("different source must not share a cached script")
+	}
+
+	// The shared script keeps working for every caller.
+	for range 2 {
+		got, err := second.Call(context.Background(), "run", []Value{NewInt(21)}, CallOptions{})
+		if err != nil {
+			t.Fatalf("call failed: %v", err)
+		}
+		if got.Int() != 42 {
+			t.Fatalf("run(21) = %v, want 42", got)
+		}
+	}
+
+	if cleared := engine.ClearCompileCache(); cleared != 2 {
+		t.Fatalf("ClearCompileCache() = %d, want 2", cleared)
+	}
+	fresh, err := engine.Compile(source)
+	if err != nil {
+		t.Fatalf("compile after clear failed: %v", err)
+	}
+	if fresh == first {
+		t.Fatal("compile after ClearCompileCache should reparse")
+	}
+}
+
+func TestEngineCompileCacheSkipsErrorsAndEvictsOldest(t *testing.T) {
+	t.Parallel()
+
+	engine := MustNewEngine(Config{})
+	if _, err := engine.Compile("def broken(\nend"); err == nil {
+		t.Fatal("expected a parse error")
+	}
+	if cleared := engine.ClearCompileCache(); cleared != 0 {
+		t.Fatalf("failed compiles should not be cached, cleared %d", cleared)
+	}
+
+	first, err := engine.Compile("def f0()\n  0\nend")
+	if err != nil {
+		t.Fatalf("compile failed: %v", err)
+	}
+	for i := 1; i <= maxCompileCacheEntries; i++ {
+		if _, err := engine.Compile(fmt.Sprintf("def f%d()\n  %d\nend", i, i)); err != nil {
+			t.Fatalf("compile %d failed: %v", i, err)
+		}
+	}
+	again, err := engine.Compile("def f0()\n  0\nend")
+	if err != nil {
+		t.Fatalf("recompile failed: %v", err)
+	}
+	if again == first {
+		t.Fatal("the oldest entry should have been evicted")
+	}
+	if cleared := engine.ClearCompileCache(); cleared != maxCompileCacheEntries {
+		t.Fatalf("ClearCompileCache() = %d, want %d", cleared, maxCompileCacheEntries)
+	}
+}
+
+func TestEngineCompileCacheIsConcurrencySafe(t *testing.T) {
+	t.Parallel()
+
+	engine := MustNewEngine(Config{})
+	source := "def run()\n  1\nend"
+
+	const workers = 16
+	scripts := make([]*Script, workers)
+	var wg sync.WaitGroup
+	for i := range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			script, err := engine.Compile(source)
+			if err != nil {
+				t.Errorf("compile failed: %v", err)
+				return
+			}
+			scripts[i] = script
+		}()
+	}
+	wg.Wait()
+
+	for i, script := range scripts {
+		if script != scripts[0] {
+			t.Fatalf("worker %d got a different script instance", i)
+		}
+	}
+}
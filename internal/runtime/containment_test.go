@@ -172,6 +172,63 @@ end`)
 	runContainmentSubprocess(t, "flatten-cycle", "TestArrayFlattenRejectsCycles")
 }
 
+// TestIndexAssignedCyclesStayBounded builds self-referential containers the way
+// a script can, through index assignment, and checks that every recursive path
+// either renders the cycle marker, compares co-inductively, or raises a cyclic
+// structure error instead of recursing forever.
+func TestIndexAssignedCyclesStayBounded(t *testing.T) {
+	if os.Getenv("VIBES_CONTAINMENT_SUBPROCESS") == "index-assign-cycle" {
+		script := compileScriptDefault(t, `def build
+  a = [1, 2]
+  a[0] = a
+  a
+end
+
+def facts
+  a = build()
+  b = build()
+  h = { x: 1 }
+  h[:self] = h
+  {
+    rendered: "#{a} #{h}",
+    inspected: a.inspect,
+    same: a == a,
+    twins: a == b,
+    hashes: h == h,
+    differs: a == [1, 2],
+    summed: a.sum { |item| 1 }
+  }
+end
+
+def join
+  build().join("-")
+end
+
+def flatten
+  build().flatten
+end`)
+
+		facts := callScript(t, context.Background(), script, "facts", nil, CallOptions{})
+		compareHash(t, facts.Hash(), map[string]Value{
+			"rendered":  NewString("[<cycle>, 2] {self: <cycle>, x: 1}"),
+			"inspected": NewString("[<cycle>, 2]"),
+			"same":      NewBool(true),
+			"twins":     NewBool(true),
+			"hashes":    NewBool(true),
+			"differs":   NewBool(false),
+			"summed":    NewInt(2),
+		})
+
+		for _, fn := range []string{"join", "flatten"} {
+			_, err := script.Call(context.Background(), fn, nil, CallOptions{})
+			requireErrorContains(t, err, "array."+fn+" does not support cyclic structures")
+		}
+		return
+	}
+
+	runContainmentSubprocess(t, "index-assign-cycle", "TestIndexAssignedCyclesStayBounded")
+}
+
 func TestStringRegexMembersEnforceSizeGuards(t *testing.T) {
 	script := compileScriptWithConfig(t, Config{MemoryQuotaBytes: 8 << 20}, `def match_text(text, pattern)
   text.match(pattern)
@@ -0,0 +1,176 @@
+package runtime
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// The capitalized conversion functions mirror Ruby's Kernel#Integer, Float,
+// String, and Array. Unlike the lenient string.to_i/to_f they reject input
+// they cannot convert exactly, so a script can normalize untrusted values and
+// fail loudly on garbage instead of silently reading it as zero.
+
+// strictFloatPattern accepts decimal floats with optional fraction, exponent,
+// and single underscores between digits, like a Ruby float literal. Special
+// spellings such as "Infinity" and "NaN", which strconv.ParseFloat would
+// otherwise accept, are rejected.
+var strictFloatPattern = regexp.MustCompile(`^[+-]?[0-9]+(_[0-9]+)*(\.[0-9]+(_[0-9]+)*)?([eE][+-]?[0-9]+(_[0-9]+)*)?$`)
+
+// registerConversionKinds marks the registered conversion builtins as
+// stand-ins for their kinds in case equality. It runs after the builtins are
+// registered, so only those exact values match by kind.
+func registerConversionKinds(engine *Engine) {
+	kinds := map[string]func(Value) bool{
+		"Integer": isIntegerValue,
+		"Float":   func(val Value) bool { return val.Kind() == KindFloat },
+		"String":  func(val Value) bool { return val.Kind() == KindString },
+		"Array":   func(val Value) bool { return val.Kind() == KindArray },
+	}
+	for name, matches := range kinds {
+		valueBuiltin(engine.builtins[name]).matchesKind = matches
+	}
+}
+
+func builtinInteger(exec *Execution, receiver Value, args []Value, kwargs map[string]Value, block Value) (Value, error) {
+	if len(kwargs) > 0 {
+		return NewNil(), fmt.Errorf("Integer does not accept keyword arguments")
+	}
+	if !block.IsNil() {
+		return NewNil(), fmt.Errorf("Integer does not accept blocks")
+	}
+	if len(args) < 1 || len(args) > 2 {
+		return NewNil(), fmt.Errorf("Integer expects a value and an optional base")
+	}
+	val := args[0]
+	if len(args) == 2 {
+		if val.Kind() != KindString {
+			return NewNil(), fmt.Errorf("Integer base requires a string value")
+		}
+		base := args[1]
+		if base.Kind() != KindInt || base.Int() < 2 || base.Int() > 36 {
+			return NewNil(), fmt.Errorf("Integer base must be an integer between 2 and 36")
+		}
+		return parseStrictInteger(val.String(), int(base.Int()))
+	}
+	switch val.Kind() {
+	case KindInt, KindBigInt:
+		return val, nil
+	case KindFloat:
+		f := val.Float()
+		if math.IsNaN(f) || math.IsInf(f, 0) {
+			return NewNil(), fmt.Errorf("Integer cannot convert float %s", val.String())
+		}
+		n, err := floatToInt64Checked(math.Trunc(f), "Integer")
+		if err != nil {
+			return NewNil(), err
+		}
+		return NewInt(n), nil
+	case KindString:
+		return parseStrictInteger(val.String(), 0)
+	default:
+		return NewNil(), fmt.Errorf("Integer cannot convert %s", val.Kind())
+	}
+}
+
+// parseStrictInteger parses s as a whole integer. Base 0 follows Go (and
+// Ruby) literal syntax: an optional sign, a 0b/0o/0x or leading-zero octal
+// prefix, and single underscores between digits. Surrounding whitespace is
+// ignored; anything else makes the value invalid.
+func parseStrictInteger(s string, base int) (Value, error) {
+	text := strings.TrimSpace(s)
+	n, err := strconv.ParseInt(text, base, 64)
+	if err == nil {
+		return NewInt(n), nil
+	}
+	if errors.Is(err, strconv.ErrRange) {
+		return NewNil(), int64RangeError("Integer")
+	}
+	return NewNil(), fmt.Errorf("Integer invalid value: %q", s)
+}
+
+func builtinFloat(exec *Execution, receiver Value, args []Value, kwargs map[string]Value, block Value) (Value, error) {
+	val, err := singleConversionArg("Float", args, kwargs, block)
+	if err != nil {
+		return NewNil(), err
+	}
+	switch val.Kind() {
+	case KindFloat:
+		return val, nil
+	case KindInt:
+		return NewFloat(float64(val.Int())), nil
+	case KindBigInt:
+		f, _ := new(big.Float).SetInt(val.BigInt()).Float64()
+		return NewFloat(f), nil
+	case KindString:
+		text := strings.TrimSpace(val.String())
+		if !strictFloatPattern.MatchString(text) {
+			return NewNil(), fmt.Errorf("Float invalid value: %q", val.String())
+		}
+		f, err := strconv.ParseFloat(strings.ReplaceAll(text, "_", ""), 64)
+		if err != nil && !errors.Is(err, strconv.ErrRange) {
+			return NewNil(), fmt.Errorf("Float invalid value: %q", val.String())
+		}
+		return NewFloat(f), nil
+	default:
+		return NewNil(), fmt.Errorf("Float cannot convert %s", val.Kind())
+	}
+}
+
+func builtinString(exec *Execution, receiver Value, args []Value, kwargs map[string]Value, block Value) (Value, error) {
+	val, err := singleConversionArg("String", args, kwargs, block)
+	if err != nil {
+		return NewNil(), err
+	}
+	if val.Kind() == KindString {
+		return val, nil
+	}
+	out, err := renderValueWithin(exec, "String result", val, false, maxStringConversionBytes)
+	if err != nil {
+		return NewNil(), err
+	}
+	return NewString(out), nil
+}
+
+// builtinArrayConversion implements Array(value): nil becomes an empty
+// array, an array is returned as is, hashes and ranges expand through their
+// to_a, and any other value is wrapped in a one-element array. It backs the
+// Array builtin registered by registerArrayBuiltins, which also carries the
+// Array.new member.
+func builtinArrayConversion(exec *Execution, receiver Value, args []Value, kwargs map[string]Value, block Value) (Value, error) {
+	val, err := singleConversionArg("Array", args, kwargs, block)
+	if err != nil {
+		return NewNil(), err
+	}
+	switch val.Kind() {
+	case KindNil:
+		return NewArray(nil), nil
+	case KindArray:
+		return val, nil
+	case KindHash, KindRange:
+		toA, err := exec.getMember(val, "to_a", Position{})
+		if err != nil {
+			return NewNil(), err
+		}
+		return valueBuiltin(toA).Fn(exec, val, nil, nil, NewNil())
+	default:
+		return NewArray([]Value{val}), nil
+	}
+}
+
+func singleConversionArg(name string, args []Value, kwargs map[string]Value, block Value) (Value, error) {
+	if len(args) != 1 {
+		return NewNil(), fmt.Errorf("%s expects a single value argument", name)
+	}
+	if len(kwargs) > 0 {
+		return NewNil(), fmt.Errorf("%s does not accept keyword arguments", name)
+	}
+	if !block.IsNil() {
+		return NewNil(), fmt.Errorf("%s does not accept blocks", name)
+	}
+	return args[0], nil
+}
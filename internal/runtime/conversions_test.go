@@ -0,0 +1,146 @@
+package runtime
+
+import (
+	"io"
+	"strings"
+	"testing"
+)
+
+func TestConversionFunctions(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		expr string
+		want Value
+	}{
+		{name: "Integer int", expr: `Integer(42)`, want: NewInt(42)},
+		{name: "Integer float truncates", expr: `Integer(-3.9)`, want: NewInt(-3)},
+		{name: "Integer decimal string", expr: `Integer(" -17 ")`, want: NewInt(-17)},
+		{name: "Integer hex string", expr: `Integer("0x1A")`, want: NewInt(26)},
+		{name: "Integer binary string", expr: `Integer("0b101")`, want: NewInt(5)},
+		{name: "Integer underscores", expr: `Integer("1_000")`, want: NewInt(1000)},
+		{name: "Integer base", expr: `Integer("ff", 16)`, want: NewInt(255)},
+		{name: "Float int", expr: `Float(2)`, want: NewFloat(2)},
+		{name: "Float string", expr: `Float("1_000.5e2")`, want: NewFloat(100050)},
+		{name: "Float integer string", expr: `Float("-7")`, want: NewFloat(-7)},
+		{name: "String nil", expr: `String(nil)`, want: NewString("")},
+		{name: "String int", expr: `String(12)`, want: NewString("12")},
+		{name: "String symbol", expr: `String(:ok)`, want: NewString("ok")},
+		{name: "String array matches interpolation", expr: `String([1, "a"]) == "#{[1, "a"]}"`, want: NewBool(true)},
+		{name: "Array nil", expr: `Array(nil)`, want: NewArray(nil)},
+		{name: "Array array", expr: `Array([1, 2])`, want: NewArray([]Value{NewInt(1), NewInt(2)})},
+		{name: "Array range", expr: `Array(1..3)`, want: NewArray([]Value{NewInt(1), NewInt(2), NewInt(3)})},
+		{name: "Array hash", expr: `Array({ a: 1 })`, want: NewArray([]Value{NewArray([]Value{NewSymbol("a"), NewInt(1)})})},
+		{name: "Array scalar", expr: `Array("x")`, want: NewArray([]Value{NewString("x")})},
+		{name: "Array.new still works", expr: `Array.new(2, 0)`, want: NewArray([]Value{NewInt(0), NewInt(0)})},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			script := compileScript(t, "def run()\n  "+tt.expr+"\nend")
+			got := callFunc(t, script, "run", nil)
+			if !got.Equal(tt.want) {
+				t.Fatalf("%s = %v, want %v", tt.expr, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConversionFunctionsRejectInvalidInput(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		expr string
+		want string
+	}{
+		{name: "Integer trailing garbage", expr: `Integer("12abc")`, want: `Integer invalid value: "12abc"`},
+		{name: "Integer empty string", expr: `Integer("")`, want: `Integer invalid value: ""`},
+		{name: "Integer float string", expr: `Integer("1.5")`, want: `Integer invalid value: "1.5"`},
+		{name: "Integer nil", expr: `Integer(nil)`, want: "Integer cannot convert nil"},
+		{name: "Integer nan", expr: `Integer(0.0 / 0.0)`, want: "Integer cannot convert float NaN"},
+		{name: "Integer out of range", expr: `Integer("99999999999999999999")`, want: "Integer result out of int64 range"},
+		{name: "Integer base on int", expr: `Integer(10, 2)`, want: "Integer base requires a string value"},
+		{name: "Integer bad base", expr: `Integer("10", 1)`, want: "Integer base must be an integer between 2 and 36"},
+		{name: "Integer digit outside base", expr: `Integer("12", 2)`, want: `Integer invalid value: "12"`},
+		{name: "Integer arity", expr: `Integer()`, want: "Integer expects a value and an optional base"},
+		{name: "Float garbage", expr: `Float("1.5x")`, want: `Float invalid value: "1.5x"`},
+		{name: "Float empty string", expr: `Float("")`, want: `Float invalid value: ""`},
+		{name: "Float nan string", expr: `Float("NaN")`, want: `Float invalid value: "NaN"`},
+		{name: "Float nil", expr: `Float(nil)`, want: "Float cannot convert nil"},
+		{name: "Float arity", expr: `Float(1, 2)`, want: "Float expects a single value argument"},
+		{name: "String arity", expr: `String()`, want: "String expects a single value argument"},
+		{name: "Array keyword", expr: `Array(1, size: 2)`, want: "Array does not accept keyword arguments"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			script := compileScript(t, "def run()\n  "+tt.expr+"\nend")
+			requireCallErrorContains(t, script, "run", nil, CallOptions{}, tt.want)
+		})
+	}
+}
+
+func TestArrayIsCallableValue(t *testing.T) {
+	t.Parallel()
+
+	script := compileScript(t, `
+def run()
+  convert = Array
+  [convert(1..2), convert.new(2, 0), convert.new]
+end
+
+def unknown()
+  Array.build(2)
+end
+`)
+	result := callFunc(t, script, "run", nil)
+	want := NewArray([]Value{
+		NewArray([]Value{NewInt(1), NewInt(2)}),
+		NewArray([]Value{NewInt(0), NewInt(0)}),
+		NewArray([]Value{}),
+	})
+	if !result.Equal(want) {
+		t.Fatalf("run() = %s, want %s", result, want)
+	}
+	requireCallErrorContains(t, script, "unknown", nil, CallOptions{}, "unknown member build")
+
+	engine := MustNewEngine(Config{})
+	engine.RegisterBuiltin("Array", func(_ *Execution, _ Value, args []Value, _ map[string]Value, _ Value) (Value, error) {
+		return NewString("host"), nil
+	})
+	hosted := compileScriptWithEngine(t, engine, "def run()\n  Array(1)\nend")
+	if got := callFunc(t, hosted, "run", nil); !got.Equal(NewString("host")) {
+		t.Fatalf("host Array(1) = %s, want host", got)
+	}
+}
+
+func TestStringConversionLimit(t *testing.T) {
+	t.Parallel()
+
+	script := compileScriptWithConfig(t, Config{MemoryQuotaBytes: 256 << 20, OutputWriter: io.Discard}, `
+def convert(value)
+  String(value)
+end
+
+def show(value)
+  puts(value)
+end
+`)
+	chunk := NewString(strings.Repeat("x", 1<<20))
+
+	// Two MiB renders as a string even though puts rejects it.
+	medium := NewArray([]Value{chunk, chunk})
+	got := callFunc(t, script, "convert", []Value{medium})
+	if got.Kind() != KindString || len(got.String()) <= 2<<20 {
+		t.Fatalf("String(2 MiB array) = %d bytes, want the full rendering", len(got.String()))
+	}
+	requireCallErrorContains(t, script, "show", []Value{medium}, CallOptions{}, "puts output exceeds limit")
+
+	large := make([]Value, 17)
+	for i := range large {
+		large[i] = chunk
+	}
+	requireCallErrorContains(t, script, "convert", []Value{NewArray(large)}, CallOptions{}, "String result exceeds limit 16777216 bytes")
+}
@@ -0,0 +1,52 @@
+package runtime
+
+import (
+	"slices"
+	"sync"
+)
+
+// Coverage records which source lines ran statements during a call. Pass one
+// as CallOptions.Coverage and read Lines after the call returns. Reusing a
+// Coverage across calls accumulates their lines, so a host can run a suite
+// and report what never ran. A Coverage is safe for concurrent use, so tasks
+// spawned by the script record into it too.
+type Coverage struct {
+	mu    sync.Mutex
+	lines map[string]map[int]struct{}
+}
+
+// Lines returns the covered lines of the called script in ascending order.
+func (c *Coverage) Lines() []int {
+	return c.ModuleLines("")
+}
+
+// ModuleLines returns the covered lines of the required module at path, the
+// same path StackFrame.Source reports, in ascending order. An empty path
+// selects the called script.
+func (c *Coverage) ModuleLines(path string) []int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	lines := make([]int, 0, len(c.lines[path]))
+	for line := range c.lines[path] {
+		lines = append(lines, line)
+	}
+	slices.Sort(lines)
+	return lines
+}
+
+func (c *Coverage) record(path string, line int) {
+	if line <= 0 {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.lines == nil {
+		c.lines = make(map[string]map[int]struct{})
+	}
+	covered := c.lines[path]
+	if covered == nil {
+		covered = make(map[int]struct{})
+		c.lines[path] = covered
+	}
+	covered[line] = struct{}{}
+}
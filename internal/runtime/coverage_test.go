@@ -0,0 +1,50 @@
+package runtime
+
+import (
+	"context"
+	"path/filepath"
+	"slices"
+	"testing"
+)
+
+func TestCoverageRecordsExecutedLines(t *testing.T) {
+	t.Parallel()
+	script := compileScriptDefault(t, `def classify(n)
+  label = "small"
+  if n > 10
+    label = "large"
+  end
+  label
+end`)
+
+	coverage := &Coverage{}
+	opts := CallOptions{Coverage: coverage}
+	callScript(t, context.Background(), script, "classify", []Value{NewInt(3)}, opts)
+	if got, want := coverage.Lines(), []int{2, 3, 6}; !slices.Equal(got, want) {
+		t.Fatalf("covered lines = %v, want %v", got, want)
+	}
+
+	callScript(t, context.Background(), script, "classify", []Value{NewInt(30)}, opts)
+	if got, want := coverage.Lines(), []int{2, 3, 4, 6}; !slices.Equal(got, want) {
+		t.Fatalf("covered lines after both branches = %v, want %v", got, want)
+	}
+}
+
+func TestCoverageRecordsModuleLinesSeparately(t *testing.T) {
+	t.Parallel()
+	engine := moduleTestEngine(t)
+	script := compileScriptWithEngine(t, engine, `def run(value)
+  helpers = require("helper")
+  helpers.triple(value)
+end`)
+
+	coverage := &Coverage{}
+	callScript(t, context.Background(), script, "run", []Value{NewInt(2)}, CallOptions{AllowRequire: true, Coverage: coverage})
+	if got, want := coverage.Lines(), []int{2, 3}; !slices.Equal(got, want) {
+		t.Fatalf("script lines = %v, want %v", got, want)
+	}
+	helperPath := filepath.Join(engine.config.ModulePaths[0], "helper.vibe")
+	if got, want := coverage.ModuleLines(helperPath), []int{6}; !slices.Equal(got, want) {
+		t.Fatalf("module lines = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,41 @@
+package runtime
+
+import (
+	"crypto/md5"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"hash"
+)
+
+// registerDigestBuiltins installs the `Digest` namespace, whose helpers hash a
+// string and return the lowercase hex digest, like Ruby's
+// Digest::SHA256.hexdigest. Hashing is deterministic and side-effect-free, so
+// it needs no host capability. MD5 is provided for compatibility with existing
+// checksums and must not be used where collision resistance matters.
+func registerDigestBuiltins(engine *Engine) {
+	engine.builtins["Digest"] = NewObject(map[string]Value{
+		"sha256": digestBuiltin("Digest.sha256", sha256.New),
+		"md5":    digestBuiltin("Digest.md5", md5.New),
+	})
+}
+
+func digestBuiltin(name string, newHash func() hash.Hash) Value {
+	return NewBuiltin(name, func(_ *Execution, _ Value, args []Value, kwargs map[string]Value, block Value) (Value, error) {
+		if len(kwargs) > 0 {
+			return NewNil(), fmt.Errorf("%s does not take keyword arguments", name)
+		}
+		if !block.IsNil() {
+			return NewNil(), fmt.Errorf("%s does not accept blocks", name)
+		}
+		if len(args) != 1 {
+			return NewNil(), fmt.Errorf("%s expects 1 argument, got %d", name, len(args))
+		}
+		if args[0].Kind() != KindString {
+			return NewNil(), fmt.Errorf("%s expects a string, got %s", name, args[0].Kind())
+		}
+		h := newHash()
+		h.Write([]byte(args[0].String()))
+		return NewString(hex.EncodeToString(h.Sum(nil))), nil
+	})
+}
@@ -0,0 +1,62 @@
+package runtime
+
+import "testing"
+
+func TestDigestKnownVectors(t *testing.T) {
+	t.Parallel()
+	script := compileScript(t, `def sha256(s)
+  Digest.sha256(s)
+end
+
+def md5(s)
+  Digest.md5(s)
+end
+
+def idempotency_key(player_id)
+  Digest.sha256("recalc:player-#{player_id}")
+end`)
+
+	tests := []struct {
+		name string
+		fn   string
+		arg  Value
+		want string
+	}{
+		{name: "sha256 empty", fn: "sha256", arg: NewString(""), want: "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"},
+		{name: "sha256 abc", fn: "sha256", arg: NewString("abc"), want: "ba7816bf8f01cfea414140de5dae2223b00361a396177a9cb410ff61f20015ad"},
+		{name: "md5 empty", fn: "md5", arg: NewString(""), want: "d41d8cd98f00b204e9800998ecf8427e"},
+		{name: "md5 abc", fn: "md5", arg: NewString("abc"), want: "900150983cd24fb0d6963f7d28e17f72"},
+		{name: "md5 utf-8 bytes", fn: "md5", arg: NewString("é"), want: "66ddcd97cfdeabb2f6fb8a999b4bc76f"},
+		{name: "idempotency key", fn: "idempotency_key", arg: NewInt(12), want: "74666973899da267bf93d90385dc2d4c29c68041bf7bbe5460c09c6f0438f202"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			got := callFunc(t, script, tc.fn, []Value{tc.arg})
+			if got.Kind() != KindString || got.String() != tc.want {
+				t.Fatalf("%s(%v) = %v, want %q", tc.fn, tc.arg, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestDigestArgumentErrors(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name string
+		expr string
+		want string
+	}{
+		{name: "no argument", expr: "Digest.sha256()", want: "Digest.sha256 expects 1 argument, got 0"},
+		{name: "too many", expr: `Digest.md5("a", "b")`, want: "Digest.md5 expects 1 argument, got 2"},
+		{name: "non-string", expr: "Digest.sha256(12)", want: "Digest.sha256 expects a string, got int"},
+		{name: "keywords", expr: `Digest.md5("a", salt: "b")`, want: "Digest.md5 does not take keyword arguments"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			script := compileScript(t, "def run()\n  "+tc.expr+"\nend")
+			requireCallErrorContains(t, script, "run", nil, CallOptions{}, tc.want)
+		})
+	}
+}
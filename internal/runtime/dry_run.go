@@ -0,0 +1,45 @@
+package runtime
+
+import (
+	"slices"
+	"sync"
+)
+
+// Effect is one mutating capability call recorded by a dry run instead of
+// being sent to the host.
+type Effect struct {
+	// Method is the contract name, for example "db.update".
+	Method string
+	// Args and Kwargs are deep copies of the call's arguments, so later
+	// script mutation does not change the log.
+	Args   []Value
+	Kwargs map[string]Value
+}
+
+// EffectLog collects the effects of a dry run. Pass one as
+// CallOptions.DryRun and read Effects after the call returns. A log is safe
+// for concurrent use, so tasks spawned by the script record into it too.
+type EffectLog struct {
+	mu      sync.Mutex
+	effects []Effect
+}
+
+// Effects returns the recorded effects in call order.
+func (l *EffectLog) Effects() []Effect {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return slices.Clone(l.effects)
+}
+
+func (l *EffectLog) record(method string, args []Value, kwargs map[string]Value) {
+	effect := Effect{Method: method, Args: make([]Value, len(args))}
+	for i, arg := range args {
+		effect.Args[i] = deepCloneValue(arg)
+	}
+	if len(kwargs) > 0 {
+		effect.Kwargs = cloneHash(kwargs)
+	}
+	l.mu.Lock()
+	l.effects = append(l.effects, effect)
+	l.mu.Unlock()
+}
@@ -0,0 +1,72 @@
+package runtime
+
+import (
+	"context"
+	"testing"
+)
+
+func TestDryRunRecordsMutatingCapabilityCalls(t *testing.T) {
+	t.Parallel()
+	script := compileScriptDefault(t, `def run()
+  player = db.find("Player", "p-1")
+  attrs = { score: player[:score] + 1 }
+  saved = db.update("Player", "p-1", attrs)
+  attrs[:score] = 99
+  jobs.enqueue("audit", { id: "p-1" }, key: "audit-p-1")
+  { saved: saved, score: player[:score] }
+end`)
+
+	db := &dbCapabilityStub{findResult: NewHash(map[string]Value{"score": NewInt(4)}), updateResult: NewBool(true)}
+	queue := &jobQueueStub{}
+	log := &EffectLog{}
+	opts := callOptionsWithCapabilities(MustNewDBCapability("db", db), MustNewJobQueueCapability("jobs", queue))
+	opts.DryRun = log
+
+	result := callScript(t, context.Background(), script, "run", nil, opts)
+	if !result.Hash()["saved"].IsNil() || result.Hash()["score"].Int() != 4 {
+		t.Fatalf("dry run result = %v, want nil placeholder and score 4", result)
+	}
+	if len(db.findCalls) != 1 {
+		t.Fatalf("db.find ran %d times, want 1", len(db.findCalls))
+	}
+	if len(db.updateCalls) != 0 || len(queue.enqueueCalls) != 0 {
+		t.Fatalf("dry run invoked host mutations: update %d, enqueue %d", len(db.updateCalls), len(queue.enqueueCalls))
+	}
+
+	effects := log.Effects()
+	if len(effects) != 2 {
+		t.Fatalf("effects = %#v, want 2", effects)
+	}
+	update := effects[0]
+	if update.Method != "db.update" || len(update.Args) != 3 || update.Args[2].Hash()["score"].Int() != 5 {
+		t.Fatalf("update effect = %#v", update)
+	}
+	enqueue := effects[1]
+	if enqueue.Method != "jobs.enqueue" || enqueue.Args[0].String() != "audit" || enqueue.Kwargs["key"].String() != "audit-p-1" {
+		t.Fatalf("enqueue effect = %#v", enqueue)
+	}
+}
+
+func TestDryRunRecordsUnderStrictEffects(t *testing.T) {
+	t.Parallel()
+	script := compileScriptWithConfig(t, Config{StrictEffects: true}, `def run()
+  db.update("Player", "p-1", { score: 5 })
+end`)
+
+	db := &dbCapabilityStub{updateResult: NewBool(true)}
+	log := &EffectLog{}
+	opts := callOptionsWithCapabilities(MustNewDBCapability("db", db))
+	opts.DryRun = log
+
+	result := callScript(t, context.Background(), script, "run", nil, opts)
+	if !result.IsNil() || len(db.updateCalls) != 0 {
+		t.Fatalf("dry run result = %v with %d host updates, want nil and none", result, len(db.updateCalls))
+	}
+	if effects := log.Effects(); len(effects) != 1 || effects[0].Method != "db.update" {
+		t.Fatalf("effects = %#v, want the db.update call", effects)
+	}
+
+	opts.DryRun = nil
+	err := callScriptErr(t, context.Background(), script, "run", nil, opts)
+	requireErrorContains(t, err, "strict effects: db.update is disabled")
+}
@@ -3,10 +3,13 @@ package runtime
 import (
 	"context"
 	cryptorand "crypto/rand"
+	"crypto/sha256"
 	"fmt"
 	"io"
+	"maps"
 	"os"
 	"path/filepath"
+	"slices"
 	"strings"
 	"sync"
 	"time"
@@ -16,6 +19,7 @@ const (
 	defaultMaxSourceBytes     = 1 << 20
 	defaultTaskConcurrency    = 4
 	defaultMaxTaskConcurrency = 64
+	defaultMaxRetries         = 3
 )
 
 // Config controls interpreter execution bounds and enforcement modes.
@@ -23,6 +27,7 @@ type Config struct {
 	StepQuota              int
 	MemoryQuotaBytes       int
 	StrictEffects          bool
+	IntOverflow            IntOverflowPolicy
 	RecursionLimit         int
 	ModulePaths            []string
 	ModuleAllowList        []string
@@ -35,6 +40,13 @@ type Config struct {
 	MaxSourceBytes         int
 	DefaultTaskConcurrency int
 	MaxTaskConcurrency     int
+	MaxCollectionSize      int
+	WarnDiscardedBang      bool
+	AllowIntrospection     bool
+	Env                    map[string]string
+	MaxRetries             int
+	BuiltinAllowList       []string
+	BuiltinDenyList        []string
 }
 
 // Engine executes Vibescript programs with deterministic limits.
@@ -50,11 +62,22 @@ type Engine struct {
 	modSuggestText    map[string]string
 	modSuggestVersion uint64
 
+	// compileCache maps the SHA-256 of a compiled source to its Script so
+	// recompiling identical source skips the parser. compileOrder records
+	// insertion order for eviction once maxCompileCacheEntries is reached.
+	compileCache map[[sha256.Size]byte]*Script
+	compileOrder [][sha256.Size]byte
+	compileMu    sync.Mutex
+
 	// builtinProto is the frozen env shared as every call root's parent.
 	// Mutable namespace builtins are cloned lazily by Env.Get before a
 	// script can mutate them, so calls that do not touch those namespaces
 	// skip their map-clone cost entirely. Rebuilt lazily after RegisterBuiltin.
 	builtinProto *Env
+
+	// standardBuiltins holds the names NewEngine registers. The builtin
+	// allow and deny lists may only name these, and only these are filtered.
+	standardBuiltins map[string]struct{}
 }
 
 // NewEngine constructs an Engine with sane defaults and registers built-ins.
@@ -68,6 +91,9 @@ func NewEngine(cfg Config) (*Engine, error) {
 	if cfg.RecursionLimit <= 0 {
 		cfg.RecursionLimit = 64
 	}
+	if cfg.MaxRetries <= 0 {
+		cfg.MaxRetries = defaultMaxRetries
+	}
 	if cfg.MaxCachedModules == 0 {
 		cfg.MaxCachedModules = 1000
 	}
@@ -77,6 +103,9 @@ func NewEngine(cfg Config) (*Engine, error) {
 	if cfg.MaxSourceBytes == 0 {
 		cfg.MaxSourceBytes = defaultMaxSourceBytes
 	}
+	if cfg.MaxCollectionSize < 0 {
+		return nil, fmt.Errorf("vibes: max collection size cannot be negative")
+	}
 	if cfg.MaxTaskConcurrency <= 0 {
 		cfg.MaxTaskConcurrency = defaultMaxTaskConcurrency
 	}
@@ -89,6 +118,11 @@ func NewEngine(cfg Config) (*Engine, error) {
 	if cfg.RandomReader == nil {
 		cfg.RandomReader = cryptorand.Reader
 	}
+	intOverflow, err := normalizeIntOverflowPolicy(cfg.IntOverflow)
+	if err != nil {
+		return nil, err
+	}
+	cfg.IntOverflow = intOverflow
 
 	modulePaths, err := normalizeModulePaths(cfg.ModulePaths)
 	if err != nil {
@@ -104,6 +138,9 @@ func NewEngine(cfg Config) (*Engine, error) {
 	cfg.ModulePaths = modulePaths
 	cfg.ModuleAllowList = append([]string(nil), cfg.ModuleAllowList...)
 	cfg.ModuleDenyList = append([]string(nil), cfg.ModuleDenyList...)
+	cfg.BuiltinAllowList = append([]string(nil), cfg.BuiltinAllowList...)
+	cfg.BuiltinDenyList = append([]string(nil), cfg.BuiltinDenyList...)
+	cfg.Env = maps.Clone(cfg.Env)
 
 	engine := &Engine{
 		config:         cfg,
@@ -116,15 +153,42 @@ func NewEngine(cfg Config) (*Engine, error) {
 
 	registerCoreBuiltins(engine)
 	registerDataBuiltins(engine)
+	registerArrayBuiltins(engine)
+	registerConversionKinds(engine)
 	registerHashBuiltins(engine)
 	registerMathBuiltins(engine)
+	registerDigestBuiltins(engine)
+	registerEnvBuiltins(engine)
 	registerDurationBuiltins(engine)
 	registerTimeBuiltins(engine)
 	registerTaskBuiltins(engine)
 
+	engine.standardBuiltins = make(map[string]struct{}, len(engine.builtins))
+	for name := range engine.builtins {
+		engine.standardBuiltins[name] = struct{}{}
+	}
+	if err := engine.validateBuiltinPolicy("allow", cfg.BuiltinAllowList); err != nil {
+		return nil, err
+	}
+	if err := engine.validateBuiltinPolicy("deny", cfg.BuiltinDenyList); err != nil {
+		return nil, err
+	}
+
 	return engine, nil
 }
 
+// validateBuiltinPolicy rejects a builtin allow- or deny-list entry that does
+// not name a standard builtin, so a typo such as "rnad" fails here instead of
+// silently leaving rand visible or hiding a builtin the host meant to allow.
+func (e *Engine) validateBuiltinPolicy(label string, names []string) error {
+	for _, name := range names {
+		if _, ok := e.standardBuiltins[name]; !ok {
+			return fmt.Errorf("vibes: builtin %s-list names unknown builtin %q", label, name)
+		}
+	}
+	return nil
+}
+
 func defaultTaskConcurrencyForMax(max int) int {
 	if max < defaultTaskConcurrency {
 		return max
@@ -250,25 +314,47 @@ func registerCoreBuiltins(engine *Engine) {
 		fn         BuiltinFunc
 		autoInvoke bool
 	}{
+		{name: "abs", fn: builtinAbs},
 		{name: "assert", fn: builtinAssert},
+		{name: "benchmark", fn: builtinBenchmark},
+		{name: "bigint", fn: builtinBigInt},
+		{name: "blank?", fn: builtinBlank},
+		{name: "capabilities", fn: builtinCapabilities, autoInvoke: true},
+		{name: "ceil", fn: builtinCeil},
+		{name: "clamp", fn: builtinClamp},
+		{name: "Float", fn: builtinFloat},
+		{name: "floor", fn: builtinFloor},
 		{name: "format", fn: builtinFormat},
+		{name: "Integer", fn: builtinInteger},
+		{name: "locals", fn: builtinLocals, autoInvoke: true},
 		{name: "loop", fn: builtinLoop},
+		{name: "memoize", fn: builtinMemoize},
+		{name: "max", fn: builtinMax},
+		{name: "min", fn: builtinMin},
 		{name: "money", fn: builtinMoney},
 		{name: "money_cents", fn: builtinMoneyCents},
 		{name: "p", fn: builtinP},
+		{name: "pluralize", fn: builtinPluralize},
+		{name: "pp", fn: builtinPP},
+		{name: "present?", fn: builtinPresent},
 		{name: "print", fn: builtinPrint},
 		{name: "puts", fn: builtinPuts},
 		{name: "require", fn: builtinRequire},
+		{name: "round", fn: builtinRound},
+		{name: "sign", fn: builtinSign},
 		{name: "now", fn: builtinNow, autoInvoke: true},
 		{name: "rand", fn: builtinRand, autoInvoke: true},
 		{name: "sleep", fn: builtinSleep},
 		{name: "sprintf", fn: builtinSprintf},
+		{name: "String", fn: builtinString},
 		{name: "srand", fn: builtinSrand},
 		{name: "uuid", fn: builtinUUID, autoInvoke: true},
 		{name: "warn", fn: builtinWarn},
+		{name: "with_timeout", fn: builtinWithTimeout},
 		{name: "random_id", fn: builtinRandomID},
 		{name: "to_int", fn: builtinToInt},
 		{name: "to_float", fn: builtinToFloat},
+		{name: "to_table", fn: builtinToTable},
 	} {
 		if builtin.autoInvoke {
 			engine.RegisterZeroArgBuiltin(builtin.name, builtin.fn)
@@ -311,6 +397,9 @@ func (e *Engine) attachBuiltins(root *Env, extraStatics int) {
 		proto := newEnv(nil)
 		proto.growStatics(len(e.builtins))
 		for name, builtin := range e.builtins {
+			if !e.builtinAllowed(name) {
+				continue
+			}
 			proto.DefineStatic(name, builtin)
 		}
 		proto.frozen = true
@@ -319,6 +408,19 @@ func (e *Engine) attachBuiltins(root *Env, extraStatics int) {
 	e.bindBuiltinsLocked(root, extraStatics)
 }
 
+// builtinAllowed applies Config.BuiltinAllowList and BuiltinDenyList to a
+// global builtin name. Builtins the host registers under a new name are always
+// visible, since the host opted into them by registering them.
+func (e *Engine) builtinAllowed(name string) bool {
+	if _, ok := e.standardBuiltins[name]; !ok {
+		return true
+	}
+	if slices.Contains(e.config.BuiltinDenyList, name) {
+		return false
+	}
+	return len(e.config.BuiltinAllowList) == 0 || slices.Contains(e.config.BuiltinAllowList, name)
+}
+
 // bindBuiltinsLocked wires root to the current proto. Callers must hold builtinsMu.
 func (e *Engine) bindBuiltinsLocked(root *Env, extraStatics int) {
 	root.parent = e.builtinProto
@@ -379,6 +481,8 @@ func cloneBuiltinValue(val Value) Value {
 		clonedBuiltin.DirectCallAlias = builtin.DirectCallAlias
 		clonedBuiltin.CapturedValues = builtin.CapturedValues
 		clonedBuiltin.Capability = builtin.Capability
+		clonedBuiltin.Members = cloneBuiltinMap(builtin.Members)
+		clonedBuiltin.matchesKind = builtin.matchesKind
 		// A bound predicate's BoundReceiver and Fn both read one mutable cell, so a
 		// shallow copy that shares both stays consistent: the copy reads the same
 		// receiver, and a later two-phase clone rebuilds a fresh predicate around
@@ -426,6 +530,19 @@ func (e *Engine) ClearModuleCache() int {
 	return count
 }
 
+// ClearCompileCache drops every cached compile result and returns the number of
+// entries removed. Hosts that hot-reload scripts can call it to release scripts
+// whose source is no longer in use.
+func (e *Engine) ClearCompileCache() int {
+	e.compileMu.Lock()
+	defer e.compileMu.Unlock()
+
+	count := len(e.compileCache)
+	clear(e.compileCache)
+	e.compileOrder = nil
+	return count
+}
+
 // Execute compiles the provided source ensuring it is valid under current config.
 func (e *Engine) Execute(ctx context.Context, script string) error {
 	if ctx == nil {
@@ -463,8 +580,10 @@ func (e *Engine) MaxSourceBytes() int {
 
 func registerDataBuiltins(engine *Engine) {
 	engine.builtins["JSON"] = NewObject(map[string]Value{
-		"parse":     NewBuiltin("JSON.parse", builtinJSONParse),
-		"stringify": NewBuiltin("JSON.stringify", builtinJSONStringify),
+		"dig":         NewBuiltin("JSON.dig", builtinJSONDig),
+		"parse":       NewBuiltin("JSON.parse", builtinJSONParse),
+		"parse_lines": NewBuiltin("JSON.parse_lines", builtinJSONParseLines),
+		"stringify":   NewBuiltin("JSON.stringify", builtinJSONStringify),
 	})
 	engine.builtins["Regex"] = NewObject(map[string]Value{
 		"match":       NewBuiltin("Regex.match", builtinRegexMatch),
@@ -480,6 +599,51 @@ func registerDataBuiltins(engine *Engine) {
 	})
 }
 
+// registerArrayBuiltins exposes Array, which is both the Array(value)
+// conversion function and the namespace holding the new constructor, as in
+// Ruby. Array.new(size, default) repeats default (nil when omitted) and
+// Array.new(size) { |i| ... } fills each slot from the block, so the result
+// goes through the same step, memory, and collection-size checks as
+// array.fill.
+func registerArrayBuiltins(engine *Engine) {
+	array := NewBuiltin("Array", builtinArrayConversion)
+	valueBuiltin(array).Members = map[string]Value{
+		// AutoBuiltin so a bare `Array.new` builds an empty array, matching Ruby.
+		"new": NewAutoBuiltin("Array.new", builtinArrayNew),
+	}
+	engine.builtins["Array"] = array
+}
+
+func builtinArrayNew(exec *Execution, receiver Value, args []Value, kwargs map[string]Value, block Value) (Value, error) {
+	if len(kwargs) > 0 {
+		return NewNil(), fmt.Errorf("Array.new does not accept keyword arguments")
+	}
+	if len(args) > 2 {
+		return NewNil(), fmt.Errorf("Array.new expects a size and an optional default value")
+	}
+	if len(args) == 0 {
+		if !block.IsNil() {
+			return NewNil(), fmt.Errorf("Array.new with a block expects a size")
+		}
+		return NewArray([]Value{}), nil
+	}
+	if args[0].Kind() != KindInt || args[0].Int() < 0 {
+		return NewNil(), fmt.Errorf("Array.new size must be a non-negative integer")
+	}
+	size := args[0]
+	if !block.IsNil() {
+		if len(args) == 2 {
+			return NewNil(), fmt.Errorf("Array.new cannot take both a default value and a block")
+		}
+		return fillArray(exec, "Array.new", NewArray(nil), []Value{NewInt(0), size}, nil, block)
+	}
+	fill := NewNil()
+	if len(args) == 2 {
+		fill = args[1]
+	}
+	return fillArray(exec, "Array.new", NewArray(nil), []Value{fill, NewInt(0), size}, nil, block)
+}
+
 // registerHashBuiltins exposes the Hash namespace, whose new constructor builds
 // an empty hash carrying Ruby-style default metadata. Hash.new(default) returns
 // the default value for missing keys without inserting; Hash.new { |h, k| ... }
@@ -676,7 +840,7 @@ func registerTimeBuiltins(engine *Engine) {
 					loc = parsed
 				}
 			}
-			return NewTime(time.Now().In(loc)), nil
+			return NewTime(exec.now().In(loc)), nil
 		}),
 		"parse": NewBuiltin("Time.parse", func(exec *Execution, receiver Value, args []Value, kwargs map[string]Value, block Value) (Value, error) {
 			if len(args) < 1 || len(args) > 2 || args[0].Kind() != KindString {
@@ -0,0 +1,101 @@
+package runtime
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestNestedEnsureRunsInsideOut(t *testing.T) {
+	t.Parallel()
+
+	var stdout bytes.Buffer
+	script := compileScriptWithConfig(t, Config{OutputWriter: &stdout}, `def fail_through
+  begin
+    begin
+      puts("body")
+      raise("boom")
+    ensure
+      puts("inner ensure")
+    end
+    puts("after inner")
+  ensure
+    puts("outer ensure")
+  end
+end
+
+def fail_rescued
+  begin
+    begin
+      begin
+        raise("boom")
+      ensure
+        puts("inner ensure")
+      end
+    ensure
+      puts("outer ensure")
+    end
+  rescue => err
+    puts("rescue #{err.message}")
+  end
+  "rescued"
+end
+
+def return_through
+  begin
+    begin
+      puts("body")
+      return "inner"
+    ensure
+      puts("inner ensure")
+    end
+    puts("after inner")
+  ensure
+    puts("outer ensure")
+  end
+  "unreachable"
+end
+
+def break_through
+  for i in [1, 2]
+    begin
+      begin
+        break
+      ensure
+        puts("inner ensure")
+      end
+    ensure
+      puts("outer ensure")
+    end
+  end
+  "broke"
+end`)
+
+	requireCallErrorContains(t, script, "fail_through", nil, CallOptions{}, "boom")
+	if got, want := stdout.String(), "body\ninner ensure\nouter ensure\n"; got != want {
+		t.Fatalf("fail_through output = %q, want %q", got, want)
+	}
+
+	stdout.Reset()
+	if got := callFunc(t, script, "fail_rescued", nil); !got.Equal(NewString("rescued")) {
+		t.Fatalf("fail_rescued = %v, want rescued", got)
+	}
+	if got, want := stdout.String(), "inner ensure\nouter ensure\nrescue boom\n"; got != want {
+		t.Fatalf("fail_rescued output = %q, want %q", got, want)
+	}
+
+	stdout.Reset()
+	if got := callFunc(t, script, "return_through", nil); !got.Equal(NewString("inner")) {
+		t.Fatalf("return_through = %v, want inner", got)
+	}
+	if got, want := stdout.String(), "body\ninner ensure\nouter ensure\n"; got != want {
+		t.Fatalf("return_through output = %q, want %q", got, want)
+	}
+
+	stdout.Reset()
+	if got := callFunc(t, script, "break_through", nil); !got.Equal(NewString("broke")) {
+		t.Fatalf("break_through = %v, want broke", got)
+	}
+	if got, want := stdout.String(), "inner ensure\nouter ensure\n"; got != want {
+		t.Fatalf("break_through output = %q, want %q", got, want)
+	}
+}
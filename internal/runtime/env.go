@@ -59,6 +59,19 @@ type Env struct {
 	// transparently chain to it.
 	callBlock    Value
 	hasCallBlock bool
+
+	// callMethod records the class method a call frame is running and the
+	// arguments it received, so super can find the superclass method and a
+	// bare super can forward the arguments. It is nil for top-level
+	// functions and for frames outside a call.
+	callMethod *methodCall
+}
+
+// methodCall is the method and arguments behind a class method's call frame.
+type methodCall struct {
+	fn     *ScriptFunction
+	args   []Value
+	kwargs map[string]Value
 }
 
 func newEnv(parent *Env) *Env {
@@ -99,6 +112,7 @@ func (e *Env) resetForBlockCall(parent *Env) {
 	e.callRoot = false
 	e.callBlock = Value{}
 	e.hasCallBlock = false
+	e.callMethod = nil
 }
 
 // Get looks up a variable by name, traversing parent scopes if needed.
@@ -160,6 +174,19 @@ func (e *Env) lookupCallBlock() (Value, bool) {
 	return Value{}, false
 }
 
+// lookupCallMethod returns the class method running in the nearest enclosing
+// call frame, or nil when that frame is a top-level function or there is none.
+// Like lookupCallBlock it stops at the owning call frame, so super inside a
+// block resolves against the method that created the block.
+func (e *Env) lookupCallMethod() *methodCall {
+	for scope := e; scope != nil; scope = scope.parent {
+		if scope.hasCallBlock {
+			return scope.callMethod
+		}
+	}
+	return nil
+}
+
 // Define binds a new variable in the current scope.
 func (e *Env) Define(name string, val Value) {
 	e.setDynamic(name, val)
@@ -349,6 +376,53 @@ func (e *Env) visibleNames() []string {
 	return names
 }
 
+// callLocalNames returns the names bound in this scope and its enclosing
+// scopes up to and including the nearest call frame, innermost first and with
+// shadowed names reported once. A first assignment to a new name binds it in
+// the call root, so the root's bindings are included too, except those named
+// in ambient: the globals, capabilities, classes, and functions the host call
+// set up before the script ran. Builtins in the frozen proto never appear.
+func (e *Env) callLocalNames(ambient map[string]struct{}) []string {
+	seen := make(map[string]struct{})
+	var names []string
+	add := func(name string, _ Value) {
+		if _, ok := seen[name]; ok {
+			return
+		}
+		seen[name] = struct{}{}
+		names = append(names, name)
+	}
+	inFrame := true
+	for scope := e; scope != nil && !scope.frozen; scope = scope.parent {
+		if scope.callRoot {
+			scope.rangeDynamicBindings(func(name string, val Value) {
+				if _, ok := ambient[name]; !ok {
+					add(name, val)
+				}
+			})
+			break
+		}
+		if inFrame {
+			scope.rangeDynamicBindings(add)
+			scope.rangeStaticBindings(add)
+			inFrame = !scope.hasCallBlock
+		}
+	}
+	return names
+}
+
+// bindingNameSet returns the names bound directly in this scope.
+func (e *Env) bindingNameSet() map[string]struct{} {
+	names := make(map[string]struct{}, e.dynamicLen()+len(e.statics))
+	e.rangeDynamicBindings(func(name string, _ Value) {
+		names[name] = struct{}{}
+	})
+	for name := range e.statics {
+		names[name] = struct{}{}
+	}
+	return names
+}
+
 // CloneShallow returns a copy of the environment with the same parent and a shallow copy of its bindings.
 func (e *Env) CloneShallow() *Env {
 	clone := newEnvWithCapacity(e.parent, e.dynamicLen())
@@ -362,6 +436,7 @@ func (e *Env) CloneShallow() *Env {
 	}
 	clone.callBlock = e.callBlock
 	clone.hasCallBlock = e.hasCallBlock
+	clone.callMethod = e.callMethod
 	return clone
 }
 
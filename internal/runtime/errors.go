@@ -26,6 +26,14 @@ type RuntimeError struct {
 	Message   string
 	CodeFrame string
 	Frames    []StackFrame
+
+	// spanned records that CodeFrame already underlines a full call, so an
+	// enclosing call that starts at the same position does not widen it.
+	spanned bool
+
+	// exception is the script error instance a raise of an Error subclass
+	// threw, so a rescue clause can match its class and bind it.
+	exception *Instance
 }
 
 type assertionFailureError struct {
@@ -109,6 +117,7 @@ const (
 var (
 	errLoopBreak           = errors.New("loop break")
 	errLoopNext            = errors.New("loop next")
+	errRetry               = errors.New("retry")
 	errStepQuotaExceeded   = errors.New("step quota exceeded")
 	errMemoryQuotaExceeded = errors.New("memory quota exceeded")
 	errOutputLimitExceeded = errors.New("output limit exceeded")
@@ -130,6 +139,20 @@ func newLoopBreakValue(value Value) error {
 	return &loopBreakError{value: value}
 }
 
+// retryError is the signal a retry statement raises; the begin block whose
+// rescue clause ran it restarts. pos locates the retry for the limit error.
+type retryError struct {
+	pos Position
+}
+
+func (e *retryError) Error() string {
+	return errRetry.Error()
+}
+
+func (e *retryError) Unwrap() error {
+	return errRetry
+}
+
 func loopBreakValue(err error) (Value, bool) {
 	var breakErr *loopBreakError
 	if errors.As(err, &breakErr) {
@@ -235,6 +258,9 @@ func (exec *Execution) step() error {
 	if exec.quota > 0 && exec.steps > exec.quota {
 		return fmt.Errorf("%w (%d)", errStepQuotaExceeded, exec.quota)
 	}
+	if exec.callOptions.Profile != nil {
+		exec.callOptions.Profile.record(exec.profileFunction())
+	}
 	onSlowPath := (exec.steps & stepSlowPathMask) == 0
 	if onSlowPath {
 		if exec.memoryQuota > 0 {
@@ -284,6 +310,19 @@ func (exec *Execution) checkStepBudgetFor(n int) error {
 	return exec.checkContext()
 }
 
+// checkCollectionSize rejects a builtin that is about to materialize n elements
+// when n exceeds Config.MaxCollectionSize. Builders call it once they know the
+// result length and before allocating the backing, so an oversized range or
+// split fails fast rather than growing until the memory quota trips. Hash
+// builders, which only learn their size as distinct keys arrive, call it as
+// each new key is added. A zero limit disables the check.
+func (exec *Execution) checkCollectionSize(method string, n int) error {
+	if exec.maxCollectionSize > 0 && n > exec.maxCollectionSize {
+		return guardLimitErrorf("%s collection size limit exceeded (%d elements)", method, exec.maxCollectionSize)
+	}
+	return nil
+}
+
 func (exec *Execution) errorAt(pos Position, format string, args ...any) error {
 	return exec.newRuntimeError(fmt.Sprintf(format, args...), pos)
 }
@@ -301,6 +340,12 @@ func (exec *Execution) localJumpErrorAt(pos Position, format string, args ...any
 }
 
 func (exec *Execution) newRuntimeErrorWithType(kind, message string, pos Position) error {
+	return exec.buildRuntimeError(kind, message, pos)
+}
+
+// buildRuntimeError is newRuntimeErrorWithType for callers that adjust the
+// error before returning it.
+func (exec *Execution) buildRuntimeError(kind, message string, pos Position) *RuntimeError {
 	if canonical, ok := ast.CanonicalRuntimeErrorType(kind); ok {
 		kind = canonical
 	} else {
@@ -324,16 +369,43 @@ func (exec *Execution) newRuntimeErrorWithType(kind, message string, pos Positio
 		frames = append(frames, StackFrame{Function: "<script>", Pos: pos, Source: stackFrameSource(exec.currentSourceScript())})
 	}
 	codeFrame := ""
-	sourceScript := exec.script
-	if len(exec.callStack) > 0 && exec.callStack[len(exec.callStack)-1].functionScript != nil {
-		sourceScript = exec.callStack[len(exec.callStack)-1].functionScript
-	}
-	if sourceScript != nil {
+	if sourceScript := exec.errorSourceScript(); sourceScript != nil {
 		codeFrame = source.FormatCodeFrame(sourceScript.source, pos)
 	}
 	return &RuntimeError{Type: kind, Message: message, CodeFrame: codeFrame, Frames: frames}
 }
 
+// errorSourceScript returns the script whose source a code frame for the
+// current function should render.
+func (exec *Execution) errorSourceScript() *Script {
+	if len(exec.callStack) > 0 && exec.callStack[len(exec.callStack)-1].functionScript != nil {
+		return exec.callStack[len(exec.callStack)-1].functionScript
+	}
+	return exec.script
+}
+
+// underlineCall widens the code frame of an error raised at call's own
+// position in the current function so it underlines the whole call, such as
+// `items.fetch(10)`, instead of only its first column. Errors raised deeper
+// in the stack or at another position keep their frame.
+func (exec *Execution) underlineCall(err error, call *CallExpr) error {
+	var runtimeErr *RuntimeError
+	if !errors.As(err, &runtimeErr) || runtimeErr.spanned {
+		return err
+	}
+	if len(runtimeErr.Frames) != len(exec.callStack)+1 || runtimeErr.Frames[0].Pos != call.Pos() {
+		return err
+	}
+	runtimeErr.spanned = true
+	if runtimeErr.CodeFrame == "" {
+		return err
+	}
+	if sourceScript := exec.errorSourceScript(); sourceScript != nil {
+		runtimeErr.CodeFrame = source.FormatCodeFrameSpan(sourceScript.source, call.Pos(), call.EndPos())
+	}
+	return err
+}
+
 func stackFrameSource(script *Script) string {
 	if script == nil {
 		return ""
@@ -93,6 +93,9 @@ func (exec *Execution) evalExpressionWithAuto(expr Expression, env *Env, autoCal
 		if e.Safe && obj.Kind() == KindNil {
 			return NewNil(), nil
 		}
+		if e == exec.discardedBang {
+			exec.warnDiscardedBang(e, obj)
+		}
 		if err := exec.checkMemoryWith(obj); err != nil {
 			return NewNil(), err
 		}
@@ -153,6 +156,8 @@ func (exec *Execution) evalExpressionWithAuto(expr Expression, env *Env, autoCal
 		return exec.evalBlockLiteral(e, env)
 	case *YieldExpr:
 		return exec.evalYield(e, env)
+	case *SuperExpr:
+		return exec.evalSuper(e, env)
 	default:
 		return NewNil(), exec.errorAt(expr.Pos(), "unsupported expression")
 	}
@@ -189,6 +194,9 @@ func (exec *Execution) buildInterpolatedString(parts []StringPart, env *Env) (st
 			if err != nil {
 				return "", err
 			}
+			if val, err = exec.instanceToS(val, p.Expr.Pos()); err != nil {
+				return "", err
+			}
 			if err := exec.appendInterpolatedValue(&sb, val); err != nil {
 				return "", err
 			}
@@ -418,9 +426,16 @@ func (exec *Execution) evalUnaryExpr(e *UnaryExpr, env *Env) (Value, error) {
 	case tokenMinus:
 		switch right.Kind() {
 		case KindInt:
-			return NewInt(-right.Int()), nil
+			negated, err := exec.negateInt(right.Int())
+			if err != nil {
+				return NewNil(), exec.wrapError(err, e.Pos())
+			}
+			return negated, nil
 		case KindFloat:
 			return NewFloat(-right.Float()), nil
+		case KindBigInt:
+			n := right.BigInt()
+			return NewBigInt(n.Neg(n)), nil
 		default:
 			return NewNil(), exec.errorAt(e.Pos(), "unsupported unary - operand")
 		}
@@ -434,6 +449,12 @@ func (exec *Execution) evalUnaryExpr(e *UnaryExpr, env *Env) (Value, error) {
 		default:
 			return NewNil(), exec.errorAt(e.Pos(), "unsupported unary + operand")
 		}
+	case tokenTilde:
+		result, err := complementValue(right)
+		if err != nil {
+			return NewNil(), exec.wrapError(err, e.Pos())
+		}
+		return result, nil
 	case tokenBang, tokenNot:
 		return NewBool(!right.Truthy()), nil
 	default:
@@ -526,7 +547,8 @@ func (exec *Execution) evalIndexSelectors(e *IndexExpr, obj Value, env *Env) ([]
 // and selectors. Arrays and strings support Ruby's single-index (with negative
 // indexing), start/length, and range forms; an out-of-range single index yields
 // nil rather than raising, matching Array#[] and String#[]. Hashes and objects
-// take exactly one key.
+// take exactly one key. Instances dispatch to their class's [] method with
+// every selector as an argument.
 func (exec *Execution) evalIndexValue(e *IndexExpr, obj Value, indices []Value) (Value, error) {
 	switch obj.Kind() {
 	case KindString:
@@ -535,6 +557,12 @@ func (exec *Execution) evalIndexValue(e *IndexExpr, obj Value, indices []Value)
 		return exec.indexArray(e, obj, indices)
 	case KindHash, KindObject:
 		return exec.indexHash(e, obj, indices)
+	case KindInstance:
+		result, ok, err := exec.callOperatorMethod(obj, "[]", indices, e.Pos())
+		if !ok {
+			return NewNil(), exec.errorAt(e.Object.Pos(), "cannot index %s instance: class does not define []", operandName(obj))
+		}
+		return result, err
 	default:
 		return NewNil(), exec.errorAt(e.Object.Pos(), "cannot index %s", obj.Kind())
 	}
@@ -739,6 +767,16 @@ func (exec *Execution) evalBinaryExpr(expr *BinaryExpr, env *Env) (Value, error)
 }
 
 func (exec *Execution) evalBinaryOperator(operator TokenType, left, right Value, pos Position) (Value, error) {
+	if left.Kind() == KindInstance {
+		if result, ok, err := exec.instanceOperator(operator, left, right, pos); ok {
+			return result, err
+		}
+	}
+	if (exec.intOverflow == IntOverflowWrap || exec.intOverflow == IntOverflowPromote) && left.Kind() == KindInt && right.Kind() == KindInt {
+		if result, handled := exec.evalIntArithmetic(operator, left.Int(), right.Int()); handled {
+			return result, nil
+		}
+	}
 	var result Value
 	var err error
 	switch operator {
@@ -747,7 +785,11 @@ func (exec *Execution) evalBinaryOperator(operator TokenType, left, right Value,
 	case tokenMinus:
 		result, err = subtractValues(left, right)
 	case tokenAsterisk:
-		result, err = multiplyValues(left, right)
+		if left.Kind() == KindString || left.Kind() == KindArray {
+			result, err = exec.repeatValues(left, right, pos)
+		} else {
+			result, err = multiplyValues(left, right)
+		}
 	case tokenPower:
 		result, err = powerValues(left, right)
 	case tokenSlash:
@@ -763,27 +805,44 @@ func (exec *Execution) evalBinaryOperator(operator TokenType, left, right Value,
 			result, err = moduloValues(left, right)
 		}
 	case tokenShovel:
-		result, err = shovelValues(left, right)
+		if isIntegerValue(left) || left.Kind() == KindFloat {
+			result, err = exec.shiftValues(operator, left, right)
+		} else {
+			result, err = shovelValues(left, right)
+		}
+	case tokenShiftR:
+		result, err = exec.shiftValues(operator, left, right)
 	case tokenAmpersand:
-		result, err = intersectValues(left, right)
+		if left.Kind() == KindArray || right.Kind() == KindArray {
+			result, err = intersectValues(left, right)
+		} else {
+			result, err = bitwiseValues(operator, left, right)
+		}
+	case tokenPipe, tokenCaret:
+		result, err = bitwiseValues(operator, left, right)
 	case tokenEQ:
 		return NewBool(left.Equal(right)), nil
 	case tokenCaseEQ:
 		// Ruby's case equality operator: the left operand acts as the matcher and
-		// the right operand is the value being tested. Ranges check membership;
-		// every other value falls back to `==`. This mirrors `when` clause
-		// matching, where the clause value is the matcher.
-		return NewBool(caseCandidateMatches(right, left)), nil
+		// the right operand is the value being tested. Ranges check membership,
+		// classes and enums check the value's type, and every other value falls
+		// back to `==`. This mirrors `when` clause matching, where the clause
+		// value is the matcher.
+		matches, err := caseCandidateMatches(right, left)
+		if err != nil {
+			return NewNil(), exec.wrapError(err, pos)
+		}
+		return NewBool(matches), nil
 	case tokenNotEQ:
 		return NewBool(!left.Equal(right)), nil
 	case tokenLT:
-		return compareValues(left, right, func(c int) bool { return c < 0 })
+		return exec.compareValues(left, right, func(c int) bool { return c < 0 })
 	case tokenLTE:
-		return compareValues(left, right, func(c int) bool { return c <= 0 })
+		return exec.compareValues(left, right, func(c int) bool { return c <= 0 })
 	case tokenGT:
-		return compareValues(left, right, func(c int) bool { return c > 0 })
+		return exec.compareValues(left, right, func(c int) bool { return c > 0 })
 	case tokenGTE:
-		return compareValues(left, right, func(c int) bool { return c >= 0 })
+		return exec.compareValues(left, right, func(c int) bool { return c >= 0 })
 	case tokenSpaceship:
 		order, ordered, err := compareValueOrder(left, right)
 		if err != nil {
@@ -811,6 +870,86 @@ func (exec *Execution) evalBinaryOperator(operator TokenType, left, right Value,
 	return result, nil
 }
 
+// instanceOperator dispatches a binary operator whose left operand is an
+// instance to the class's method of the same name (def +(other)), reporting
+// ok=false when the class defines none so the built-in semantics apply. As
+// with Ruby's Comparable, a class that defines only <=> also answers <, <=, >,
+// and >=, and != negates a user-defined ==.
+func (exec *Execution) instanceOperator(operator TokenType, left, right Value, pos Position) (Value, bool, error) {
+	args := []Value{right}
+	if result, ok, err := exec.callOperatorMethod(left, string(operator), args, pos); ok {
+		return result, true, err
+	}
+	switch operator {
+	case tokenNotEQ:
+		if result, ok, err := exec.callOperatorMethod(left, string(tokenEQ), args, pos); ok {
+			if err != nil {
+				return NewNil(), true, err
+			}
+			return NewBool(!result.Truthy()), true, nil
+		}
+	case tokenLT, tokenLTE, tokenGT, tokenGTE:
+		if result, ok, err := exec.callOperatorMethod(left, string(tokenSpaceship), args, pos); ok {
+			if err != nil {
+				return NewNil(), true, err
+			}
+			if result.Kind() != KindInt {
+				return NewNil(), true, exec.errorAt(pos, "comparison of %s with %s failed", operandName(left), operandName(right))
+			}
+			order := result.Int()
+			switch operator {
+			case tokenLT:
+				return NewBool(order < 0), true, nil
+			case tokenLTE:
+				return NewBool(order <= 0), true, nil
+			case tokenGT:
+				return NewBool(order > 0), true, nil
+			default:
+				return NewBool(order >= 0), true, nil
+			}
+		}
+	}
+	return NewNil(), false, nil
+}
+
+// callOperatorMethod calls the operator method name (+, [], []=) on an
+// instance receiver, reporting ok=false when its class does not define one.
+// Operator syntax is an external call, so a private operator method raises.
+func (exec *Execution) callOperatorMethod(receiver Value, name string, args []Value, pos Position) (Value, bool, error) {
+	fn, ok := valueInstance(receiver).Class.findMethod(name)
+	if !ok {
+		return NewNil(), false, nil
+	}
+	if fn.Private {
+		return NewNil(), true, exec.errorAt(pos, "private method %s", name)
+	}
+	result, err := exec.callFunction(fn, receiver, args, nil, NewNil(), pos)
+	return result, true, err
+}
+
+// valuesEqual reports whether item == target, dispatching to the == method of
+// an instance item the way the binary operator does, so array.include?,
+// index, rindex, count, and delete find user objects that define equality.
+// Any other item uses the built-in Value.Equal.
+func (exec *Execution) valuesEqual(item, target Value) (bool, error) {
+	if item.Kind() == KindInstance {
+		result, ok, err := exec.callOperatorMethod(item, string(tokenEQ), []Value{target}, Position{})
+		if ok {
+			return err == nil && result.Truthy(), err
+		}
+	}
+	return item.Equal(target), nil
+}
+
+// operandName names an operand's type in operator errors: the class name for
+// an instance, the kind otherwise.
+func operandName(val Value) string {
+	if val.Kind() == KindInstance {
+		return valueInstance(val).Class.Name
+	}
+	return val.Kind().String()
+}
+
 func (exec *Execution) evalConditionalExpr(expr *ConditionalExpr, env *Env) (Value, error) {
 	condition, err := exec.evalExpression(expr.Condition, env)
 	if err != nil {
@@ -1129,7 +1268,7 @@ func statementCapturesCurrentEnv(stmt Statement) bool {
 	case *ReturnStmt:
 		return expressionCapturesCurrentEnv(s.Value)
 	case *RaiseStmt:
-		return expressionCapturesCurrentEnv(s.Value)
+		return expressionCapturesCurrentEnv(s.Value) || expressionCapturesCurrentEnv(s.Message)
 	case *AssignStmt:
 		return expressionCapturesCurrentEnv(s.Target) || expressionCapturesCurrentEnv(s.Value)
 	case *ExprStmt:
@@ -1154,7 +1293,7 @@ func statementCapturesCurrentEnv(stmt Statement) bool {
 		return expressionCapturesCurrentEnv(s.Condition) || statementsCaptureCurrentEnv(s.Body)
 	case *BreakStmt:
 		return expressionCapturesCurrentEnv(s.Value)
-	case *NextStmt, *EnumStmt:
+	case *NextStmt, *RetryStmt, *ModuleStmt, *EnumStmt:
 		return false
 	case *TryStmt:
 		return statementsCaptureCurrentEnv(s.Body) ||
@@ -1339,23 +1478,76 @@ func (exec *Execution) evalYield(expr *YieldExpr, env *Env) (Value, error) {
 	return exec.CallBlock(block, args)
 }
 
+// evalSuper evaluates a bare super: it calls the superclass method with the
+// arguments and block the current method received.
+func (exec *Execution) evalSuper(expr *SuperExpr, env *Env) (Value, error) {
+	callee, self, call, err := exec.resolveSuper(expr, env)
+	if err != nil {
+		return NewNil(), err
+	}
+	block, ok := env.lookupCallBlock()
+	if !ok {
+		block = NewNil()
+	}
+	return exec.invokeCallable(callee, self, call.args, call.kwargs, block, expr.Pos())
+}
+
+// resolveSuper finds the method super refers to: the same-named method on the
+// superclass of the class that defines the running method, found through the
+// instance or class methods depending on self. It returns that method with
+// self as its receiver, along with the running call. An initialize without a
+// superclass initialize resolves to a no-op, as Ruby's Object#initialize.
+func (exec *Execution) resolveSuper(expr *SuperExpr, env *Env) (Value, Value, *methodCall, error) {
+	call := env.lookupCallMethod()
+	self, ok := env.Get("self")
+	if call == nil || !ok || (self.Kind() != KindInstance && self.Kind() != KindClass) {
+		return NewNil(), NewNil(), nil, exec.errorAt(expr.Pos(), "super called outside of a method")
+	}
+	name := call.fn.Name
+	classDef := valueClass(self)
+	if self.Kind() == KindInstance {
+		classDef = valueInstance(self).Class
+	}
+	for classDef != nil && classDef.Name != call.fn.className {
+		classDef = classDef.Superclass
+	}
+	var fn *ScriptFunction
+	if classDef != nil && classDef.Superclass != nil {
+		if self.Kind() == KindInstance {
+			fn, ok = classDef.Superclass.findMethod(name)
+		} else {
+			fn, ok = classDef.Superclass.findClassMethod(name)
+		}
+	}
+	if fn != nil {
+		return NewFunction(fn), self, call, nil
+	}
+	if self.Kind() == KindInstance && name == "initialize" {
+		noop := NewBuiltin("initialize", func(exec *Execution, receiver Value, args []Value, kwargs map[string]Value, block Value) (Value, error) {
+			return NewNil(), nil
+		})
+		return noop, self, call, nil
+	}
+	return NewNil(), NewNil(), nil, exec.errorAt(expr.Pos(), "super: no superclass method %s", name)
+}
+
 func (exec *Execution) assignToMember(obj Value, property string, value Value, pos Position) error {
 	setterName := property + "="
-	var methods map[string]*ScriptFunction
+	var findMethod func(string) (*ScriptFunction, bool)
 	var vars map[string]Value
 
 	switch obj.Kind() {
 	case KindInstance:
-		methods = valueInstance(obj).Class.Methods
+		findMethod = valueInstance(obj).Class.findMethod
 		vars = valueInstance(obj).Ivars
 	case KindClass:
-		methods = valueClass(obj).ClassMethods
+		findMethod = valueClass(obj).findClassMethod
 		vars = valueClass(obj).ClassVars
 	default:
 		return exec.errorAt(pos, "cannot assign to %s", obj.Kind())
 	}
 
-	if fn, ok := methods[setterName]; ok {
+	if fn, ok := findMethod(setterName); ok {
 		if fn.Private {
 			return exec.errorAt(pos, "private method %s", setterName)
 		}
@@ -1371,7 +1563,7 @@ func (exec *Execution) assignToMember(obj Value, property string, value Value, p
 		return err
 	}
 
-	if _, hasGetter := methods[property]; hasGetter {
+	if _, hasGetter := findMethod(property); hasGetter {
 		return exec.errorAt(pos, "cannot assign to read-only property %s", property)
 	}
 
@@ -1440,6 +1632,14 @@ func (exec *Execution) assign(target Expression, value Value, env *Env) error {
 func (exec *Execution) assignToEvaluatedMember(target *MemberExpr, obj, value Value) error {
 	switch obj.Kind() {
 	case KindHash, KindObject:
+		// `h.default = value` is Ruby's Hash#default=, not a record field: the
+		// reader `h.default` always resolves to the builtin, so a stored :default
+		// key would be unreachable by member access. Like Ruby, setting a default
+		// value clears any default proc.
+		if obj.Kind() == KindHash && target.Property == "default" {
+			obj.SetHashDefaults(value, NewNil())
+			return nil
+		}
 		key := NewString(target.Property)
 		if obj.Kind() == KindHash {
 			key = hashMemberAssignmentKey(obj, target.Property)
@@ -1456,7 +1656,8 @@ func (exec *Execution) assignToEvaluatedMember(target *MemberExpr, obj, value Va
 // accepts a single integer index, counting a negative index back from the end
 // (Ruby's arr[-1] = x); an index outside the array raises rather than
 // auto-extending. Hash and object assignment store under a single key. Slice
-// assignment (start/length or range targets) is not supported.
+// assignment (start/length or range targets) is not supported. Instances
+// dispatch to their class's []= method with the selectors and then the value.
 func (exec *Execution) assignToEvaluatedIndex(target *IndexExpr, obj Value, indices []Value, value Value) error {
 	switch obj.Kind() {
 	case KindArray:
@@ -1485,6 +1686,13 @@ func (exec *Execution) assignToEvaluatedIndex(target *IndexExpr, obj Value, indi
 			return exec.errorAt(target.IndexPos(0), "%s", err.Error())
 		}
 		return nil
+	case KindInstance:
+		args := append(append(make([]Value, 0, len(indices)+1), indices...), value)
+		_, ok, err := exec.callOperatorMethod(obj, "[]=", args, target.Pos())
+		if !ok {
+			return exec.errorAt(target.Object.Pos(), "cannot assign index on %s instance: class does not define []=", operandName(obj))
+		}
+		return err
 	default:
 		return exec.errorAt(target.Object.Pos(), "cannot index %s", obj.Kind())
 	}
@@ -1961,7 +2169,8 @@ func (exec *Execution) evalCaseExpr(expr *CaseExpr, env *Env) (Value, error) {
 
 func (exec *Execution) caseWhenValueMatches(hasTarget bool, target, candidate Value, splat bool, pos Position) (bool, error) {
 	if !splat {
-		return caseWhenMatches(hasTarget, target, candidate), nil
+		matches, err := caseWhenMatches(hasTarget, target, candidate)
+		return matches, exec.wrapError(err, pos)
 	}
 	if candidate.Kind() != KindArray {
 		return false, exec.errorAt(pos, "case when splat value must be an array")
@@ -1973,32 +2182,55 @@ func (exec *Execution) caseWhenValueMatches(hasTarget bool, target, candidate Va
 		if err := exec.checkMemoryWith(item); err != nil {
 			return false, err
 		}
-		if caseWhenMatches(hasTarget, target, item) {
-			return true, nil
+		matches, err := caseWhenMatches(hasTarget, target, item)
+		if err != nil || matches {
+			return matches, exec.wrapError(err, pos)
 		}
 	}
 	return false, nil
 }
 
-func caseWhenMatches(hasTarget bool, target, candidate Value) bool {
+func caseWhenMatches(hasTarget bool, target, candidate Value) (bool, error) {
 	if !hasTarget {
-		return candidate.Truthy()
+		return candidate.Truthy(), nil
 	}
 	return caseCandidateMatches(target, candidate)
 }
 
-func caseCandidateMatches(target, candidate Value) bool {
-	if candidate.Kind() != KindRange {
-		return target.Equal(candidate)
+// caseCandidateMatches reports whether target matches a when-clause candidate:
+// a range matches numbers it contains, a class matches its instances (is_a?
+// semantics), an enum matches its members, the Integer, Float, String, and
+// Array conversion builtins match values of their kind (bigints count as
+// Integer), and every other candidate matches by value equality. Any other
+// builtin is a function rather than a pattern, so matching against it is an
+// error instead of a silent false.
+func caseCandidateMatches(target, candidate Value) (bool, error) {
+	switch candidate.Kind() {
+	case KindRange:
+		return rangeCaseMatches(candidate, target), nil
+	case KindClass:
+		return target.Kind() == KindInstance && valueInstance(target).Class.inherits(valueClass(candidate)), nil
+	case KindEnum:
+		return target.Kind() == KindEnumValue && NewEnum(valueEnumValue(target).Enum).Equal(candidate), nil
+	case KindBuiltin:
+		builtin := valueBuiltin(candidate)
+		if builtin.matchesKind == nil {
+			return false, fmt.Errorf("cannot match against builtin %s", builtin.Name)
+		}
+		return builtin.matchesKind(target), nil
+	default:
+		return target.Equal(candidate), nil
 	}
+}
 
+func rangeCaseMatches(rng, target Value) bool {
 	switch target.Kind() {
 	case KindInt:
-		return rangeContainsInt(candidate.Range(), target.Int())
+		return rangeContainsInt(rng.Range(), target.Int())
 	case KindFloat:
-		return rangeContainsFloat(candidate.Range(), target.Float())
+		return rangeContainsFloat(rng.Range(), target.Float())
 	default:
-		return target.Equal(candidate)
+		return target.Equal(rng)
 	}
 }
 
@@ -2041,6 +2273,7 @@ func rangeContainsFloat(rng Range, value float64) bool {
 }
 
 func (exec *Execution) evalForStatement(stmt *ForStmt, env *Env) (Value, bool, error) {
+	discardBody := exec.takeDiscardBody()
 	exec.loopDepth++
 	defer func() {
 		exec.loopDepth--
@@ -2063,7 +2296,7 @@ func (exec *Execution) evalForStatement(stmt *ForStmt, env *Env) (Value, bool, e
 				return NewNil(), false, exec.wrapError(err, stmt.Pos())
 			}
 			env.Assign(stmt.Iterator, item)
-			val, returned, err := exec.evalStatements(stmt.Body, env)
+			val, returned, err := exec.evalBody(stmt.Body, env, discardBody)
 			if err != nil {
 				if errors.Is(err, errLoopBreak) {
 					if breakVal, ok := loopBreakValue(err); ok {
@@ -2082,7 +2315,7 @@ func (exec *Execution) evalForStatement(stmt *ForStmt, env *Env) (Value, bool, e
 			last = val
 		}
 	case KindHash:
-		val, returned, err := exec.evalForHash(stmt, env, iterable, last)
+		val, returned, err := exec.evalForHash(stmt, env, iterable, last, discardBody)
 		if err != nil {
 			return NewNil(), false, err
 		}
@@ -2098,7 +2331,7 @@ func (exec *Execution) evalForStatement(stmt *ForStmt, env *Env) (Value, bool, e
 					return NewNil(), false, exec.wrapError(err, stmt.Pos())
 				}
 				env.Assign(stmt.Iterator, NewInt(i))
-				val, returned, err := exec.evalStatements(stmt.Body, env)
+				val, returned, err := exec.evalBody(stmt.Body, env, discardBody)
 				if err != nil {
 					if errors.Is(err, errLoopBreak) {
 						if breakVal, ok := loopBreakValue(err); ok {
@@ -2122,7 +2355,7 @@ func (exec *Execution) evalForStatement(stmt *ForStmt, env *Env) (Value, bool, e
 					return NewNil(), false, exec.wrapError(err, stmt.Pos())
 				}
 				env.Assign(stmt.Iterator, NewInt(i))
-				val, returned, err := exec.evalStatements(stmt.Body, env)
+				val, returned, err := exec.evalBody(stmt.Body, env, discardBody)
 				if err != nil {
 					if errors.Is(err, errLoopBreak) {
 						if breakVal, ok := loopBreakValue(err); ok {
@@ -2169,7 +2402,7 @@ func (exec *Execution) evalForStatement(stmt *ForStmt, env *Env) (Value, bool, e
 // without reserving it for the whole body. If the iterable is Go-stack-only, the
 // largest pair stays reserved so body checks keep accounting for the transient they
 // cannot combine with the invisible receiver.
-func (exec *Execution) evalForHash(stmt *ForStmt, env *Env, iterable, last Value) (Value, bool, error) {
+func (exec *Execution) evalForHash(stmt *ForStmt, env *Env, iterable, last Value, discardBody bool) (Value, bool, error) {
 	if hashHasTypedEntries(iterable) {
 		count := iterable.HashLen()
 		reservePair := !exec.valueReachableFromLiveBase(iterable, NewNil())
@@ -2194,7 +2427,7 @@ func (exec *Execution) evalForHash(stmt *ForStmt, env *Env, iterable, last Value
 			}
 			pair := NewArray([]Value{entry.Key, entry.Value})
 			env.Assign(stmt.Iterator, pair)
-			val, returned, err := exec.evalStatements(stmt.Body, env)
+			val, returned, err := exec.evalBody(stmt.Body, env, discardBody)
 			if err != nil {
 				if errors.Is(err, errLoopBreak) {
 					if breakVal, ok := loopBreakValue(err); ok {
@@ -2244,7 +2477,7 @@ func (exec *Execution) evalForHash(stmt *ForStmt, env *Env, iterable, last Value
 		// expose.
 		pair := NewArray([]Value{NewSymbol(key), entries[key]})
 		env.Assign(stmt.Iterator, pair)
-		val, returned, err := exec.evalStatements(stmt.Body, env)
+		val, returned, err := exec.evalBody(stmt.Body, env, discardBody)
 		if err != nil {
 			if errors.Is(err, errLoopBreak) {
 				if breakVal, ok := loopBreakValue(err); ok {
@@ -2280,6 +2513,7 @@ func rangeLoopDescendingContinues(value int64, rng Range) bool {
 }
 
 func (exec *Execution) evalWhileStatement(stmt *WhileStmt, env *Env) (Value, bool, error) {
+	discardBody := exec.takeDiscardBody()
 	exec.loopDepth++
 	defer func() {
 		exec.loopDepth--
@@ -2300,7 +2534,7 @@ func (exec *Execution) evalWhileStatement(stmt *WhileStmt, env *Env) (Value, boo
 		if !condition.Truthy() {
 			return last, false, nil
 		}
-		val, returned, err := exec.evalStatements(stmt.Body, env)
+		val, returned, err := exec.evalBody(stmt.Body, env, discardBody)
 		if err != nil {
 			if errors.Is(err, errLoopBreak) {
 				if breakVal, ok := loopBreakValue(err); ok {
@@ -2321,6 +2555,7 @@ func (exec *Execution) evalWhileStatement(stmt *WhileStmt, env *Env) (Value, boo
 }
 
 func (exec *Execution) evalUntilStatement(stmt *UntilStmt, env *Env) (Value, bool, error) {
+	discardBody := exec.takeDiscardBody()
 	exec.loopDepth++
 	defer func() {
 		exec.loopDepth--
@@ -2341,7 +2576,7 @@ func (exec *Execution) evalUntilStatement(stmt *UntilStmt, env *Env) (Value, boo
 		if condition.Truthy() {
 			return last, false, nil
 		}
-		val, returned, err := exec.evalStatements(stmt.Body, env)
+		val, returned, err := exec.evalBody(stmt.Body, env, discardBody)
 		if err != nil {
 			if errors.Is(err, errLoopBreak) {
 				if breakVal, ok := loopBreakValue(err); ok {
@@ -2362,17 +2597,37 @@ func (exec *Execution) evalUntilStatement(stmt *UntilStmt, env *Env) (Value, boo
 }
 
 func (exec *Execution) evalStatements(stmts []Statement, env *Env) (Value, bool, error) {
+	return exec.evalBody(stmts, env, false)
+}
+
+// evalBody evaluates a statement list and returns the value of its final
+// statement. discardLast reports that the caller drops that value, as a loop
+// or if statement in a discarding position does, so the final statement is
+// checked for discarded bang calls like the others.
+func (exec *Execution) evalBody(stmts []Statement, env *Env, discardLast bool) (Value, bool, error) {
 	exec.pushEnv(env)
 	defer exec.popEnv()
 
 	result := NewNil()
 	var lastPos Position
-	for _, stmt := range stmts {
+	warnBang := exec.engine != nil && exec.engine.config.WarnDiscardedBang
+	coverage := exec.callOptions.Coverage
+	for i, stmt := range stmts {
 		lastPos = stmt.Pos()
 		if err := exec.step(); err != nil {
 			return NewNil(), false, exec.wrapError(err, stmt.Pos())
 		}
-		val, returned, err := exec.evalStatement(stmt, env)
+		if coverage != nil {
+			coverage.record(stackFrameSource(exec.currentSourceScript()), lastPos.Line)
+		}
+		var val Value
+		var returned bool
+		var err error
+		if warnBang && (i < len(stmts)-1 || discardLast) {
+			val, returned, err = exec.evalDiscardingStatement(stmt, env)
+		} else {
+			val, returned, err = exec.evalStatement(stmt, env)
+		}
 		if err != nil {
 			return NewNil(), false, err
 		}
@@ -2526,6 +2781,7 @@ func (exec *Execution) evalStatement(stmt Statement, env *Env) (Value, bool, err
 		}
 		return val, false, nil
 	case *IfStmt:
+		discardBody := exec.takeDiscardBody()
 		val, err := exec.evalExpression(s.Condition, env)
 		if err != nil {
 			return NewNil(), false, err
@@ -2534,7 +2790,7 @@ func (exec *Execution) evalStatement(stmt Statement, env *Env) (Value, bool, err
 			return NewNil(), false, err
 		}
 		if val.Truthy() {
-			return exec.evalStatements(s.Consequent, env)
+			return exec.evalBody(s.Consequent, env, discardBody)
 		}
 		for _, clause := range s.ElseIf {
 			condVal, err := exec.evalExpression(clause.Condition, env)
@@ -2545,11 +2801,11 @@ func (exec *Execution) evalStatement(stmt Statement, env *Env) (Value, bool, err
 				return NewNil(), false, err
 			}
 			if condVal.Truthy() {
-				return exec.evalStatements(clause.Consequent, env)
+				return exec.evalBody(clause.Consequent, env, discardBody)
 			}
 		}
 		if len(s.Alternate) > 0 {
-			return exec.evalStatements(s.Alternate, env)
+			return exec.evalBody(s.Alternate, env, discardBody)
 		}
 		return NewNil(), false, nil
 	case *ForStmt:
@@ -2578,6 +2834,8 @@ func (exec *Execution) evalStatement(stmt Statement, env *Env) (Value, bool, err
 			return NewNil(), false, exec.errorAt(s.Pos(), "next used outside of loop")
 		}
 		return NewNil(), false, errLoopNext
+	case *RetryStmt:
+		return NewNil(), false, &retryError{pos: s.Pos()}
 	case *TryStmt:
 		return exec.evalTryStatement(s, env)
 	case *ClassStmt:
@@ -2604,7 +2862,16 @@ func (exec *Execution) evalRaiseStatement(stmt *RaiseStmt, env *Env) (Value, boo
 		if err != nil {
 			return NewNil(), false, err
 		}
-		if val.Kind() != KindString {
+		switch {
+		case val.Kind() == KindClass && valueClass(val).isErrorClass():
+			return NewNil(), false, exec.raiseErrorClass(val, stmt, env)
+		case val.Kind() == KindInstance && valueInstance(val).Class.isErrorClass():
+			if stmt.Message != nil {
+				return NewNil(), false, exec.errorAt(stmt.Message.Pos(), "raise with an exception object does not take a message")
+			}
+			return NewNil(), false, exec.raiseException(valueInstance(val), stmt.Pos())
+		}
+		if val.Kind() != KindString || stmt.Message != nil {
 			message := "exception class/object expected"
 			if val.Kind() == KindNil {
 				message = "exception object expected"
@@ -2621,11 +2888,14 @@ func (exec *Execution) evalRaiseStatement(stmt *RaiseStmt, env *Env) (Value, boo
 	return NewNil(), false, err
 }
 
+// evalTryStatement runs a begin block. A retry in its rescue clause runs the
+// body again, at most Config.MaxRetries times per execution of the block;
+// ensure runs once, after the final attempt.
 func (exec *Execution) evalTryStatement(stmt *TryStmt, env *Env) (Value, bool, error) {
 	val, returned, err := exec.evalStatements(stmt.Body, env)
 	runElse := err == nil && !returned
 
-	if err != nil && !isLoopControlSignal(err) && !isHostControlSignal(err) && len(stmt.Rescue) > 0 && runtimeErrorMatchesRescueType(err, stmt.RescueTy) {
+	for retries := 0; rescueHandles(stmt, err); {
 		rescueEnv := env
 		if stmt.RescueBinding != "" {
 			rescueEnv = newEnv(env)
@@ -2634,6 +2904,18 @@ func (exec *Execution) evalTryStatement(stmt *TryStmt, env *Env) (Value, bool, e
 		exec.pushRescuedError(err)
 		rescueVal, rescueReturned, rescueErr := exec.evalStatements(stmt.Rescue, rescueEnv)
 		exec.popRescuedError()
+		var retry *retryError
+		if errors.As(rescueErr, &retry) {
+			retries++
+			if retries > exec.engine.config.MaxRetries {
+				val, returned = NewNil(), false
+				err = exec.wrapError(guardLimitErrorf("retry limit exceeded (%d retries)", exec.engine.config.MaxRetries), retry.pos)
+				break
+			}
+			val, returned, err = exec.evalStatements(stmt.Body, env)
+			runElse = err == nil && !returned
+			continue
+		}
 		if rescueErr != nil {
 			val = NewNil()
 			returned = false
@@ -2643,6 +2925,7 @@ func (exec *Execution) evalTryStatement(stmt *TryStmt, env *Env) (Value, bool, e
 			returned = rescueReturned
 			err = nil
 		}
+		break
 	}
 
 	if runElse && len(stmt.Else) > 0 {
@@ -2665,7 +2948,19 @@ func (exec *Execution) evalTryStatement(stmt *TryStmt, env *Env) (Value, bool, e
 	return val, returned, nil
 }
 
+// rescueHandles reports whether stmt's rescue clause handles err. Loop, retry,
+// and host control signals pass through every rescue.
+func rescueHandles(stmt *TryStmt, err error) bool {
+	return err != nil && !isLoopControlSignal(err) && !errors.Is(err, errRetry) && !isHostControlSignal(err) &&
+		len(stmt.Rescue) > 0 && runtimeErrorMatchesRescueType(err, stmt.RescueTy)
+}
+
+// rescuedErrorValue is the value a rescue clause binds: the raised instance
+// for a script error class, otherwise an object describing the runtime error.
 func rescuedErrorValue(err error) Value {
+	if exception := raisedException(err); exception != nil {
+		return NewInstance(exception)
+	}
 	errType := classifyRuntimeErrorType(err)
 	message := err.Error()
 	codeFrame := ""
@@ -2735,16 +3030,19 @@ func runtimeErrorMatchesRescueType(err error, rescueTy *TypeExpr) bool {
 		return errors.As(err, &runtimeErr) && classifyRuntimeErrorType(runtimeErr) != runtimeErrorTypeLimit
 	}
 	errKind := classifyRuntimeErrorType(err)
-	return rescueTypeMatchesErrorKind(rescueTy, errKind)
+	return rescueTypeMatchesErrorKind(rescueTy, errKind, raisedException(err))
 }
 
-func rescueTypeMatchesErrorKind(ty *TypeExpr, errKind string) bool {
+// rescueTypeMatchesErrorKind reports whether a rescue type catches an error of
+// errKind. A type naming a script class catches only a raised instance of that
+// class or one of its subclasses.
+func rescueTypeMatchesErrorKind(ty *TypeExpr, errKind string, exception *Instance) bool {
 	if ty == nil {
 		return false
 	}
 	if ty.Kind == TypeUnion {
 		for _, option := range ty.Union {
-			if rescueTypeMatchesErrorKind(option, errKind) {
+			if rescueTypeMatchesErrorKind(option, errKind, exception) {
 				return true
 			}
 		}
@@ -2752,7 +3050,7 @@ func rescueTypeMatchesErrorKind(ty *TypeExpr, errKind string) bool {
 	}
 	canonical, ok := ast.CanonicalRuntimeErrorType(ty.Name)
 	if !ok {
-		return false
+		return exception != nil && exception.Class.inheritsNamed(ty.Name)
 	}
 	if canonical == runtimeErrorTypeBase {
 		return true
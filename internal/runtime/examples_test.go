@@ -385,6 +385,28 @@ func TestExamples(t *testing.T) {
 			},
 			want: intVal(10),
 		},
+		{
+			name:     "arrays/weighted_score",
+			file:     "arrays/extras.vibe",
+			function: "weighted_score",
+			args: []Value{
+				arrayVal(
+					hashVal(map[string]Value{"score": intVal(10), "weight": intVal(3)}),
+					hashVal(map[string]Value{"score": intVal(4), "weight": intVal(2)}),
+				),
+			},
+			want: intVal(38),
+		},
+		{
+			name:     "arrays/rolling_sums",
+			file:     "arrays/extras.vibe",
+			function: "rolling_sums",
+			args: []Value{
+				arrayVal(intVal(1), intVal(2), intVal(3), intVal(4), intVal(5)),
+				intVal(3),
+			},
+			want: arrayVal(intVal(6), intVal(9), intVal(12)),
+		},
 		{
 			name:     "arrays/push_and_pop",
 			file:     "arrays/extras.vibe",
@@ -456,6 +478,47 @@ func TestExamples(t *testing.T) {
 			},
 			want: arrayVal(intVal(1), intVal(3)),
 		},
+		{
+			name:     "arrays/concat_named",
+			file:     "arrays/extras.vibe",
+			function: "concat_named",
+			args: []Value{
+				arrayVal(intVal(1), intVal(2)),
+				arrayVal(intVal(3), intVal(4)),
+				arrayVal(intVal(2)),
+			},
+			want: arrayVal(intVal(1), intVal(2), intVal(3), intVal(4), intVal(2)),
+		},
+		{
+			name:     "arrays/union_named",
+			file:     "arrays/extras.vibe",
+			function: "union_named",
+			args: []Value{
+				arrayVal(intVal(1), intVal(2), intVal(2)),
+				arrayVal(intVal(2), intVal(3)),
+			},
+			want: arrayVal(intVal(1), intVal(2), intVal(3)),
+		},
+		{
+			name:     "arrays/intersection_named",
+			file:     "arrays/extras.vibe",
+			function: "intersection_named",
+			args: []Value{
+				arrayVal(intVal(1), intVal(1), intVal(2), intVal(3)),
+				arrayVal(intVal(1), intVal(3), intVal(4)),
+			},
+			want: arrayVal(intVal(1), intVal(3)),
+		},
+		{
+			name:     "arrays/difference_named",
+			file:     "arrays/extras.vibe",
+			function: "difference_named",
+			args: []Value{
+				arrayVal(intVal(1), intVal(2), intVal(3), intVal(2)),
+				arrayVal(intVal(2)),
+			},
+			want: arrayVal(intVal(1), intVal(3)),
+		},
 		{
 			name:     "arrays/include_value_true",
 			file:     "arrays/extras.vibe",
@@ -869,6 +932,13 @@ func TestExamples(t *testing.T) {
 			args:     []Value{intVal(1)},
 			want:     intVal(1),
 		},
+		{
+			name:     "recursion/fibonacci_memo_sixty",
+			file:     "control_flow/recursion.vibe",
+			function: "fibonacci_memo",
+			args:     []Value{intVal(60)},
+			want:     intVal(1548008755920),
+		},
 		{
 			name:     "loops/sum_range",
 			file:     "loops/iteration.vibe",
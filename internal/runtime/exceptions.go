@@ -0,0 +1,148 @@
+package runtime
+
+import "errors"
+
+// errorClassName names the built-in exception base class. A script class that
+// inherits from it, directly or through another error class, can be raised
+// with `raise NotFound, "message"` and rescued by name with `rescue NotFound`.
+const errorClassName = "Error"
+
+// newErrorBaseClass builds the Error class a script receives when one of its
+// classes inherits from Error without declaring it. It behaves like
+//
+//	class Error
+//	  getter message, type, code_frame, backtrace
+//
+//	  def initialize(message = nil)
+//	    @message = message
+//	  end
+//
+//	  def to_s
+//	    self.message
+//	  end
+//	end
+//
+// so a subclass with its own initialize can pass its message on with super.
+// raiseException fills in @type, @code_frame, and @backtrace, giving a
+// rescued instance the same members as the object a rescue binds for a
+// built-in runtime error.
+func newErrorBaseClass() *ClassDef {
+	return &ClassDef{
+		Name: errorClassName,
+		Methods: map[string]*ScriptFunction{
+			"message":    errorIvarGetter("message"),
+			"type":       errorIvarGetter("type"),
+			"code_frame": errorIvarGetter("code_frame"),
+			"backtrace":  errorIvarGetter("backtrace"),
+			"to_s": {
+				Name:      "to_s",
+				Body:      []Statement{&ReturnStmt{Value: &MemberExpr{Object: &Identifier{Name: "self"}, Property: "message"}}},
+				className: errorClassName,
+			},
+			"initialize": {
+				Name:   "initialize",
+				Params: []Param{{Name: "message", DefaultVal: &NilLiteral{}}},
+				Body: []Statement{&AssignStmt{
+					Target: &IvarExpr{Name: "message"},
+					Value:  &Identifier{Name: "message"},
+				}},
+				className: errorClassName,
+			},
+		},
+		ClassMethods: make(map[string]*ScriptFunction),
+		ClassVars:    make(map[string]Value),
+	}
+}
+
+func errorIvarGetter(name string) *ScriptFunction {
+	return &ScriptFunction{
+		Name:      name,
+		Body:      []Statement{&ReturnStmt{Value: &IvarExpr{Name: name}}},
+		className: errorClassName,
+	}
+}
+
+// isErrorClass reports whether instances of c can be raised: c is, or
+// inherits from, a root class named Error.
+func (c *ClassDef) isErrorClass() bool {
+	for cl := c; cl != nil; cl = cl.Superclass {
+		if cl.Superclass == nil {
+			return cl.Name == errorClassName
+		}
+	}
+	return false
+}
+
+// inheritsNamed reports whether c is the class called name or one of its
+// subclasses. Rescue clauses match by name because each call works on its own
+// clones of the script's classes.
+func (c *ClassDef) inheritsNamed(name string) bool {
+	for cl := c; cl != nil; cl = cl.Superclass {
+		if cl.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// raiseErrorClass instantiates the error class classVal, passing the message
+// operand of `raise ErrorClass, message` to its constructor, and raises the
+// new instance.
+func (exec *Execution) raiseErrorClass(classVal Value, stmt *RaiseStmt, env *Env) error {
+	var args []Value
+	if stmt.Message != nil {
+		message, err := exec.evalExpression(stmt.Message, env)
+		if err != nil {
+			return err
+		}
+		args = []Value{message}
+	}
+	constructor, err := exec.getMember(classVal, "new", stmt.Pos())
+	if err != nil {
+		return err
+	}
+	exception, err := exec.invokeCallable(constructor, classVal, args, nil, NewNil(), stmt.Pos())
+	if err != nil {
+		return err
+	}
+	return exec.raiseException(valueInstance(exception), stmt.Pos())
+}
+
+// raiseException builds the runtime error for a raised error instance. The
+// error's Type is the instance's class name and its message is what the
+// instance's message method returns, so a subclass that overrides message
+// reports the same text to the host as to a rescue body. An instance raised
+// without a message takes its class name, as in Ruby. The instance records
+// its type, code frame, and backtrace for the Error getters.
+func (exec *Execution) raiseException(exception *Instance, pos Position) error {
+	if val, ok := exception.Ivars["message"]; !ok || val.IsNil() {
+		exception.Ivars["message"] = NewString(exception.Class.Name)
+	}
+	message := exception.Ivars["message"].String()
+	if fn, ok := exception.Class.findMethod("message"); ok {
+		val, err := exec.callFunction(fn, NewInstance(exception), nil, nil, NewNil(), pos)
+		if err != nil {
+			return err
+		}
+		if !val.IsNil() {
+			message = val.String()
+		}
+	}
+	runtimeErr := exec.buildRuntimeError(runtimeErrorTypeBase, message, pos)
+	runtimeErr.Type = exception.Class.Name
+	runtimeErr.exception = exception
+	exception.Ivars["type"] = NewString(exception.Class.Name)
+	exception.Ivars["code_frame"] = NewString(runtimeErr.CodeFrame)
+	exception.Ivars["backtrace"] = NewArray(runtimeErrorBacktrace(runtimeErr))
+	return runtimeErr
+}
+
+// raisedException returns the error instance err carries, or nil when err was
+// not raised from a script error class.
+func raisedException(err error) *Instance {
+	var runtimeErr *RuntimeError
+	if errors.As(err, &runtimeErr) {
+		return runtimeErr.exception
+	}
+	return nil
+}
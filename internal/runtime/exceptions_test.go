@@ -0,0 +1,186 @@
+package runtime
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestCustomExceptionClassesRescueByHierarchy(t *testing.T) {
+	t.Parallel()
+
+	script := compileScript(t, `
+class NotFound < Error
+end
+
+class MissingUser < NotFound
+  getter id
+
+  def initialize(id)
+    super("user #{id} not found")
+    @id = id
+  end
+end
+
+class Conflict < Error
+end
+
+def lookup(kind)
+  if kind == :user
+    raise MissingUser.new(7)
+  elsif kind == :page
+    raise NotFound, "no page"
+  elsif kind == :bare
+    raise NotFound
+  end
+  raise Conflict, "busy"
+end
+
+def find(kind)
+  begin
+    lookup(kind)
+  rescue NotFound => e
+    [e.instance_of?(NotFound), e.message, e.is_a?(NotFound), e.backtrace.first.include?("lookup")]
+  end
+end
+
+def user_id
+  begin
+    lookup(:user)
+  rescue MissingUser => e
+    e.id
+  end
+end
+
+def either
+  begin
+    lookup(:conflict)
+  rescue NotFound | Conflict => e
+    e.message
+  end
+end
+
+def catch_all
+  begin
+    lookup(:page)
+  rescue Error => e
+    e.message
+  end
+end
+`)
+
+	compareArrays(t, callFunc(t, script, "find", []Value{NewSymbol("user")}), []Value{
+		NewBool(false), NewString("user 7 not found"), NewBool(true), NewBool(true),
+	})
+	compareArrays(t, callFunc(t, script, "find", []Value{NewSymbol("page")}), []Value{
+		NewBool(true), NewString("no page"), NewBool(true), NewBool(true),
+	})
+	compareArrays(t, callFunc(t, script, "find", []Value{NewSymbol("bare")}), []Value{
+		NewBool(true), NewString("NotFound"), NewBool(true), NewBool(true),
+	})
+	if got := callFunc(t, script, "user_id", nil); !got.Equal(NewInt(7)) {
+		t.Fatalf("user_id = %v, want 7", got)
+	}
+	if got := callFunc(t, script, "either", nil); !got.Equal(NewString("busy")) {
+		t.Fatalf("either = %v, want busy", got)
+	}
+	if got := callFunc(t, script, "catch_all", nil); !got.Equal(NewString("no page")) {
+		t.Fatalf("catch_all = %v, want no page", got)
+	}
+
+	_, err := script.Call(t.Context(), "find", []Value{NewSymbol("conflict")}, CallOptions{})
+	var runtimeErr *RuntimeError
+	if !errors.As(err, &runtimeErr) {
+		t.Fatalf("find(:conflict) error = %v, want RuntimeError", err)
+	}
+	if runtimeErr.Type != "Conflict" || runtimeErr.Message != "busy" {
+		t.Fatalf("propagated error = %s %q, want Conflict \"busy\"", runtimeErr.Type, runtimeErr.Message)
+	}
+}
+
+func TestCustomExceptionStructuredMembers(t *testing.T) {
+	t.Parallel()
+
+	script := compileScript(t, `
+class Timeout < Error
+  def message
+    "timed out after #{@message}s"
+  end
+end
+
+def wait
+  raise Timeout, "5"
+end
+
+def generic
+  begin
+    wait
+  rescue => e
+    [e.type, e.message, e.to_s, "#{e}", e.code_frame.include?("raise Timeout"), e.backtrace.first.include?("wait")]
+  end
+end
+
+def builtin
+  begin
+    1 / 0
+  rescue => e
+    [e.type, e.code_frame.include?("1 / 0")]
+  end
+end
+`)
+
+	compareArrays(t, callFunc(t, script, "generic", nil), []Value{
+		NewString("Timeout"),
+		NewString("timed out after 5s"),
+		NewString("timed out after 5s"),
+		NewString("timed out after 5s"),
+		NewBool(true),
+		NewBool(true),
+	})
+	compareArrays(t, callFunc(t, script, "builtin", nil), []Value{NewString("ZeroDivisionError"), NewBool(true)})
+
+	_, err := script.Call(t.Context(), "wait", nil, CallOptions{})
+	var runtimeErr *RuntimeError
+	if !errors.As(err, &runtimeErr) {
+		t.Fatalf("wait error = %v, want RuntimeError", err)
+	}
+	if runtimeErr.Type != "Timeout" || runtimeErr.Message != "timed out after 5s" {
+		t.Fatalf("propagated error = %s %q, want Timeout \"timed out after 5s\"", runtimeErr.Type, runtimeErr.Message)
+	}
+}
+
+func TestCustomExceptionErrors(t *testing.T) {
+	t.Parallel()
+
+	script := compileScript(t, `
+class Plain
+end
+
+class Failure < Error
+end
+
+def raise_plain_class
+  raise Plain, "nope"
+end
+
+def raise_object_with_message
+  raise Failure.new("a"), "b"
+end
+
+def raise_string_with_message
+  raise "a", "b"
+end
+`)
+	requireCallErrorContains(t, script, "raise_plain_class", nil, CallOptions{}, "exception class/object expected")
+	requireCallErrorContains(t, script, "raise_object_with_message", nil, CallOptions{}, "raise with an exception object does not take a message")
+	requireCallErrorContains(t, script, "raise_string_with_message", nil, CallOptions{}, "exception class/object expected")
+
+	requireCompileErrorContainsDefault(t, `
+def run
+  begin
+    1
+  rescue Missing => e
+    e
+  end
+end
+`, "unknown rescue error type Missing")
+}
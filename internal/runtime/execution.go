@@ -4,6 +4,11 @@ import (
 	"context"
 	"fmt"
 	"math/rand"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/mgomes/vibescript/vibes/value"
 )
 
 // ScriptFunction represents a user-defined function within a Vibescript module.
@@ -17,6 +22,9 @@ type ScriptFunction struct {
 	Exported bool
 	Private  bool
 	owner    *Script
+	// className names the class that defines this method, which super
+	// resolves against; it is empty for top-level functions.
+	className string
 }
 
 // Script represents a parsed Vibescript module ready for execution.
@@ -31,6 +39,9 @@ type Script struct {
 	moduleKey           string
 	modulePath          string
 	moduleRoot          string
+	// moduleName is moduleDisplayName(moduleKey), computed once when the
+	// module loads so stack frames do not rebuild it on every call.
+	moduleName string
 }
 
 // CallOptions configures globals, capabilities, and other settings for a script invocation.
@@ -38,7 +49,100 @@ type CallOptions struct {
 	Globals      map[string]Value
 	Capabilities []CapabilityAdapter
 	AllowRequire bool
+	// AllowEffects permits capability methods whose contracts are marked
+	// Mutating to run under Config.StrictEffects.
+	AllowEffects bool
 	Keywords     map[string]Value
+	// CapabilityObserver, when set, is called once per contracted capability
+	// method invocation, after the contract accepts the arguments and before
+	// the host method runs. method is the contract name (for example
+	// "db.find"). args aliases the call's arguments and must not be retained
+	// or mutated. Stdlib builtins and capability methods without a contract
+	// are not reported.
+	CapabilityObserver func(method string, args []Value)
+	// CapabilityBudgets caps how many times each contracted capability
+	// method, keyed by contract name (for example "db.query"), may run
+	// during one call, including calls made from tasks the script spawns.
+	// A call past the cap fails with a limit error. A missing or zero entry
+	// means unlimited.
+	CapabilityBudgets map[string]int
+	// DryRun, when set, records each call to a contracted capability method
+	// marked Mutating into the log instead of invoking it, and the call
+	// returns nil to the script. Read-only capability methods still run.
+	DryRun *EffectLog
+	// Record captures the call's random seed, clock, and capability results
+	// into a CallRecording, and Replay reruns a call from one, returning the
+	// recorded capability results instead of invoking the host. At most one
+	// may be set.
+	Record *CallRecording
+	Replay *CallRecording
+	// Clock, when set, supplies the current time for `now`, `Time.now`,
+	// uuid timestamps, and duration helpers such as `5.minutes.from_now`
+	// that default to the current time. Nil means time.Now. Record and
+	// Replay freeze the clock at the recording's time instead.
+	Clock func() time.Time
+	// Cache, when set, stores memoize results so they persist across calls
+	// that share it. Nil keeps memoize's cache per call.
+	Cache Cache
+	// Coverage, when set, records the source lines of the statements the
+	// call runs, including statements in required modules and tasks.
+	Coverage *Coverage
+	// Profile, when set, counts the steps each function runs, including
+	// steps in required modules and tasks.
+	Profile *Profile
+
+	capabilityBudget *capabilityBudget
+	replayCursor     *replayCursor
+}
+
+// capabilityBudget counts contracted capability invocations against
+// CallOptions.CapabilityBudgets. One budget is shared by a top-level call
+// and its tasks, which run on other goroutines.
+type capabilityBudget struct {
+	mu    sync.Mutex
+	spent map[string]int
+}
+
+// spend records one invocation of method and reports the error to raise
+// when it exceeds limit.
+func (b *capabilityBudget) spend(method string, limit int) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.spent[method] >= limit {
+		return guardLimitErrorf("capability budget exceeded for %s (limit %d)", method, limit)
+	}
+	b.spent[method]++
+	return nil
+}
+
+func (exec *Execution) spendCapabilityBudget(method string) error {
+	limit := exec.callOptions.CapabilityBudgets[method]
+	if limit <= 0 || exec.callOptions.capabilityBudget == nil {
+		return nil
+	}
+	return exec.callOptions.capabilityBudget.spend(method, limit)
+}
+
+// NewGlobalsFromGo converts a map of plain Go data into CallOptions.Globals
+// using value.FromGo, so hosts can pass configuration built from Go maps and
+// slices without wrapping every field by hand. An entry FromGo cannot convert
+// fails the whole map, and the error names the offending global.
+func NewGlobalsFromGo(globals map[string]any) (map[string]Value, error) {
+	names := make([]string, 0, len(globals))
+	for name := range globals {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	out := make(map[string]Value, len(globals))
+	for _, name := range names {
+		converted, err := value.FromGo(globals[name])
+		if err != nil {
+			return nil, fmt.Errorf("global %s: %w", name, err)
+		}
+		out[name] = converted
+	}
+	return out, nil
 }
 
 // Execution holds the runtime state for a single script evaluation.
@@ -59,6 +163,8 @@ type Execution struct {
 	capabilityContracts       map[*Builtin]CapabilityMethodContract
 	capabilityContractScopes  map[*Builtin]*capabilityContractScope
 	capabilityContractsByName map[string]CapabilityMethodContract
+	capabilityNames           []string
+	capabilityMethods         []CapabilityMethod
 	receiverStack             []Value
 	envStack                  []*Env
 	activeTaskGroups          []*taskGroup
@@ -75,12 +181,28 @@ type Execution struct {
 	validatedCapabilityArgsArr [4]string
 	loopDepth                  int
 	rescuedErrors              []error
+	memoCache                  map[HashLookupKey]memoEntry
 	randSource                 *rand.Rand
 	randSeed                   int64
 	randSeeded                 bool
 	strictEffects              bool
+	intOverflow                IntOverflowPolicy
+	maxCollectionSize          int
 	allowRequire               bool
 	callOptions                CallOptions
+
+	// ambientNames records the call root's bindings from before the entry
+	// function ran, so locals can tell host-provided names from variables
+	// the script assigned. It is only captured under
+	// Config.AllowIntrospection.
+	ambientNames map[string]struct{}
+
+	// discardedBang is the bang member call of the statement being evaluated
+	// when Config.WarnDiscardedBang is set and that statement drops its value.
+	discardedBang *MemberExpr
+	// discardBody marks a loop or if statement whose value is dropped, so
+	// its body's final statement is checked for discarded bang calls too.
+	discardBody bool
 }
 
 type capabilityContractScope struct {
@@ -133,6 +255,16 @@ func (exec *Execution) isCurrentReceiver(v Value) bool {
 	}
 }
 
+// frameName labels fn in stack frames. Functions defined in a required module
+// carry the module's display name, as in helpers.triple, so frames from
+// different modules stay distinguishable.
+func (fn *ScriptFunction) frameName() string {
+	if fn.owner == nil || fn.owner.moduleName == "" {
+		return fn.Name
+	}
+	return fn.owner.moduleName + "." + fn.Name
+}
+
 func (exec *Execution) pushFrame(function string, pos Position, callSiteScript, functionScript *Script) error {
 	if exec.recursionCap > 0 && len(exec.callStack) >= exec.recursionCap {
 		return exec.newRuntimeErrorWithType(runtimeErrorTypeLimit, fmt.Sprintf("recursion depth exceeded (limit %d)", exec.recursionCap), pos)
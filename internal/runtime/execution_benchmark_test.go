@@ -3,6 +3,7 @@ package runtime
 import (
 	"context"
 	"fmt"
+	"strings"
 	"testing"
 )
 
@@ -383,3 +384,41 @@ end`)
 		}
 	}
 }
+
+func manySmallFunctionsSource(count int) string {
+	var b strings.Builder
+	for i := 1; i <= count; i++ {
+		fmt.Fprintf(&b, "def f%03d(n)\n  n + %d\nend\n\n", i, i)
+	}
+	return b.String()
+}
+
+func BenchmarkScriptCallManySmallFunctions(b *testing.B) {
+	script := compileScriptWithEngine(b, benchmarkEngine(), manySmallFunctionsSource(250))
+	args := []Value{NewInt(1)}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := range b.N {
+		name := fmt.Sprintf("f%03d", i%250+1)
+		if _, err := script.Call(context.Background(), name, args, CallOptions{}); err != nil {
+			b.Fatalf("call failed: %v", err)
+		}
+	}
+}
+
+func BenchmarkSessionCallManySmallFunctions(b *testing.B) {
+	script := compileScriptWithEngine(b, benchmarkEngine(), manySmallFunctionsSource(250))
+	session, err := script.NewSession(context.Background(), CallOptions{})
+	if err != nil {
+		b.Fatalf("NewSession failed: %v", err)
+	}
+	args := []Value{NewInt(1)}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := range b.N {
+		name := fmt.Sprintf("f%03d", i%250+1)
+		if _, err := session.Call(context.Background(), name, args, nil); err != nil {
+			b.Fatalf("call failed: %v", err)
+		}
+	}
+}
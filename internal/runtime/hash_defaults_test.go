@@ -19,6 +19,29 @@ def value_default_reader()
   { default: h.default, with_key: h.default(:any) }
 end
 
+def default_zero_counter(words)
+  counts = Hash.new(0)
+  words.each do |word|
+    counts[word] += 1
+  end
+  { counts: counts, unseen: counts["zebra"], size: counts.size }
+end
+
+def default_setter_counter(words)
+  counts = {}
+  counts.default = 0
+  words.each do |word|
+    counts[word] += 1
+  end
+  counts
+end
+
+def default_setter_replaces_proc()
+  h = Hash.new { |hash, key| "computed" }
+  h.default = 5
+  { missed: h[:missing], default: h.default, has_proc: h.default_proc != nil, stored: h.key?(:default) }
+end
+
 def bare_new_default()
   h = Hash.new
   { default: h.default, missed: h[:a], size: h.size }
@@ -218,6 +241,47 @@ func TestHashNewDefaultValue(t *testing.T) {
 		}
 	})
 
+	t.Run("default zero counts words", func(t *testing.T) {
+		t.Parallel()
+		words := arrayVal(strVal("a"), strVal("b"), strVal("a"), strVal("c"), strVal("a"))
+		got := callFunc(t, script, "default_zero_counter", []Value{words})
+		compareHash(t, hashDefaultsField(t, got, "counts").Hash(), map[string]Value{
+			"a": intVal(3),
+			"b": intVal(1),
+			"c": intVal(1),
+		})
+		if unseen := hashDefaultsField(t, got, "unseen"); unseen.Int() != 0 {
+			t.Fatalf("unseen = %v, want 0", unseen.Int())
+		}
+		if size := hashDefaultsField(t, got, "size"); size.Int() != 3 {
+			t.Fatalf("size = %v, want 3 (reading an unseen key must not insert)", size.Int())
+		}
+	})
+
+	t.Run("default setter counts words", func(t *testing.T) {
+		t.Parallel()
+		words := arrayVal(strVal("a"), strVal("b"), strVal("a"))
+		got := callFunc(t, script, "default_setter_counter", []Value{words})
+		compareHash(t, got.Hash(), map[string]Value{"a": intVal(2), "b": intVal(1)})
+	})
+
+	t.Run("default setter replaces a default proc", func(t *testing.T) {
+		t.Parallel()
+		got := callFunc(t, script, "default_setter_replaces_proc", nil)
+		if missed := hashDefaultsField(t, got, "missed"); missed.Int() != 5 {
+			t.Fatalf("missed = %v, want 5", missed)
+		}
+		if def := hashDefaultsField(t, got, "default"); def.Int() != 5 {
+			t.Fatalf("default = %v, want 5", def)
+		}
+		if hasProc := hashDefaultsField(t, got, "has_proc"); hasProc.Bool() {
+			t.Fatalf("default_proc survived default=")
+		}
+		if stored := hashDefaultsField(t, got, "stored"); stored.Bool() {
+			t.Fatalf("default= stored a :default key instead of setting the default")
+		}
+	})
+
 	t.Run("bare new has nil default", func(t *testing.T) {
 		t.Parallel()
 		got := callFunc(t, script, "bare_new_default", nil)
@@ -0,0 +1,58 @@
+package runtime
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// registerEnvBuiltins installs the `env` namespace, which reads configuration
+// from Config.Env. It never consults the process environment, so a script
+// sees only the variables the host chose to expose. The data is fixed when
+// the engine is built and env cannot write to it, so env is available under
+// StrictEffects without a capability.
+func registerEnvBuiltins(engine *Engine) {
+	vars := engine.config.Env
+	engine.builtins["env"] = NewObject(map[string]Value{
+		"get": NewBuiltin("env.get", func(_ *Execution, _ Value, args []Value, kwargs map[string]Value, block Value) (Value, error) {
+			if err := checkEnvArgs("env.get", args, 1, kwargs, block); err != nil {
+				return NewNil(), err
+			}
+			if val, ok := vars[args[0].String()]; ok {
+				return NewString(val), nil
+			}
+			return NewNil(), nil
+		}),
+		"fetch": NewBuiltin("env.fetch", func(_ *Execution, _ Value, args []Value, kwargs map[string]Value, block Value) (Value, error) {
+			if len(args) < 1 || len(args) > 2 {
+				return NewNil(), fmt.Errorf("env.fetch expects key and optional default")
+			}
+			if err := checkEnvArgs("env.fetch", args, len(args), kwargs, block); err != nil {
+				return NewNil(), err
+			}
+			key := args[0].String()
+			if val, ok := vars[key]; ok {
+				return NewString(val), nil
+			}
+			if len(args) == 2 {
+				return args[1], nil
+			}
+			return NewNil(), fmt.Errorf("env.fetch key not found: %s", strconv.Quote(key))
+		}),
+	})
+}
+
+func checkEnvArgs(name string, args []Value, want int, kwargs map[string]Value, block Value) error {
+	if len(kwargs) > 0 {
+		return fmt.Errorf("%s does not take keyword arguments", name)
+	}
+	if !block.IsNil() {
+		return fmt.Errorf("%s does not accept blocks", name)
+	}
+	if len(args) != want {
+		return fmt.Errorf("%s expects %d argument, got %d", name, want, len(args))
+	}
+	if args[0].Kind() != KindString {
+		return fmt.Errorf("%s expects a string key, got %s", name, args[0].Kind())
+	}
+	return nil
+}
@@ -0,0 +1,44 @@
+package runtime
+
+import "testing"
+
+func TestEnvReadsHostProvidedVariables(t *testing.T) {
+	t.Parallel()
+
+	vars := map[string]string{"REGION": "us-east-1", "EMPTY": ""}
+	script := compileScriptWithConfig(t, Config{Env: vars, StrictEffects: true}, `def present
+  [env.get("REGION"), env.fetch("REGION", "eu-west-1"), env.get("EMPTY")]
+end
+
+def absent
+  [env.get("MISSING"), env.fetch("MISSING", "fallback"), env.fetch("MISSING", nil)]
+end
+
+def strict_fetch
+  env.fetch("MISSING")
+end
+
+def bad_key
+  env.get(:REGION)
+end`)
+	vars["REGION"] = "changed after NewEngine"
+
+	got := callFunc(t, script, "present", nil)
+	compareArrays(t, got, []Value{NewString("us-east-1"), NewString("us-east-1"), NewString("")})
+	got = callFunc(t, script, "absent", nil)
+	compareArrays(t, got, []Value{NewNil(), NewString("fallback"), NewNil()})
+
+	requireCallErrorContains(t, script, "strict_fetch", nil, CallOptions{}, `env.fetch key not found: "MISSING"`)
+	requireCallErrorContains(t, script, "bad_key", nil, CallOptions{}, "env.get expects a string key, got symbol")
+}
+
+func TestEnvIgnoresProcessEnvironment(t *testing.T) {
+	t.Setenv("VIBES_ENV_TEST", "from process")
+
+	script := compileScript(t, `def run
+  env.get("VIBES_ENV_TEST")
+end`)
+	if got := callFunc(t, script, "run", nil); !got.IsNil() {
+		t.Fatalf("env.get read the process environment: %v", got)
+	}
+}
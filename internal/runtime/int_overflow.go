@@ -0,0 +1,88 @@
+package runtime
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+)
+
+// IntOverflowPolicy selects what integer `+`, `-`, `*`, and unary `-` do when
+// the exact result does not fit in an int64.
+type IntOverflowPolicy string
+
+const (
+	// IntOverflowError raises a runtime error. It is the default.
+	IntOverflowError IntOverflowPolicy = "error"
+	// IntOverflowWrap keeps the two's-complement wrapped int64 result, as
+	// native Go arithmetic does.
+	IntOverflowWrap IntOverflowPolicy = "wrap"
+	// IntOverflowPromote returns the exact result as an arbitrary-precision
+	// bigint.
+	IntOverflowPromote IntOverflowPolicy = "promote"
+)
+
+func normalizeIntOverflowPolicy(policy IntOverflowPolicy) (IntOverflowPolicy, error) {
+	switch policy {
+	case "":
+		return IntOverflowError, nil
+	case IntOverflowError, IntOverflowWrap, IntOverflowPromote:
+		return policy, nil
+	default:
+		return "", fmt.Errorf("vibes: unsupported int overflow policy %q", policy)
+	}
+}
+
+// evalIntArithmetic applies an arithmetic operator to two ints under a
+// non-default overflow policy. handled is false for operators the policy does
+// not cover, which then fall through to the regular operator dispatch.
+func (exec *Execution) evalIntArithmetic(operator TokenType, left, right int64) (Value, bool) {
+	var result int64
+	var ok bool
+	var symbol byte
+	switch operator {
+	case tokenPlus:
+		result, ok = addInt64Checked(left, right)
+		symbol = '+'
+	case tokenMinus:
+		result, ok = subInt64Checked(left, right)
+		symbol = '-'
+	case tokenAsterisk:
+		result, ok = mulInt64Checked(left, right)
+		symbol = '*'
+	default:
+		return NewNil(), false
+	}
+	if ok {
+		return NewInt(result), true
+	}
+	if exec.intOverflow == IntOverflowPromote {
+		// Int operands of +, -, and * cannot fail in bigint arithmetic.
+		promoted, _ := bigIntArithmetic(symbol, "", NewInt(left), NewInt(right))
+		return promoted, true
+	}
+	switch operator {
+	case tokenPlus:
+		return NewInt(left + right), true
+	case tokenMinus:
+		return NewInt(left - right), true
+	default:
+		return NewInt(left * right), true
+	}
+}
+
+// negateInt applies unary minus under the overflow policy; only the minimum
+// int64 has no positive counterpart.
+func (exec *Execution) negateInt(n int64) (Value, error) {
+	if n != math.MinInt64 {
+		return NewInt(-n), nil
+	}
+	switch exec.intOverflow {
+	case IntOverflowWrap:
+		return NewInt(n), nil
+	case IntOverflowPromote:
+		promoted := big.NewInt(n)
+		return NewBigInt(promoted.Neg(promoted)), nil
+	default:
+		return NewNil(), int64RangeError("integer negation")
+	}
+}
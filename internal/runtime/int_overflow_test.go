@@ -0,0 +1,68 @@
+package runtime
+
+import (
+	"context"
+	"math"
+	"strings"
+	"testing"
+)
+
+// TestIntOverflowPolicies multiplies, adds, subtracts, and negates at the
+// int64 boundary under each Config.IntOverflow policy.
+func TestIntOverflowPolicies(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		policy  IntOverflowPolicy
+		expr    string
+		want    Value
+		wantErr string
+	}{
+		{name: "default raises on multiply", expr: "9223372036854775807 * 2", wantErr: "integer multiplication result out of int64 range"},
+		{name: "error raises on add", policy: IntOverflowError, expr: "9223372036854775807 + 1", wantErr: "integer addition result out of int64 range"},
+		{name: "error raises on subtract", policy: IntOverflowError, expr: "(-9223372036854775807 - 1) - 1", wantErr: "integer subtraction result out of int64 range"},
+		{name: "error raises on negate", policy: IntOverflowError, expr: "x = -9223372036854775807 - 1\n  -x", wantErr: "integer negation result out of int64 range"},
+		{name: "error keeps in-range results", policy: IntOverflowError, expr: "4611686018427387903 * 2", want: NewInt(math.MaxInt64 - 1)},
+		{name: "wrap multiply", policy: IntOverflowWrap, expr: "9223372036854775807 * 2", want: NewInt(-2)},
+		{name: "wrap add", policy: IntOverflowWrap, expr: "9223372036854775807 + 1", want: NewInt(math.MinInt64)},
+		{name: "wrap subtract", policy: IntOverflowWrap, expr: "(-9223372036854775807 - 1) - 1", want: NewInt(math.MaxInt64)},
+		{name: "wrap negate", policy: IntOverflowWrap, expr: "x = -9223372036854775807 - 1\n  -x", want: NewInt(math.MinInt64)},
+		{name: "wrap compound assignment", policy: IntOverflowWrap, expr: "x = 9223372036854775807\n  x += 1\n  x", want: NewInt(math.MinInt64)},
+		{name: "promote multiply", policy: IntOverflowPromote, expr: "9223372036854775807 * 2", want: mustBigIntValue(t, "18446744073709551614")},
+		{name: "promote add", policy: IntOverflowPromote, expr: "9223372036854775807 + 1", want: mustBigIntValue(t, "9223372036854775808")},
+		{name: "promote subtract", policy: IntOverflowPromote, expr: "(-9223372036854775807 - 1) - 1", want: mustBigIntValue(t, "-9223372036854775809")},
+		{name: "promote negate", policy: IntOverflowPromote, expr: "x = -9223372036854775807 - 1\n  -x", want: mustBigIntValue(t, "9223372036854775808")},
+		{name: "promote continues exactly", policy: IntOverflowPromote, expr: "(9223372036854775807 * 4) / 4 == 9223372036854775807", want: NewBool(true)},
+		{name: "promote keeps in-range ints", policy: IntOverflowPromote, expr: "3037000499 * 3037000499", want: NewInt(9223372030926249001)},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			script := compileScriptWithConfig(t, Config{IntOverflow: tc.policy}, "def run()\n  "+tc.expr+"\nend")
+			got, err := script.Call(context.Background(), "run", nil, CallOptions{})
+			if tc.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+					t.Fatalf("expected error containing %q, got %v (result %v)", tc.wantErr, err, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("call failed: %v", err)
+			}
+			if got.Kind() != tc.want.Kind() || !got.Equal(tc.want) {
+				t.Fatalf("%s = %v (%v), want %v (%v)", tc.expr, got, got.Kind(), tc.want, tc.want.Kind())
+			}
+		})
+	}
+}
+
+func TestNewEngineRejectsUnknownIntOverflowPolicy(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewEngine(Config{IntOverflow: "saturate"})
+	if err == nil || !strings.Contains(err.Error(), `unsupported int overflow policy "saturate"`) {
+		t.Fatalf("expected unsupported policy error, got %v", err)
+	}
+}
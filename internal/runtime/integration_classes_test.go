@@ -1,7 +1,9 @@
 package runtime
 
 import (
+	"bytes"
 	"context"
+	"strings"
 	"testing"
 )
 
@@ -119,3 +121,656 @@ func TestClassErrorCases(t *testing.T) {
 		t.Fatalf("run: writeonly mismatch: %v", h["writeonly"])
 	}
 }
+
+func TestInstanceToSDrivesImplicitStringConversion(t *testing.T) {
+	t.Parallel()
+
+	var stdout, stderr bytes.Buffer
+	script := compileScriptWithConfig(t, Config{OutputWriter: &stdout, ErrorWriter: &stderr}, `
+class Point
+  def initialize(x, y)
+    @x = x
+    @y = y
+  end
+
+  def to_s
+    "(#{@x}, #{@y})"
+  end
+end
+
+class Secret
+  private def to_s
+    "hidden"
+  end
+end
+
+class Numeric
+  def to_s
+    42
+  end
+end
+
+class Bare
+end
+
+def run()
+  pt = Point.new(1, 2)
+  puts pt
+  print pt, "\n"
+  warn pt
+  p pt
+  [
+    "at #{pt}",
+    "#{Secret.new}",
+    "#{Numeric.new}",
+    "#{Bare.new}",
+    pt.to_s
+  ]
+end
+`)
+
+	got := callFunc(t, script, "run", nil)
+	compareArrays(t, got, []Value{
+		NewString("at (1, 2)"),
+		NewString("hidden"),
+		NewString("<Numeric instance>"),
+		NewString("<Bare instance>"),
+		NewString("(1, 2)"),
+	})
+	if want := "(1, 2)\n(1, 2)\n<Point instance>\n"; stdout.String() != want {
+		t.Fatalf("stdout = %q, want %q", stdout.String(), want)
+	}
+	if want := "(1, 2)\n"; stderr.String() != want {
+		t.Fatalf("stderr = %q, want %q", stderr.String(), want)
+	}
+}
+
+func TestInstanceToSErrorPropagates(t *testing.T) {
+	t.Parallel()
+
+	script := compileScript(t, `
+class Broken
+  def to_s
+    raise "cannot render"
+  end
+end
+
+def run()
+  "value: #{Broken.new}"
+end
+`)
+	requireCallErrorContains(t, script, "run", nil, CallOptions{}, "cannot render")
+}
+
+func TestInstanceOperatorMethods(t *testing.T) {
+	t.Parallel()
+
+	script := compileScript(t, `
+class Point
+  def initialize(x, y)
+    @x = x
+    @y = y
+  end
+
+  def x
+    @x
+  end
+
+  def y
+    @y
+  end
+
+  def +(other)
+    Point.new(@x + other.x, @y + other.y)
+  end
+
+  def *(factor)
+    Point.new(@x * factor, @y * factor)
+  end
+
+  def ==(other)
+    @x == other.x && @y == other.y
+  end
+
+  def <=>(other)
+    (@x * @x + @y * @y) <=> (other.x * other.x + other.y * other.y)
+  end
+
+  def to_a
+    [@x, @y]
+  end
+end
+
+def run()
+  a = Point.new(1, 2)
+  b = Point.new(3, 4)
+  total = a + b
+  sum = [a, b, Point.new(5, 6)].reduce(:+)
+  acc = Point.new(0, 0)
+  acc += a
+  acc += b
+  [
+    total.to_a,
+    (a * 3).to_a,
+    sum.to_a,
+    acc.to_a,
+    a == Point.new(1, 2),
+    a != Point.new(1, 2),
+    a != b,
+    a <=> b,
+    a.compare(b),
+    a < b,
+    a <= b,
+    a > b,
+    b >= a
+  ]
+end
+`)
+
+	got := callFunc(t, script, "run", nil)
+	compareArrays(t, got, []Value{
+		NewArray([]Value{NewInt(4), NewInt(6)}),
+		NewArray([]Value{NewInt(3), NewInt(6)}),
+		NewArray([]Value{NewInt(9), NewInt(12)}),
+		NewArray([]Value{NewInt(4), NewInt(6)}),
+		NewBool(true),
+		NewBool(false),
+		NewBool(true),
+		NewInt(-1),
+		NewInt(-1),
+		NewBool(true),
+		NewBool(true),
+		NewBool(false),
+		NewBool(true),
+	})
+}
+
+func TestInstanceOperatorErrors(t *testing.T) {
+	t.Parallel()
+
+	script := compileScript(t, `
+class Plain
+end
+
+class Hidden
+  private def +(other)
+    self
+  end
+end
+
+class Vague
+  def <=>(other)
+    "maybe"
+  end
+end
+
+def add_plain()
+  Plain.new + Plain.new
+end
+
+def add_hidden()
+  Hidden.new + 1
+end
+
+def compare_vague()
+  Vague.new < Vague.new
+end
+`)
+
+	requireCallErrorContains(t, script, "add_plain", nil, CallOptions{}, "unsupported addition operands")
+	requireCallErrorContains(t, script, "add_hidden", nil, CallOptions{}, "private method +")
+	requireCallErrorContains(t, script, "compare_vague", nil, CallOptions{}, "comparison of Vague with Vague failed")
+}
+
+func TestInstanceOperatorsDriveArrayOrderingAndMembership(t *testing.T) {
+	t.Parallel()
+
+	script := compileScript(t, `
+class Version
+  def initialize(major, minor)
+    @major = major
+    @minor = minor
+  end
+
+  def major
+    @major
+  end
+
+  def minor
+    @minor
+  end
+
+  def ==(other)
+    @major == other.major && @minor == other.minor
+  end
+
+  def <=>(other)
+    if @major == other.major
+      @minor <=> other.minor
+    else
+      @major <=> other.major
+    end
+  end
+
+  def label
+    "#{@major}.#{@minor}"
+  end
+end
+
+def run()
+  versions = [Version.new(2, 0), Version.new(1, 4), Version.new(1, 10)]
+  [
+    versions.sort.map { |v| v.label },
+    versions.sort_by { |v| [v, v.major] }.map { |v| v.label },
+    versions.max.label,
+    versions.min.label,
+    versions.minmax.map { |v| v.label },
+    max(versions).label,
+    versions.include?(Version.new(1, 10)),
+    versions.include?(Version.new(3, 0)),
+    versions.index(Version.new(1, 4)),
+    versions.rindex(Version.new(2, 0)),
+    versions.count(Version.new(1, 4))
+  ]
+end
+`)
+
+	got := callFunc(t, script, "run", nil)
+	compareArrays(t, got, []Value{
+		NewArray([]Value{NewString("1.4"), NewString("1.10"), NewString("2.0")}),
+		NewArray([]Value{NewString("1.4"), NewString("1.10"), NewString("2.0")}),
+		NewString("2.0"),
+		NewString("1.4"),
+		NewArray([]Value{NewString("1.4"), NewString("2.0")}),
+		NewString("2.0"),
+		NewBool(true),
+		NewBool(false),
+		NewInt(1),
+		NewInt(0),
+		NewInt(1),
+	})
+}
+
+func TestInstanceOperatorOrderingErrors(t *testing.T) {
+	t.Parallel()
+
+	script := compileScript(t, `
+class Plain
+end
+
+class Vague
+  def <=>(other)
+    "maybe"
+  end
+end
+
+class Loud
+  def <=>(other)
+    raise "cannot rank"
+  end
+end
+
+def sort_plain()
+  [Plain.new, Plain.new].sort
+end
+
+def sort_vague()
+  [Vague.new, Vague.new].sort
+end
+
+def max_loud()
+  [Loud.new, Loud.new].max
+end
+`)
+
+	requireCallErrorContains(t, script, "sort_plain", nil, CallOptions{}, "cannot compare instance with instance")
+	requireCallErrorContains(t, script, "sort_vague", nil, CallOptions{}, "comparison of Vague with Vague failed")
+	requireCallErrorContains(t, script, "max_loud", nil, CallOptions{}, "cannot rank")
+}
+
+func TestInstanceIndexMethods(t *testing.T) {
+	t.Parallel()
+
+	script := compileScript(t, `
+class Store
+  def initialize()
+    @data = {}
+  end
+
+  def [](key)
+    @data.fetch(key, 0)
+  end
+
+  def []=(key, value)
+    @data[key] = value
+  end
+
+  def keys
+    @data.keys
+  end
+end
+
+class Grid
+  def [](row, col)
+    row * 10 + col
+  end
+end
+
+def run()
+  store = Store.new
+  store[:apples] = 3
+  store[:apples] += 2
+  store[:pears] = store[:missing] + 1
+  [store[:apples], store[:pears], store.keys, Grid.new[2, 5]]
+end
+`)
+
+	got := callFunc(t, script, "run", nil)
+	compareArrays(t, got, []Value{
+		NewInt(5),
+		NewInt(1),
+		NewArray([]Value{NewSymbol("apples"), NewSymbol("pears")}),
+		NewInt(25),
+	})
+}
+
+func TestInstanceIndexMethodErrors(t *testing.T) {
+	t.Parallel()
+
+	script := compileScript(t, `
+class ReadOnly
+  def [](key)
+    key
+  end
+end
+
+class Plain
+end
+
+class Sealed
+  private def [](key)
+    key
+  end
+end
+
+def read_plain()
+  ReadOnly.new[:a]
+  Sealed.new[:a]
+end
+
+def write_read_only()
+  box = ReadOnly.new
+  box[:a] = 1
+end
+
+def read_plain_class()
+  box = Plain.new
+  box[:a]
+end
+`)
+
+	requireCallErrorContains(t, script, "read_plain", nil, CallOptions{}, "private method []")
+	requireCallErrorContains(t, script, "write_read_only", nil, CallOptions{}, "cannot assign index on ReadOnly instance: class does not define []=")
+	requireCallErrorContains(t, script, "read_plain_class", nil, CallOptions{}, "cannot index Plain instance: class does not define []")
+}
+
+func TestClassInheritanceAndSuper(t *testing.T) {
+	t.Parallel()
+
+	script := compileScript(t, `
+class Animal
+  property name
+
+  def initialize(name)
+    @name = name
+  end
+
+  def speak
+    "..."
+  end
+
+  def describe(prefix)
+    "#{prefix} #{@name}"
+  end
+
+  def legs
+    4
+  end
+
+  def self.kind
+    "animal"
+  end
+end
+
+class Dog < Animal
+  def initialize(name, breed)
+    super(name)
+    @breed = breed
+  end
+
+  def speak
+    "Woof"
+  end
+
+  def describe(prefix)
+    super + " the #{@breed}"
+  end
+
+  def self.kind
+    "dog " + super
+  end
+end
+
+class Puppy < Dog
+  def speak
+    super() + "!"
+  end
+
+  def describe(prefix)
+    [prefix].map { |p| super(p.upcase) }.first
+  end
+end
+
+def run()
+  dog = Dog.new("Rex", "lab")
+  pup = Puppy.new("Bit", "pug")
+  pup.name = "Bitsy"
+  kind = case pup
+         when Animal then "animal"
+         else "other"
+         end
+  [
+    dog.speak,
+    dog.describe("a"),
+    dog.legs,
+    Dog.kind,
+    pup.speak,
+    pup.describe("a"),
+    pup.name,
+    Puppy.kind,
+    pup.is_a?(Animal),
+    pup.kind_of?(Dog),
+    pup.instance_of?(Dog),
+    pup.respond_to?(:legs),
+    kind
+  ]
+end
+`)
+
+	got := callFunc(t, script, "run", nil)
+	compareArrays(t, got, []Value{
+		NewString("Woof"),
+		NewString("a Rex the lab"),
+		NewInt(4),
+		NewString("dog animal"),
+		NewString("Woof!"),
+		NewString("A Bitsy the pug"),
+		NewString("Bitsy"),
+		NewString("dog animal"),
+		NewBool(true),
+		NewBool(true),
+		NewBool(false),
+		NewBool(true),
+		NewString("animal"),
+	})
+}
+
+func TestSuperWithoutSuperclassMethod(t *testing.T) {
+	t.Parallel()
+
+	script := compileScript(t, `
+class Base
+end
+
+class Child < Base
+  def initialize(value)
+    super
+    @value = value
+  end
+
+  def value
+    @value
+  end
+
+  def greet
+    super
+  end
+end
+
+def build()
+  Child.new(3).value
+end
+
+def greet()
+  Child.new(1).greet
+end
+
+def outside()
+  super
+end
+`)
+
+	if got := callFunc(t, script, "build", nil); got.Int() != 3 {
+		t.Fatalf("build = %v, want 3", got)
+	}
+	requireCallErrorContains(t, script, "greet", nil, CallOptions{}, "super: no superclass method greet")
+	requireCallErrorContains(t, script, "outside", nil, CallOptions{}, "super called outside of a method")
+}
+
+func TestClassSuperclassMustBeDefinedEarlier(t *testing.T) {
+	t.Parallel()
+
+	engine := MustNewEngine(Config{})
+	_, err := engine.Compile(`
+class Child < Parent
+end
+
+class Parent
+end
+`)
+	if err == nil || !strings.Contains(err.Error(), "class Child superclass Parent must be a class defined earlier") {
+		t.Fatalf("expected superclass error, got %v", err)
+	}
+}
+
+func TestModuleIncludeSharesMethods(t *testing.T) {
+	t.Parallel()
+
+	script := compileScript(t, `
+module Describable
+  def describe
+    "#{label}: #{summary}"
+  end
+
+  def summary
+    "generic"
+  end
+
+  private def label
+    "item"
+  end
+end
+
+module Loud
+  def summary
+    "LOUD"
+  end
+end
+
+class Base
+  def summary
+    "base"
+  end
+end
+
+class Invoice < Base
+  include Describable
+
+  def summary
+    "invoice " + super
+  end
+end
+
+class Receipt
+  include Describable, Loud
+end
+
+def run()
+  [
+    Invoice.new.describe,
+    Receipt.new.describe,
+    Receipt.new.respond_to?(:describe),
+    Receipt.new.respond_to?(:label)
+  ]
+end
+`)
+
+	got := callFunc(t, script, "run", nil)
+	compareArrays(t, got, []Value{
+		NewString("item: invoice base"),
+		NewString("item: LOUD"),
+		NewBool(true),
+		NewBool(false),
+	})
+}
+
+func TestModuleIncludeErrors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		source string
+		want   string
+	}{
+		{
+			name: "unknown module",
+			source: `
+class User
+  include Missing
+end
+`,
+			want: "class User includes Missing, which must be a module defined earlier",
+		},
+		{
+			name: "duplicate name",
+			source: `
+module Shared
+end
+
+class Shared
+end
+`,
+			want: "duplicate top-level name Shared",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			engine := MustNewEngine(Config{})
+			_, err := engine.Compile(tt.source)
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Fatalf("expected %q, got %v", tt.want, err)
+			}
+		})
+	}
+}
@@ -4,7 +4,9 @@ import (
 	"context"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
+	"time"
 )
 
 func TestNewEngineRejectsMissingModulePath(t *testing.T) {
@@ -264,6 +266,37 @@ end`)
 	}
 }
 
+func TestGlobalsFromGoConfig(t *testing.T) {
+	t.Parallel()
+	script := compileScriptDefault(t, `def summary
+  "#{config[:region]}:#{config[:limits][:retries]}:#{config[:hosts].size}:#{config[:timeout]}"
+end`)
+
+	globals, err := NewGlobalsFromGo(map[string]any{
+		"config": map[string]any{
+			"region":  "us-east",
+			"hosts":   []string{"a", "b", "c"},
+			"limits":  map[string]int{"retries": 3},
+			"timeout": 30 * time.Second,
+		},
+	})
+	if err != nil {
+		t.Fatalf("NewGlobalsFromGo: %v", err)
+	}
+	result, err := script.Call(context.Background(), "summary", nil, CallOptions{Globals: globals})
+	if err != nil {
+		t.Fatalf("call failed: %v", err)
+	}
+	if result.String() != "us-east:3:3:30s" {
+		t.Fatalf("summary = %q", result.String())
+	}
+
+	_, err = NewGlobalsFromGo(map[string]any{"ok": 1, "handler": func() {}})
+	if err == nil || !strings.Contains(err.Error(), "global handler: cannot convert Go func()") {
+		t.Fatalf("NewGlobalsFromGo(func) err = %v", err)
+	}
+}
+
 func TestAssertFailure(t *testing.T) {
 	t.Parallel()
 	script := compileScriptDefault(t, `def check
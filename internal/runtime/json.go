@@ -100,6 +100,9 @@ func (p *jsonValueParser) parseArray() (Value, error) {
 		if err != nil {
 			return NewNil(), err
 		}
+		if err := p.checkSize("array", len(values)+1); err != nil {
+			return NewNil(), err
+		}
 		values = append(values, value)
 		if err := p.checkMaterialized(NewArray(values)); err != nil {
 			return NewNil(), err
@@ -164,6 +167,9 @@ func (p *jsonValueParser) parseObject() (Value, error) {
 		if err := values.HashSet(NewString(key), value); err != nil {
 			return NewNil(), err
 		}
+		if err := p.checkSize("object", values.HashLen()); err != nil {
+			return NewNil(), err
+		}
 		if err := p.checkMaterialized(values); err != nil {
 			return NewNil(), err
 		}
@@ -436,6 +442,15 @@ func (p *jsonValueParser) checkMaterialized(value Value) error {
 	return p.exec.checkMemoryWith(value)
 }
 
+// checkSize applies Config.MaxCollectionSize to a JSON array or object that
+// is about to hold n elements or entries.
+func (p *jsonValueParser) checkSize(kind string, n int) error {
+	if p.exec == nil {
+		return nil
+	}
+	return p.exec.checkCollectionSize(kind, n)
+}
+
 func isJSONDigit(c byte) bool {
 	return c >= '0' && c <= '9'
 }
@@ -468,6 +483,8 @@ func appendJSONValue(buf []byte, val Value, state *jsonStringifyState) ([]byte,
 		return append(buf, "false"...), nil
 	case KindInt:
 		return strconv.AppendInt(buf, val.Int(), 10), nil
+	case KindBigInt:
+		return append(buf, val.String()...), nil
 	case KindFloat:
 		f := val.Float()
 		if math.IsInf(f, 0) || math.IsNaN(f) {
@@ -48,6 +48,33 @@ end`)
 	requireCallErrorContains(t, script, "blockless", nil, CallOptions{}, "loop requires a block")
 }
 
+func TestKernelLoopNestedBreakExitsInnermost(t *testing.T) {
+	t.Parallel()
+
+	script := compileScript(t, `def run
+  outer = 0
+  inner_total = 0
+  result = loop do
+    outer = outer + 1
+    inner = 0
+    loop do
+      inner = inner + 1
+      if inner == outer
+        break
+      end
+    end
+    inner_total = inner_total + inner
+    if outer == 4
+      break inner_total
+    end
+  end
+  [outer, result]
+end`)
+
+	got := callScript(t, context.Background(), script, "run", nil, CallOptions{})
+	compareArrays(t, got, []Value{NewInt(4), NewInt(10)})
+}
+
 func TestKernelLoopBraceBlockBareBreak(t *testing.T) {
 	t.Parallel()
 
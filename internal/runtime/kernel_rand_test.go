@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"math"
+	"regexp"
 	"testing"
 )
 
@@ -213,3 +214,50 @@ end`)
 		t.Fatalf("rand entropy reads = %d, want 0 after pre-canceled context", reads)
 	}
 }
+
+func TestUUIDVersion4FollowsSeededSource(t *testing.T) {
+	t.Parallel()
+
+	script := compileScriptWithConfig(t, Config{
+		RandomReader: bytes.NewReader(bytes.Repeat([]byte{0xAB}, 64)),
+	}, `def seeded
+  srand(1234)
+  first = uuid(version: 4)
+  srand(1234)
+  [first, uuid(version: 4), uuid(version: 4)]
+end
+
+def unseeded
+  uuid(version: 4)
+end
+
+def bad_version
+  uuid(version: 5)
+end
+
+def bad_keyword
+  uuid(format: 4)
+end`)
+
+	got := callScript(t, context.Background(), script, "seeded", nil, CallOptions{})
+	ids := got.Array()
+	const want = "c089bdd5-c7f7-488f-8e1b-5ccd85b6214d"
+	if ids[0].String() != want || !ids[1].Equal(ids[0]) {
+		t.Fatalf("seeded uuids = %v, want %s twice", got, want)
+	}
+	if ids[2].Equal(ids[0]) {
+		t.Fatalf("next seeded uuid repeated %s", ids[2])
+	}
+	uuidV4 := regexp.MustCompile(`^[0-9a-f]{8}-[0-9a-f]{4}-4[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$`)
+	for _, id := range ids {
+		if !uuidV4.MatchString(id.String()) {
+			t.Fatalf("uuid %q is not a version 4 UUID", id.String())
+		}
+	}
+
+	if got := callScript(t, context.Background(), script, "unseeded", nil, CallOptions{}); got.String() != "abababab-abab-4bab-abab-abababababab" {
+		t.Fatalf("unseeded uuid = %q, want entropy-derived id", got.String())
+	}
+	requireCallErrorContains(t, script, "bad_version", nil, CallOptions{}, "uuid version must be 4 or 7")
+	requireCallErrorContains(t, script, "bad_keyword", nil, CallOptions{}, "uuid does not accept keyword format")
+}
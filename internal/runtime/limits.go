@@ -37,6 +37,12 @@ const (
 	// host-side writes when embedders configure very large memory quotas.
 	maxOutputHelperBytes = 1 << 20
 
+	// maxStringConversionBytes caps the string String(value) builds at
+	// 16 MiB. Unlike the output helpers the result stays in the script, where
+	// the memory quota already bounds it, so this cap only keeps a host that
+	// configures a very large quota from rendering an unbounded string.
+	maxStringConversionBytes = 16 << 20
+
 	// maxRegexPatternSize caps regex patterns at 16 KiB. Patterns are
 	// compiled before any quota accounting happens, and pathological
 	// patterns are far smaller than pathological inputs, so the
@@ -0,0 +1,39 @@
+package runtime
+
+import (
+	"fmt"
+	"sort"
+)
+
+// builtinLocals returns the local variables of the running function as a hash
+// from name to value, for debugging. Block parameters and variables of the
+// blocks enclosing the call site are included; self, globals, and builtins
+// are not. The dump exposes script state the host may not want logged, so it
+// is disabled unless the engine sets Config.AllowIntrospection.
+func builtinLocals(exec *Execution, receiver Value, args []Value, kwargs map[string]Value, block Value) (Value, error) {
+	if exec.engine == nil || !exec.engine.config.AllowIntrospection {
+		return NewNil(), fmt.Errorf("locals is disabled without Config.AllowIntrospection")
+	}
+	if len(args) > 0 || len(kwargs) > 0 {
+		return NewNil(), fmt.Errorf("locals does not take arguments")
+	}
+	if !block.IsNil() {
+		return NewNil(), fmt.Errorf("locals does not accept blocks")
+	}
+	if len(exec.envStack) == 0 {
+		return NewHash(map[string]Value{}), nil
+	}
+	env := exec.envStack[len(exec.envStack)-1]
+	names := env.callLocalNames(exec.ambientNames)
+	sort.Strings(names)
+	out := make(map[string]Value, len(names))
+	for _, name := range names {
+		if name == "self" {
+			continue
+		}
+		if val, ok := env.Get(name); ok {
+			out[name] = val
+		}
+	}
+	return NewHash(out), nil
+}
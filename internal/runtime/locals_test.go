@@ -0,0 +1,40 @@
+package runtime
+
+import (
+	"context"
+	"testing"
+)
+
+func TestLocalsDumpsVariablesInScope(t *testing.T) {
+	t.Parallel()
+
+	source := `def helper(a, b = 2)
+  total = a + b
+  [10].map do |item|
+    doubled = item * 2
+    locals
+  end
+end
+
+def run
+  helper(1).first
+end`
+
+	script := compileScriptWithConfig(t, Config{AllowIntrospection: true}, source)
+	got := callScript(t, context.Background(), script, "run", nil, CallOptions{
+		Globals: map[string]Value{"tenant": NewString("acme")},
+	})
+	want := NewHash(map[string]Value{
+		"a":       NewInt(1),
+		"b":       NewInt(2),
+		"total":   NewInt(3),
+		"item":    NewInt(10),
+		"doubled": NewInt(20),
+	})
+	if !got.Equal(want) {
+		t.Fatalf("locals = %v, want %v", got, want)
+	}
+
+	disabled := compileScript(t, source)
+	requireCallErrorContains(t, disabled, "run", nil, CallOptions{}, "locals is disabled without Config.AllowIntrospection")
+}
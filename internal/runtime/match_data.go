@@ -141,6 +141,25 @@ func newRegexpObject(pattern string) (Value, error) {
 	}), nil
 }
 
+// regexpObjectSource returns the pattern of a value built by Regexp.new, so
+// string methods can take a compiled regex wherever they take a pattern
+// string.
+func regexpObjectSource(val Value) (string, bool) {
+	if val.Kind() != KindObject {
+		return "", false
+	}
+	fields := val.Hash()
+	source, ok := fields["source"]
+	if !ok || source.Kind() != KindString {
+		return "", false
+	}
+	match := valueBuiltin(fields["match"])
+	if match == nil || match.Name != "regexp.match" {
+		return "", false
+	}
+	return source.String(), true
+}
+
 func regexpUnionPattern(args []Value) (string, error) {
 	if len(args) == 0 {
 		// A never-matching pattern (Ruby returns /(?!)/). Go's RE2 engine rejects
@@ -185,6 +185,8 @@ func (exec *Execution) resolveTypedMember(obj Value, property string, pos Positi
 		return exec.intMember(obj, property, pos)
 	case KindFloat:
 		return exec.floatMember(obj, property, pos)
+	case KindBigInt:
+		return exec.bigintMember(obj, property, pos)
 	case KindRange:
 		return exec.rangeMember(obj, property, pos)
 	case KindFunction:
@@ -195,6 +197,15 @@ func (exec *Execution) resolveTypedMember(obj Value, property string, pos Positi
 		return exec.nilMember(obj, property, pos)
 	case KindBool:
 		return exec.boolMember(obj, property, pos)
+	case KindBuiltin:
+		if builtin := valueBuiltin(obj); builtin != nil && builtin.Members != nil {
+			members := builtin.Members
+			if member, ok := members[property]; ok {
+				return member, nil
+			}
+			return NewNil(), exec.errorAt(pos, "unknown member %s%s", property, didYouMean(property, slices.Sorted(maps.Keys(members))))
+		}
+		return NewNil(), exec.errorAt(pos, "unsupported member access on %s", obj.Kind())
 	default:
 		return NewNil(), exec.errorAt(pos, "unsupported member access on %s", obj.Kind())
 	}
@@ -250,19 +261,19 @@ func (exec *Execution) classMember(obj Value, property string, pos Position, cal
 		constructor := NewAutoBuiltin(cl.Name+".new", func(exec *Execution, receiver Value, args []Value, kwargs map[string]Value, block Value) (Value, error) {
 			inst := &Instance{Class: cl, Ivars: make(map[string]Value)}
 			instVal := NewInstance(inst)
-			if initFn, ok := cl.Methods["initialize"]; ok {
+			if initFn, ok := cl.findMethod("initialize"); ok {
 				if _, err := exec.callFunctionIgnoringReturn(initFn, instVal, args, kwargs, block, pos); err != nil {
 					return NewNil(), err
 				}
 			}
 			return instVal, nil
 		})
-		if initFn, ok := cl.Methods["initialize"]; ok {
+		if initFn, ok := cl.findMethod("initialize"); ok {
 			valueBuiltin(constructor).OptionsHashTarget = initFn
 		}
 		return constructor, nil
 	}
-	if fn, ok := cl.ClassMethods[property]; ok {
+	if fn, ok := cl.findClassMethod(property); ok {
 		if fn.Private && !callerIsReceiver {
 			return NewNil(), privateMemberAccess(exec.errorAt(pos, "private method %s", property))
 		}
@@ -288,7 +299,9 @@ func (exec *Execution) classMember(obj Value, property string, pos Position, cal
 	}
 	candidates := make([]string, 0, len(cl.ClassMethods)+len(cl.ClassVars)+1+len(universalMemberNames))
 	candidates = append(candidates, "new")
-	candidates = appendAccessibleMethodNames(candidates, cl.ClassMethods, callerIsReceiver)
+	for ancestor := cl; ancestor != nil; ancestor = ancestor.Superclass {
+		candidates = appendAccessibleMethodNames(candidates, ancestor.ClassMethods, callerIsReceiver)
+	}
 	candidates = slices.AppendSeq(candidates, maps.Keys(cl.ClassVars))
 	candidates = append(candidates, universalMemberNames...)
 	return NewNil(), exec.errorAt(pos, "unknown class member %s%s", property, didYouMean(property, candidates))
@@ -299,7 +312,7 @@ func (exec *Execution) instanceMember(obj Value, property string, pos Position,
 	if property == "class" {
 		return NewClass(inst.Class), nil
 	}
-	if fn, ok := inst.Class.Methods[property]; ok {
+	if fn, ok := inst.Class.findMethod(property); ok {
 		if fn.Private && !callerIsReceiver {
 			return NewNil(), privateMemberAccess(exec.errorAt(pos, "private method %s", property))
 		}
@@ -325,7 +338,9 @@ func (exec *Execution) instanceMember(obj Value, property string, pos Position,
 	}
 	candidates := make([]string, 0, len(inst.Class.Methods)+len(inst.Ivars)+1+len(universalMemberNames))
 	candidates = append(candidates, "class")
-	candidates = appendAccessibleMethodNames(candidates, inst.Class.Methods, callerIsReceiver)
+	for ancestor := inst.Class; ancestor != nil; ancestor = ancestor.Superclass {
+		candidates = appendAccessibleMethodNames(candidates, ancestor.Methods, callerIsReceiver)
+	}
 	candidates = slices.AppendSeq(candidates, maps.Keys(inst.Ivars))
 	candidates = append(candidates, universalMemberNames...)
 	return NewNil(), exec.errorAt(pos, "unknown member %s%s", property, didYouMean(property, candidates))
@@ -403,6 +418,7 @@ func MemberCompletionNames() map[string][]string {
 		"hash":     withUniversalMembers(hashMemberNames),
 		"int":      withUniversalMembers(intMemberNames),
 		"float":    withUniversalMembers(floatMemberNames),
+		"bigint":   withUniversalMembers(bigintMemberNames),
 		"money":    withUniversalMembers(moneyMemberNames),
 		"duration": withUniversalMembers(durationMemberNames),
 		"time":     withUniversalMembers(timeMemberNames),
@@ -13,12 +13,13 @@ import (
 // switch below; TestMemberSuggestionCandidatesResolve enforces that every
 // listed name resolves.
 var arrayMemberNames = []string{
-	"size", "length", "empty?", "each", "each_with_index", "each_slice", "each_cons", "reverse_each", "cycle", "map", "map_with_index", "filter_map", "select", "reject", "find", "find_index", "reduce", "include?", "index", "rindex", "at", "slice", "fetch", "values_at", "dig", "count", "any?", "all?", "none?", "one?",
+	"size", "length", "empty?", "each", "each_with_index", "each_slice", "each_cons", "reverse_each", "cycle", "map", "map_with_index", "filter_map", "select", "select_with_index", "reject", "reject!", "delete_if", "find", "find_index", "bsearch", "reduce", "include?", "index", "rindex", "at", "slice", "fetch", "values_at", "dig", "count", "any?", "all?", "none?", "one?",
 	"take_while", "drop_while", "grep", "grep_v",
-	"push", "append", "prepend", "unshift", "pop", "shift", "delete", "insert", "uniq", "first", "last", "sum", "compact", "flatten", "fill", "chunk", "window", "join", "reverse", "to_h",
-	"take", "drop", "zip", "transpose", "union", "difference",
-	"sort", "sort_by", "partition", "group_by", "group_by_stable", "tally",
+	"push", "append", "prepend", "unshift", "pop", "shift", "delete", "delete_at", "insert", "uniq", "first", "last", "sum", "compact", "flatten", "fill", "chunk", "window", "join", "reverse", "to_h",
+	"take", "drop", "zip", "transpose", "concat", "union", "intersection", "difference",
+	"sort", "sort_by", "partition", "group_by", "group_by_stable", "tally", "tally_by",
 	"min", "max", "minmax", "min_by", "max_by",
+	"average", "mean", "median", "variance", "standard_deviation",
 	"inspect",
 }
 
@@ -33,15 +34,17 @@ func arrayMember(array Value, property string) (Value, error) {
 
 func arrayMemberBuiltin(property string) (Value, error) {
 	switch property {
-	case "size", "length", "empty?", "each", "each_with_index", "each_slice", "each_cons", "reverse_each", "cycle", "map", "map_with_index", "filter_map", "select", "reject", "find", "find_index", "reduce", "include?", "index", "rindex", "at", "slice", "fetch", "values_at", "dig", "count", "any?", "all?", "none?", "one?",
+	case "size", "length", "empty?", "each", "each_with_index", "each_slice", "each_cons", "reverse_each", "cycle", "map", "map_with_index", "filter_map", "select", "select_with_index", "reject", "reject!", "delete_if", "find", "find_index", "bsearch", "reduce", "include?", "index", "rindex", "at", "slice", "fetch", "values_at", "dig", "count", "any?", "all?", "none?", "one?",
 		"take_while", "drop_while", "grep", "grep_v":
 		return arrayMemberQuery(property)
-	case "push", "append", "prepend", "unshift", "pop", "shift", "delete", "insert", "uniq", "first", "last", "sum", "compact", "flatten", "fill", "chunk", "window", "join", "reverse", "to_h", "take", "drop", "zip", "transpose", "union", "difference":
+	case "push", "append", "prepend", "unshift", "pop", "shift", "delete", "delete_at", "insert", "uniq", "first", "last", "sum", "compact", "flatten", "fill", "chunk", "window", "join", "reverse", "to_h", "take", "drop", "zip", "transpose", "concat", "union", "intersection", "difference":
 		return arrayMemberTransforms(property)
-	case "sort", "sort_by", "partition", "group_by", "group_by_stable", "tally":
+	case "sort", "sort_by", "partition", "group_by", "group_by_stable", "tally", "tally_by":
 		return arrayMemberGrouping(property)
 	case "min", "max", "minmax", "min_by", "max_by":
 		return arrayMemberExtrema(property)
+	case "average", "mean", "median", "variance", "standard_deviation":
+		return arrayMemberStatistics(property)
 	case "inspect":
 		return newInspectBuiltin("array"), nil
 	default:
@@ -88,9 +91,13 @@ func arrayMemberGrouping(property string) (Value, error) {
 					}
 					return cmp < 0
 				}
-				cmp, err := arraySortCompareValues(out[i], out[j])
+				cmp, err := arraySortCompareValues(exec, out[i], out[j])
 				if err != nil {
-					sortErr = fmt.Errorf("array.sort values are not comparable")
+					if raisedByScript(err) {
+						sortErr = err
+						return false
+					}
+					sortErr = fmt.Errorf("array.sort values are not comparable: %s", describeIncomparablePair(exec, out[i], out[j]))
 					return false
 				}
 				return cmp < 0
@@ -130,9 +137,13 @@ func arrayMemberGrouping(property string) (Value, error) {
 				if sortErr != nil {
 					return false
 				}
-				cmp, err := arraySortCompareValues(withKeys[i].key, withKeys[j].key)
+				cmp, err := arraySortCompareValues(exec, withKeys[i].key, withKeys[j].key)
 				if err != nil {
-					sortErr = fmt.Errorf("array.sort_by block values are not comparable")
+					if raisedByScript(err) {
+						sortErr = err
+						return false
+					}
+					sortErr = fmt.Errorf("array.sort_by block values are not comparable: %s", describeIncomparablePair(exec, withKeys[i].key, withKeys[j].key))
 					return false
 				}
 				if cmp == 0 {
@@ -201,6 +212,9 @@ func arrayMemberGrouping(property string) (Value, error) {
 					return NewNil(), fmt.Errorf("array.group_by block returned unsupported hash key: %w", err)
 				}
 				if _, exists := groups[key]; !exists {
+					if err := exec.checkCollectionSize("array.group_by", len(groups)+1); err != nil {
+						return NewNil(), err
+					}
 					keyValues[key] = groupValue
 				}
 				groups[key] = append(groups[key], item)
@@ -254,56 +268,71 @@ func arrayMemberGrouping(property string) (Value, error) {
 			return NewArray(result), nil
 		}), nil
 	case "tally":
-		return NewAutoBuiltin("array.tally", func(exec *Execution, receiver Value, args []Value, kwargs map[string]Value, block Value) (Value, error) {
-			if len(args) > 0 {
-				return NewNil(), fmt.Errorf("array.tally does not take arguments")
-			}
-			arr := receiver.Array()
-			hasBlock := valueBlock(block) != nil
-			initialCapacity, err := arrayTallyInitialCapacity(arr, hasBlock)
+		return arrayMemberTally("array.tally", false), nil
+	case "tally_by":
+		return arrayMemberTally("array.tally_by", true), nil
+	default:
+		return NewNil(), fmt.Errorf("unknown array method %s", property)
+	}
+}
+
+// arrayMemberTally builds tally and tally_by. Both count elements by key,
+// where the key is the element itself or the block's result; tally_by
+// requires the block.
+func arrayMemberTally(name string, requireBlock bool) Value {
+	return NewAutoBuiltin(name, func(exec *Execution, receiver Value, args []Value, kwargs map[string]Value, block Value) (Value, error) {
+		if len(args) > 0 {
+			return NewNil(), fmt.Errorf("%s does not take arguments", name)
+		}
+		arr := receiver.Array()
+		hasBlock := valueBlock(block) != nil
+		if requireBlock && !hasBlock {
+			return NewNil(), fmt.Errorf("%s requires a block", name)
+		}
+		initialCapacity, err := arrayTallyInitialCapacity(arr, hasBlock)
+		if err != nil {
+			return NewNil(), fmt.Errorf("%s value is unsupported hash key: %w", name, err)
+		}
+		counts := make(map[hashAggregationKey]int64, initialCapacity)
+		keyValues := make(map[hashAggregationKey]Value, initialCapacity)
+		var runner *blockCallRunner
+		if hasBlock {
+			runner, err = newBlockCallRunner(exec, block, name, receiver, nil, kwargs)
 			if err != nil {
-				return NewNil(), fmt.Errorf("array.tally value is unsupported hash key: %w", err)
+				return NewNil(), err
 			}
-			counts := make(map[hashAggregationKey]int64, initialCapacity)
-			keyValues := make(map[hashAggregationKey]Value, initialCapacity)
-			var runner *blockCallRunner
+		}
+		var blockArg [1]Value
+		for _, item := range arr {
+			keyValue := item
 			if hasBlock {
-				runner, err = newBlockCallRunner(exec, block, "array.tally", receiver, nil, kwargs)
+				blockArg[0] = item
+				mapped, err := runner.call(blockArg[:])
 				if err != nil {
 					return NewNil(), err
 				}
+				keyValue = mapped
 			}
-			var blockArg [1]Value
-			for _, item := range arr {
-				keyValue := item
-				if hasBlock {
-					blockArg[0] = item
-					mapped, err := runner.call(blockArg[:])
-					if err != nil {
-						return NewNil(), err
-					}
-					keyValue = mapped
-				}
-				key, err := newHashAggregationKey(keyValue)
-				if err != nil {
-					return NewNil(), fmt.Errorf("array.tally value is unsupported hash key: %w", err)
-				}
-				if _, exists := keyValues[key]; !exists {
-					keyValues[key] = keyValue
-				}
-				counts[key]++
+			key, err := newHashAggregationKey(keyValue)
+			if err != nil {
+				return NewNil(), fmt.Errorf("%s value is unsupported hash key: %w", name, err)
 			}
-			result := NewHash(make(map[string]Value, len(counts)))
-			for key, count := range counts {
-				if err := hashSet(result, keyValues[key], NewInt(count)); err != nil {
+			if _, exists := keyValues[key]; !exists {
+				if err := exec.checkCollectionSize(name, len(keyValues)+1); err != nil {
 					return NewNil(), err
 				}
+				keyValues[key] = keyValue
 			}
-			return result, nil
-		}), nil
-	default:
-		return NewNil(), fmt.Errorf("unknown array method %s", property)
-	}
+			counts[key]++
+		}
+		result := NewHash(make(map[string]Value, len(counts)))
+		for key, count := range counts {
+			if err := hashSet(result, keyValues[key], NewInt(count)); err != nil {
+				return NewNil(), err
+			}
+		}
+		return result, nil
+	})
 }
 
 func arrayMemberExtrema(property string) (Value, error) {
@@ -327,15 +356,21 @@ func arrayMemberExtrema(property string) (Value, error) {
 			minVal := arr[0]
 			maxVal := arr[0]
 			for _, item := range arr[1:] {
-				cmpMin, err := arraySortCompareValues(item, minVal)
+				cmpMin, err := arraySortCompareValues(exec, item, minVal)
 				if err != nil {
+					if raisedByScript(err) {
+						return NewNil(), err
+					}
 					return NewNil(), fmt.Errorf("array.minmax values are not comparable")
 				}
 				if cmpMin < 0 {
 					minVal = item
 				}
-				cmpMax, err := arraySortCompareValues(item, maxVal)
+				cmpMax, err := arraySortCompareValues(exec, item, maxVal)
 				if err != nil {
+					if raisedByScript(err) {
+						return NewNil(), err
+					}
 					return NewNil(), fmt.Errorf("array.minmax values are not comparable")
 				}
 				if cmpMax > 0 {
@@ -371,8 +406,11 @@ func arrayMemberMinMax(name string, wantMax bool) Value {
 		}
 		best := arr[0]
 		for _, item := range arr[1:] {
-			cmp, err := arraySortCompareValues(item, best)
+			cmp, err := arraySortCompareValues(exec, item, best)
 			if err != nil {
+				if raisedByScript(err) {
+					return NewNil(), err
+				}
 				return NewNil(), fmt.Errorf("%s values are not comparable", name)
 			}
 			if (wantMax && cmp > 0) || (!wantMax && cmp < 0) {
@@ -413,8 +451,11 @@ func arrayMemberMinMaxBy(name string, wantMax bool) Value {
 			if err != nil {
 				return NewNil(), err
 			}
-			cmp, err := arraySortCompareValues(key, bestKey)
+			cmp, err := arraySortCompareValues(exec, key, bestKey)
 			if err != nil {
+				if raisedByScript(err) {
+					return NewNil(), err
+				}
 				return NewNil(), fmt.Errorf("%s block values are not comparable", name)
 			}
 			if (wantMax && cmp > 0) || (!wantMax && cmp < 0) {
@@ -790,6 +831,9 @@ func arrayMemberQuery(property string) (Value, error) {
 				return NewNil(), err
 			}
 			arr := receiver.Array()
+			if err := exec.checkCollectionSize("array.map", len(arr)); err != nil {
+				return NewNil(), err
+			}
 			result := make([]Value, len(arr))
 			var blockArg [1]Value
 			for i, item := range arr {
@@ -857,6 +901,39 @@ func arrayMemberQuery(property string) (Value, error) {
 			}
 			return NewArray(out), nil
 		}), nil
+	case "select_with_index":
+		return NewAutoBuiltin("array.select_with_index", func(exec *Execution, receiver Value, args []Value, kwargs map[string]Value, block Value) (Value, error) {
+			if len(args) > 0 {
+				return NewNil(), fmt.Errorf("array.select_with_index does not take arguments")
+			}
+			if len(kwargs) > 0 {
+				return NewNil(), fmt.Errorf("array.select_with_index does not take keyword arguments")
+			}
+			runner, err := newBlockCallRunner(exec, block, "array.select_with_index", receiver, nil, kwargs)
+			if err != nil {
+				return NewNil(), err
+			}
+			arr := receiver.Array()
+			out := make([]Value, 0, len(arr))
+			var blockArgs [2]Value
+			for i, item := range arr {
+				// Charge a step per yield, as map_with_index does, so an empty
+				// block cannot traverse a large receiver for free.
+				if err := exec.step(); err != nil {
+					return NewNil(), err
+				}
+				blockArgs[0] = item
+				blockArgs[1] = NewInt(int64(i))
+				val, err := runner.call(blockArgs[:])
+				if err != nil {
+					return NewNil(), err
+				}
+				if val.Truthy() {
+					out = append(out, item)
+				}
+			}
+			return NewArray(out), nil
+		}), nil
 	case "filter_map":
 		return NewAutoBuiltin("array.filter_map", func(exec *Execution, receiver Value, args []Value, kwargs map[string]Value, block Value) (Value, error) {
 			if len(args) > 0 {
@@ -949,36 +1026,11 @@ func arrayMemberQuery(property string) (Value, error) {
 			return NewArray(out), nil
 		}), nil
 	case "reject":
-		return NewAutoBuiltin("array.reject", func(exec *Execution, receiver Value, args []Value, kwargs map[string]Value, block Value) (Value, error) {
-			if len(args) > 0 {
-				return NewNil(), fmt.Errorf("array.reject does not take arguments")
-			}
-			runner, err := newBlockCallRunner(exec, block, "array.reject", receiver, nil, kwargs)
-			if err != nil {
-				return NewNil(), err
-			}
-			arr := receiver.Array()
-			out := make([]Value, 0, len(arr))
-			var blockArg [1]Value
-			for _, item := range arr {
-				blockArg[0] = item
-				val, err := runner.call(blockArg[:])
-				if err != nil {
-					return NewNil(), err
-				}
-				if !val.Truthy() {
-					out = append(out, item)
-				}
-			}
-			// A sparse result should not retain a backing array sized to the
-			// whole receiver, so right-size the result.
-			if len(out) < cap(out) {
-				trimmed := make([]Value, len(out))
-				copy(trimmed, out)
-				out = trimmed
-			}
-			return NewArray(out), nil
-		}), nil
+		return arrayMemberReject("array.reject", false), nil
+	case "delete_if":
+		return arrayMemberReject("array.delete_if", false), nil
+	case "reject!":
+		return arrayMemberReject("array.reject!", true), nil
 	case "take_while":
 		return NewAutoBuiltin("array.take_while", func(exec *Execution, receiver Value, args []Value, kwargs map[string]Value, block Value) (Value, error) {
 			if len(args) > 0 {
@@ -1078,6 +1130,8 @@ func arrayMemberQuery(property string) (Value, error) {
 			}
 			return NewNil(), nil
 		}), nil
+	case "bsearch":
+		return NewAutoBuiltin("array.bsearch", arrayBsearch), nil
 	case "find_index":
 		return NewAutoBuiltin("array.find_index", func(exec *Execution, receiver Value, args []Value, kwargs map[string]Value, block Value) (Value, error) {
 			return arrayForwardIndex(exec, receiver, args, block, "array.find_index")
@@ -1090,7 +1144,11 @@ func arrayMemberQuery(property string) (Value, error) {
 				return NewNil(), fmt.Errorf("array.include? expects exactly one value")
 			}
 			for _, item := range receiver.Array() {
-				if item.Equal(args[0]) {
+				equal, err := exec.valuesEqual(item, args[0])
+				if err != nil {
+					return NewNil(), err
+				}
+				if equal {
 					return NewBool(true), nil
 				}
 			}
@@ -1256,7 +1314,11 @@ func arrayMemberQuery(property string) (Value, error) {
 				// ignored, matching Ruby's Array#count(value) { ... }.
 				total := int64(0)
 				for _, item := range arr {
-					if item.Equal(args[0]) {
+					equal, err := exec.valuesEqual(item, args[0])
+					if err != nil {
+						return NewNil(), err
+					}
+					if equal {
 						total++
 					}
 				}
@@ -1470,7 +1532,7 @@ func arrayPredicate(exec *Execution, receiver Value, args []Value, kwargs map[st
 	if len(args) == 1 {
 		pattern := args[0]
 		return arrayPredicateResult(kind, arr, func(item Value) (bool, error) {
-			return caseCandidateMatches(item, pattern), nil
+			return caseCandidateMatches(item, pattern)
 		})
 	}
 	if valueBlock(block) != nil {
@@ -1580,7 +1642,11 @@ func arrayForwardIndex(exec *Execution, receiver Value, args []Value, block Valu
 	}
 	arr := receiver.Array()
 	for idx := offset; idx < len(arr); idx++ {
-		if arr[idx].Equal(args[0]) {
+		equal, err := exec.valuesEqual(arr[idx], args[0])
+		if err != nil {
+			return NewNil(), err
+		}
+		if equal {
 			return NewInt(int64(idx)), nil
 		}
 	}
@@ -1640,7 +1706,11 @@ func arrayReverseIndex(exec *Execution, receiver Value, args []Value, block Valu
 		offset = len(arr) - 1
 	}
 	for idx := offset; idx >= 0; idx-- {
-		if arr[idx].Equal(args[0]) {
+		equal, err := exec.valuesEqual(arr[idx], args[0])
+		if err != nil {
+			return NewNil(), err
+		}
+		if equal {
 			return NewInt(int64(idx)), nil
 		}
 	}
@@ -1941,21 +2011,34 @@ func arrayReduce(exec *Execution, receiver Value, args []Value, kwargs map[strin
 //	values.sum { |item| ... }        # start from 0, add each block result
 //	values.sum(initial) { |item| ... } # combine both
 //
-// The optional positional argument seeds the accumulator and an optional block
-// transforms each element before it is added. Like Ruby's `+`, each addition
-// must operate on compatible operands, so mixing a string with a non-string (or
-// any other unsupported pair) raises instead of silently coercing the operands.
+// The optional positional argument (or `initial:` keyword) seeds the
+// accumulator and an optional block transforms each element before it is
+// added. Like Ruby's `+`, each addition must operate on compatible operands, so
+// mixing a string with a non-string (or any other unsupported pair) raises
+// instead of silently coercing the operands. Money is the one exception to the
+// implicit 0 seed: without an initial value, a first money contribution starts
+// the total, so `prices.sum` totals same-currency money. An empty array still
+// sums to 0, so pass `initial: money("0.00 USD")` to get money back either way.
 func arraySum(exec *Execution, receiver Value, args []Value, kwargs map[string]Value, block Value) (Value, error) {
-	if len(kwargs) > 0 {
-		return NewNil(), fmt.Errorf("array.sum does not take keyword arguments")
-	}
 	if len(args) > 1 {
 		return NewNil(), fmt.Errorf("array.sum accepts at most an initial value")
 	}
+	for key := range kwargs {
+		if key != "initial" {
+			return NewNil(), fmt.Errorf("array.sum does not accept keyword %s", key)
+		}
+	}
 
 	total := NewInt(0)
+	seeded := false
 	if len(args) == 1 {
-		total = args[0]
+		total, seeded = args[0], true
+	}
+	if initial, ok := kwargs["initial"]; ok {
+		if seeded {
+			return NewNil(), fmt.Errorf("array.sum accepts an initial value or initial:, not both")
+		}
+		total, seeded = initial, true
 	}
 
 	var runner *blockCallRunner
@@ -1982,6 +2065,11 @@ func arraySum(exec *Execution, receiver Value, args []Value, kwargs map[string]V
 			}
 			contribution = result
 		}
+		if !seeded && contribution.Kind() == KindMoney {
+			total, seeded = contribution, true
+			continue
+		}
+		seeded = true
 		next, err := arraySumAdd(total, contribution)
 		if err != nil {
 			return NewNil(), err
@@ -2008,12 +2096,16 @@ func arraySum(exec *Execution, receiver Value, args []Value, kwargs map[string]V
 // arraySumAdd adds one contribution into the running total for array.sum. It
 // reuses addValues for the actual arithmetic but rejects the asymmetric
 // string-coercion addValues allows (e.g. 0 + "a"), matching Ruby's strict `+`
-// where a string and a non-string cannot be summed together.
+// where a string and a non-string cannot be summed together, and names both
+// currencies when money totals mix them.
 func arraySumAdd(total, contribution Value) (Value, error) {
 	isString := func(v Value) bool { return v.Kind() == KindString }
 	if isString(total) != isString(contribution) {
 		return NewNil(), errArraySumIncompatible
 	}
+	if total.Kind() == KindMoney && contribution.Kind() == KindMoney && total.Money().Currency() != contribution.Money().Currency() {
+		return NewNil(), fmt.Errorf("array.sum cannot add %s money to a %s total", contribution.Money().Currency(), total.Money().Currency())
+	}
 	sum, err := addValues(total, contribution)
 	if err != nil {
 		return NewNil(), errArraySumIncompatible
@@ -2055,13 +2147,14 @@ var reduceArithmeticOps = map[string]func(left, right Value) (Value, error){
 
 // reduceSendOperation applies a single fold step by sending operation to the
 // accumulator with item as its argument. Operator names dispatch to the same
-// arithmetic helpers the corresponding operators use; any other name is treated
-// as a method invoked as `accumulator.operation(item)`, mirroring Ruby's
+// arithmetic helpers the corresponding operators use, except on an instance
+// accumulator, whose class may define the operator as a method; any other name
+// is treated as a method invoked as `accumulator.operation(item)`, mirroring Ruby's
 // `accumulator.public_send(operation, item)`. Resolution is public-only, so an
 // accumulator that happens to be the current self cannot reach private methods,
 // matching public_send's privacy guarantee.
 func (exec *Execution) reduceSendOperation(accumulator Value, operation string, item Value) (Value, error) {
-	if op, ok := reduceArithmeticOps[operation]; ok {
+	if op, ok := reduceArithmeticOps[operation]; ok && accumulator.Kind() != KindInstance {
 		return op(accumulator, item)
 	}
 	member, err := exec.getPublicMember(accumulator, operation, Position{})
@@ -2071,6 +2164,46 @@ func (exec *Execution) reduceSendOperation(accumulator Value, operation string,
 	return exec.invokeCallable(member, accumulator, []Value{item}, nil, NewNil(), Position{})
 }
 
+// arrayMemberReject builds reject and its aliases, which return a new array
+// without the elements the block selects. delete_if is a Ruby-style alias. Like
+// the string bang methods, reject! does not mutate the receiver and returns nil
+// when the block selects nothing (nilWhenUnchanged).
+func arrayMemberReject(name string, nilWhenUnchanged bool) Value {
+	return NewAutoBuiltin(name, func(exec *Execution, receiver Value, args []Value, kwargs map[string]Value, block Value) (Value, error) {
+		if len(args) > 0 {
+			return NewNil(), fmt.Errorf("%s does not take arguments", name)
+		}
+		runner, err := newBlockCallRunner(exec, block, name, receiver, nil, kwargs)
+		if err != nil {
+			return NewNil(), err
+		}
+		arr := receiver.Array()
+		out := make([]Value, 0, len(arr))
+		var blockArg [1]Value
+		for _, item := range arr {
+			blockArg[0] = item
+			val, err := runner.call(blockArg[:])
+			if err != nil {
+				return NewNil(), err
+			}
+			if !val.Truthy() {
+				out = append(out, item)
+			}
+		}
+		if nilWhenUnchanged && len(out) == len(arr) {
+			return NewNil(), nil
+		}
+		// A sparse result should not retain a backing array sized to the
+		// whole receiver, so right-size the result.
+		if len(out) < cap(out) {
+			trimmed := make([]Value, len(out))
+			copy(trimmed, out)
+			out = trimmed
+		}
+		return NewArray(out), nil
+	})
+}
+
 // arrayMemberGrep builds array.grep and array.grep_v. Both select elements
 // against a pattern using Ruby's case-equality direction (pattern === element),
 // reusing the same matcher that powers case/when clauses. grep keeps matching
@@ -2100,7 +2233,11 @@ func arrayMemberGrep(property string) (Value, error) {
 		out := make([]Value, 0, len(arr))
 		var blockArg [1]Value
 		for _, item := range arr {
-			if caseCandidateMatches(item, pattern) != keep {
+			matches, err := caseCandidateMatches(item, pattern)
+			if err != nil {
+				return NewNil(), err
+			}
+			if matches != keep {
 				continue
 			}
 			if runner == nil {
@@ -2196,7 +2333,7 @@ func arrayToHash(exec *Execution, receiver Value, args []Value, kwargs map[strin
 
 	out := NewHash(make(map[string]Value, len(arr)))
 	var blockArg [1]Value
-	for _, item := range arr {
+	for i, item := range arr {
 		// Charge a step per element so even the bare form, where runner is nil and
 		// no block statements run, participates in the step quota and observes
 		// cancellation while converting a large receiver. runner.call only charges
@@ -2218,12 +2355,14 @@ func arrayToHash(exec *Execution, receiver Value, args []Value, kwargs map[strin
 			}
 			pair = mapped
 		}
+		// Name the offending index so a malformed element in a long pair list
+		// can be found, as Ruby's "wrong element type ... at N" does.
 		if pair.Kind() != KindArray {
-			return NewNil(), fmt.Errorf("array.to_h expects an array of two-element pairs")
+			return NewNil(), fmt.Errorf("array.to_h expects an array of two-element pairs, got %s at index %d", pair.Kind(), i)
 		}
 		elements := pair.Array()
 		if len(elements) != 2 {
-			return NewNil(), fmt.Errorf("array.to_h pair must have exactly two elements")
+			return NewNil(), fmt.Errorf("array.to_h pair must have exactly two elements, got %d at index %d", len(elements), i)
 		}
 		key, err := canonicalHashKey(elements[0])
 		if err != nil {
@@ -2232,6 +2371,9 @@ func arrayToHash(exec *Execution, receiver Value, args []Value, kwargs map[strin
 		if err := hashSet(out, elements[0], elements[1]); err != nil {
 			return NewNil(), err
 		}
+		if err := exec.checkCollectionSize("array.to_h", out.HashLen()); err != nil {
+			return NewNil(), err
+		}
 		if acc != nil {
 			// The block synthesized both the key and the value, so charge each: the
 			// key string via addSynthesizedKey and the value via add. Both route
@@ -2286,8 +2428,14 @@ type arrayFillSpan struct {
 // matching Ruby, which never consults a block when an explicit fill value is
 // given.
 func arrayFill(exec *Execution, receiver Value, args []Value, kwargs map[string]Value, block Value) (Value, error) {
+	return fillArray(exec, "array.fill", receiver, args, kwargs, block)
+}
+
+// fillArray implements array.fill under the given method name, which also
+// builds Array.new's result by filling an empty receiver.
+func fillArray(exec *Execution, method string, receiver Value, args []Value, kwargs map[string]Value, block Value) (Value, error) {
 	if len(kwargs) > 0 {
-		return NewNil(), fmt.Errorf("array.fill does not take keyword arguments")
+		return NewNil(), fmt.Errorf("%s does not take keyword arguments", method)
 	}
 	arr := receiver.Array()
 	hasBlock := valueBlock(block) != nil
@@ -2300,7 +2448,7 @@ func arrayFill(exec *Execution, receiver Value, args []Value, kwargs map[string]
 		selectors = args
 	} else {
 		if len(args) == 0 {
-			return NewNil(), fmt.Errorf("array.fill requires a value or a block")
+			return NewNil(), fmt.Errorf("%s requires a value or a block", method)
 		}
 		selectors = args[1:]
 	}
@@ -2313,13 +2461,16 @@ func arrayFill(exec *Execution, receiver Value, args []Value, kwargs map[string]
 	// Reject an oversized result up front so a window far past the receiver
 	// cannot reserve a huge backing array before the per-element checks below
 	// observe it, mirroring the range materialization guard.
+	if err := exec.checkCollectionSize(method, span.finalLength); err != nil {
+		return NewNil(), err
+	}
 	if err := exec.checkProjectedIntArrayBytes(span.finalLength); err != nil {
 		return NewNil(), err
 	}
 
 	var runner *blockCallRunner
 	if hasBlock {
-		runner, err = newBlockCallRunner(exec, block, "array.fill", receiver, nil, kwargs)
+		runner, err = newBlockCallRunner(exec, block, method, receiver, nil, kwargs)
 		if err != nil {
 			return NewNil(), err
 		}
@@ -2648,6 +2799,8 @@ func arrayMemberTransforms(property string) (Value, error) {
 		return NewAutoBuiltin("array.shift", arrayShift), nil
 	case "delete":
 		return NewAutoBuiltin("array.delete", arrayDelete), nil
+	case "delete_at":
+		return NewAutoBuiltin("array.delete_at", arrayDeleteAt), nil
 	case "insert":
 		return NewAutoBuiltin("array.insert", arrayInsert), nil
 	case "uniq":
@@ -2670,6 +2823,22 @@ func arrayMemberTransforms(property string) (Value, error) {
 			}
 			return NewArray(unionArrayValues(receiver.Array(), others)), nil
 		}), nil
+	case "concat":
+		return NewAutoBuiltin("array.concat", func(exec *Execution, receiver Value, args []Value, kwargs map[string]Value, block Value) (Value, error) {
+			others, err := arrayArgsToSlices("array.concat", args, kwargs)
+			if err != nil {
+				return NewNil(), err
+			}
+			return NewArray(concatArrayValues(receiver.Array(), others)), nil
+		}), nil
+	case "intersection":
+		return NewAutoBuiltin("array.intersection", func(exec *Execution, receiver Value, args []Value, kwargs map[string]Value, block Value) (Value, error) {
+			others, err := arrayArgsToSlices("array.intersection", args, kwargs)
+			if err != nil {
+				return NewNil(), err
+			}
+			return NewArray(intersectionArrayValues(receiver.Array(), others)), nil
+		}), nil
 	case "difference":
 		return NewAutoBuiltin("array.difference", func(exec *Execution, receiver Value, args []Value, kwargs map[string]Value, block Value) (Value, error) {
 			others, err := arrayArgsToSlices("array.difference", args, kwargs)
@@ -2793,6 +2962,9 @@ func arrayMemberTransforms(property string) (Value, error) {
 			if len(arr)%size != 0 {
 				chunkCapacity++
 			}
+			if err := exec.checkCollectionSize("array.chunk", chunkCapacity); err != nil {
+				return NewNil(), err
+			}
 			chunks := make([]Value, 0, chunkCapacity)
 			for i := 0; i < len(arr); i += size {
 				end := min(i+size, len(arr))
@@ -2817,6 +2989,9 @@ func arrayMemberTransforms(property string) (Value, error) {
 			if size > len(arr) {
 				return NewArray([]Value{}), nil
 			}
+			if err := exec.checkCollectionSize("array.window", len(arr)-size+1); err != nil {
+				return NewNil(), err
+			}
 			windows := make([]Value, 0, len(arr)-size+1)
 			for i := range len(arr) - size + 1 {
 				part := make([]Value, size)
@@ -3074,7 +3249,11 @@ func arrayDelete(exec *Execution, receiver Value, args []Value, kwargs map[strin
 		if err := exec.step(); err != nil {
 			return NewNil(), err
 		}
-		if item.Equal(target) {
+		equal, err := exec.valuesEqual(item, target)
+		if err != nil {
+			return NewNil(), err
+		}
+		if equal {
 			found = true
 			// Track the matched element itself so the result reports the stored
 			// object rather than the caller's search argument. Ruby's Array#delete
@@ -3112,6 +3291,53 @@ func arrayDelete(exec *Execution, receiver Value, args []Value, kwargs map[strin
 	}), nil
 }
 
+// arrayDeleteAt implements Ruby's Array#delete_at without mutating the
+// receiver. It returns { array:, deleted: } like array.delete, where deleted is
+// the element removed from index. A negative index counts back from the end,
+// and an index outside the array leaves it unchanged with a nil deleted.
+func arrayDeleteAt(exec *Execution, receiver Value, args []Value, kwargs map[string]Value, block Value) (Value, error) {
+	if len(kwargs) > 0 {
+		return NewNil(), fmt.Errorf("array.delete_at does not take keyword arguments")
+	}
+	if !block.IsNil() {
+		return NewNil(), fmt.Errorf("array.delete_at does not accept blocks")
+	}
+	if len(args) != 1 {
+		return NewNil(), fmt.Errorf("array.delete_at expects an index")
+	}
+	if args[0].Kind() != KindInt {
+		return NewNil(), fmt.Errorf("array.delete_at index must be integer")
+	}
+	arr := receiver.Array()
+	index := args[0].Int()
+	if index < 0 {
+		index += int64(len(arr))
+	}
+	inRange := index >= 0 && index < int64(len(arr))
+	remainingLen := len(arr)
+	if inRange {
+		remainingLen--
+	}
+	if err := newArrayBuildAccumulator(exec, receiver, args, kwargs, block).reserveSlotArrays(remainingLen); err != nil {
+		return NewNil(), err
+	}
+	// Copy rather than reslice so index assignment on the result cannot reach
+	// the receiver's backing array.
+	remaining := make([]Value, 0, remainingLen)
+	deleted := NewNil()
+	if inRange {
+		remaining = append(remaining, arr[:index]...)
+		remaining = append(remaining, arr[index+1:]...)
+		deleted = arr[index]
+	} else {
+		remaining = append(remaining, arr...)
+	}
+	return NewHash(map[string]Value{
+		"array":   NewArray(remaining),
+		"deleted": deleted,
+	}), nil
+}
+
 // arrayInsert implements Ruby's Array#insert, returning a new array with the
 // given values inserted before the element at index. Vibescript's collections are
 // non-mutating, so it returns the new array rather than the receiver.
@@ -3200,3 +3426,55 @@ func arrayInsertBuildResult(exec *Execution, receiver Value, args []Value, kwarg
 	}
 	return NewArray(out), nil
 }
+
+// arrayBsearch binary-searches a sorted array the way Ruby's Array#bsearch
+// does. A block returning true, false, or nil selects find-minimum mode and
+// yields the first element for which it is true. A block returning a number
+// selects find-any mode: zero means found, positive means the target lies to
+// the right, and negative means it lies to the left. Either way the result is
+// nil when nothing matches.
+func arrayBsearch(exec *Execution, receiver Value, args []Value, kwargs map[string]Value, block Value) (Value, error) {
+	if len(args) > 0 {
+		return NewNil(), fmt.Errorf("array.bsearch does not take arguments")
+	}
+	if len(kwargs) > 0 {
+		return NewNil(), fmt.Errorf("array.bsearch does not take keyword arguments")
+	}
+	runner, err := newBlockCallRunner(exec, block, "array.bsearch", receiver, nil, kwargs)
+	if err != nil {
+		return NewNil(), err
+	}
+	arr := receiver.Array()
+	found := NewNil()
+	var blockArg [1]Value
+	for lo, hi := 0, len(arr); lo < hi; {
+		mid := lo + (hi-lo)/2
+		blockArg[0] = arr[mid]
+		result, err := runner.call(blockArg[:])
+		if err != nil {
+			return NewNil(), err
+		}
+		switch result.Kind() {
+		case KindBool, KindNil:
+			if result.Truthy() {
+				found = arr[mid]
+				hi = mid
+			} else {
+				lo = mid + 1
+			}
+		case KindInt, KindFloat:
+			cmp, _ := sortComparisonResult(result)
+			switch {
+			case cmp == 0:
+				return arr[mid], nil
+			case cmp > 0:
+				lo = mid + 1
+			default:
+				hi = mid
+			}
+		default:
+			return NewNil(), fmt.Errorf("array.bsearch block must return true, false, nil, or a number, got %s", result.Kind())
+		}
+	}
+	return found, nil
+}
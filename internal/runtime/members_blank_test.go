@@ -0,0 +1,89 @@
+package runtime
+
+import "testing"
+
+func TestBlankAndPresentAcrossKinds(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name  string
+		expr  string
+		blank bool
+	}{
+		{name: "nil", expr: `nil`, blank: true},
+		{name: "false", expr: `false`, blank: true},
+		{name: "true", expr: `true`, blank: false},
+		{name: "empty string", expr: `""`, blank: true},
+		{name: "whitespace string", expr: `"  \t\n"`, blank: true},
+		{name: "string", expr: `" x "`, blank: false},
+		{name: "empty array", expr: `[]`, blank: true},
+		{name: "array of nil", expr: `[nil]`, blank: false},
+		{name: "empty hash", expr: `{}`, blank: true},
+		{name: "hash", expr: `{ a: 1 }`, blank: false},
+		{name: "zero", expr: `0`, blank: false},
+		{name: "float zero", expr: `0.0`, blank: false},
+		{name: "symbol", expr: `:ready`, blank: false},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			script := compileScript(t, `
+def run
+  value = `+tc.expr+`
+  [value.blank?, value.present?, blank?(value), present?(value)]
+end
+`)
+			got := callFunc(t, script, "run", nil)
+			compareArrays(t, got, []Value{
+				NewBool(tc.blank),
+				NewBool(!tc.blank),
+				NewBool(tc.blank),
+				NewBool(!tc.blank),
+			})
+		})
+	}
+}
+
+func TestBlankPredicateShadowing(t *testing.T) {
+	t.Parallel()
+
+	script := compileScript(t, `
+class Slot
+  def initialize(items)
+    @items = items
+  end
+
+  def blank?
+    @items.size < 2
+  end
+end
+
+def run
+  slot = Slot.new([1])
+  [slot.blank?, Slot.new([1, 2]).blank?, { "blank?" => true }.blank?]
+end
+`)
+	got := callFunc(t, script, "run", nil)
+	compareArrays(t, got, []Value{NewBool(true), NewBool(false), NewBool(false)})
+}
+
+func TestBlankPredicateErrors(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name   string
+		script string
+		want   string
+	}{
+		{name: "member argument", script: `def run; "".blank?(1); end`, want: "string.blank? does not take arguments"},
+		{name: "top-level arity", script: `def run; present?(); end`, want: "present? expects a single value argument"},
+		{name: "top-level block", script: `def run; blank?(1) { |x| x }; end`, want: "blank? does not accept blocks"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			requireCallErrorContains(t, compileScript(t, tc.script), "run", nil, CallOptions{}, tc.want)
+		})
+	}
+}
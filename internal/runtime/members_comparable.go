@@ -0,0 +1,97 @@
+package runtime
+
+import "fmt"
+
+// orderedBetween implements between?(min, max) for every kind compareValueOrder
+// can order: it reports min <= receiver <= max. Like the relational operators,
+// an unordered (NaN) comparison makes the predicate false, while bounds of a
+// different kind or money in another currency raise an error.
+func orderedBetween(method string, receiver Value, args []Value, kwargs map[string]Value, block Value) (Value, error) {
+	if len(kwargs) > 0 {
+		return NewNil(), fmt.Errorf("%s does not take keyword arguments", method)
+	}
+	if !block.IsNil() {
+		return NewNil(), fmt.Errorf("%s does not accept blocks", method)
+	}
+	if len(args) != 2 {
+		return NewNil(), fmt.Errorf("%s expects min and max", method)
+	}
+	below, ordered, err := compareOrderedBound(method, receiver, args[0])
+	if err != nil || !ordered || below < 0 {
+		return NewBool(false), err
+	}
+	above, ordered, err := compareOrderedBound(method, receiver, args[1])
+	if err != nil || !ordered {
+		return NewBool(false), err
+	}
+	return NewBool(above <= 0), nil
+}
+
+// orderedClamp implements clamp for the ordered kinds without a dedicated
+// numeric or string clamp (money, durations, and times). It accepts min and
+// max, either of which may be nil to leave that side open. Ranges only hold
+// integers, so the range form is rejected rather than comparing the receiver
+// with integer bounds.
+func orderedClamp(method string, receiver Value, args []Value, kwargs map[string]Value, block Value) (Value, error) {
+	if len(kwargs) > 0 {
+		return NewNil(), fmt.Errorf("%s does not take keyword arguments", method)
+	}
+	if !block.IsNil() {
+		return NewNil(), fmt.Errorf("%s does not accept blocks", method)
+	}
+	var minVal, maxVal Value
+	switch len(args) {
+	case 1:
+		if args[0].Kind() == KindRange {
+			return NewNil(), fmt.Errorf("%s(range) is only supported for numbers", method)
+		}
+		return NewNil(), fmt.Errorf("%s expects min and max", method)
+	case 2:
+		minVal, maxVal = args[0], args[1]
+	default:
+		return NewNil(), fmt.Errorf("%s expects min and max", method)
+	}
+	if !minVal.IsNil() && !maxVal.IsNil() {
+		cmp, _, err := compareOrderedBound(method, minVal, maxVal)
+		if err != nil {
+			return NewNil(), err
+		}
+		if cmp > 0 {
+			return NewNil(), fmt.Errorf("%s min must be <= max", method)
+		}
+	}
+	if !minVal.IsNil() {
+		cmp, _, err := compareOrderedBound(method, receiver, minVal)
+		if err != nil {
+			return NewNil(), err
+		}
+		if cmp < 0 {
+			return minVal, nil
+		}
+	}
+	if !maxVal.IsNil() {
+		cmp, _, err := compareOrderedBound(method, receiver, maxVal)
+		if err != nil {
+			return NewNil(), err
+		}
+		if cmp > 0 {
+			return maxVal, nil
+		}
+	}
+	return receiver, nil
+}
+
+// compareOrderedBound orders left against right via compareValueOrder and
+// rewrites an incomparable pair into an error naming the method, so a bound
+// of the wrong kind or currency reads as a bad argument rather than a bare
+// comparison failure.
+func compareOrderedBound(method string, left, right Value) (int, bool, error) {
+	order, ordered, err := compareValueOrder(left, right)
+	if err == nil {
+		return order, ordered, nil
+	}
+	if !isIncomparable(err) {
+		return 0, false, err
+	}
+	return 0, false, fmt.Errorf("%s %s", method, incomparablePairText(left, right))
+}
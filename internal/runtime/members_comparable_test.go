@@ -0,0 +1,72 @@
+package runtime
+
+import "testing"
+
+func TestOrderedBetweenAndClamp(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		expr string
+		want Value
+	}{
+		{name: "int between", expr: `5.between?(1, 10)`, want: NewBool(true)},
+		{name: "int between inclusive", expr: `10.between?(1, 10)`, want: NewBool(true)},
+		{name: "int between mixed float", expr: `5.between?(5.5, 10)`, want: NewBool(false)},
+		{name: "float between nan", expr: `(0.0 / 0.0).between?(0, 1)`, want: NewBool(false)},
+		{name: "string between", expr: `"m".between?("a", "z")`, want: NewBool(true)},
+		{name: "string outside", expr: `"zz".between?("a", "z")`, want: NewBool(false)},
+		{name: "money between", expr: `money("5.00 USD").between?(money("1.00 USD"), money("9.99 USD"))`, want: NewBool(true)},
+		{name: "money outside", expr: `money("15.00 USD").between?(money("1.00 USD"), money("9.99 USD"))`, want: NewBool(false)},
+		{name: "duration between", expr: `90.seconds.between?(1.minute, 2.minutes)`, want: NewBool(true)},
+		{name: "time between", expr: `Time.utc(2024, 6, 1).between?(Time.utc(2024, 1, 1), Time.utc(2024, 12, 31))`, want: NewBool(true)},
+		{name: "string clamp", expr: `"zebra".clamp("apple", "mango")`, want: NewString("mango")},
+		{name: "money clamp low", expr: `money("0.50 USD").clamp(money("1.00 USD"), money("9.99 USD"))`, want: mustMoneyValue(t, "1.00 USD")},
+		{name: "money clamp high", expr: `money("12.00 USD").clamp(money("1.00 USD"), money("9.99 USD"))`, want: mustMoneyValue(t, "9.99 USD")},
+		{name: "money clamp inside", expr: `money("4.25 USD").clamp(money("1.00 USD"), money("9.99 USD"))`, want: mustMoneyValue(t, "4.25 USD")},
+		{name: "money clamp open max", expr: `money("12.00 USD").clamp(money("1.00 USD"), nil)`, want: mustMoneyValue(t, "12.00 USD")},
+		{name: "duration clamp", expr: `3.hours.clamp(1.minute, 1.hour).seconds`, want: NewInt(3600)},
+		{name: "time clamp", expr: `Time.utc(2023, 1, 1).clamp(Time.utc(2024, 1, 1), Time.utc(2024, 12, 31)).year`, want: NewInt(2024)},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			script := compileScript(t, "def run()\n  "+tc.expr+"\nend")
+			got := callFunc(t, script, "run", nil)
+			if !got.Equal(tc.want) {
+				t.Fatalf("%s = %v, want %v", tc.expr, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestOrderedBetweenAndClampErrors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		expr string
+		want string
+	}{
+		{name: "between kind mismatch", expr: `"m".between?(1, 10)`, want: "string.between? cannot compare string with int"},
+		{name: "between currency mismatch", expr: `money("5.00 USD").between?(money("1.00 EUR"), money("9.00 EUR"))`, want: "money.between? cannot compare USD money with EUR money"},
+		{name: "between arity", expr: `5.between?(1)`, want: "int.between? expects min and max"},
+		{name: "clamp currency mismatch", expr: `money("5.00 USD").clamp(money("1.00 EUR"), money("9.00 EUR"))`, want: "money.clamp cannot compare USD money with EUR money"},
+		{name: "clamp bound kind", expr: `money("5.00 USD").clamp(1, 10)`, want: "money.clamp cannot compare money with int"},
+		{name: "clamp inverted bounds", expr: `money("5.00 USD").clamp(money("9.00 USD"), money("1.00 USD"))`, want: "money.clamp min must be <= max"},
+		{name: "clamp range", expr: `1.hour.clamp(1..10)`, want: "duration.clamp(range) is only supported for numbers"},
+		{name: "clamp money range", expr: `money("5.00 USD").clamp(1..10)`, want: "money.clamp(range) is only supported for numbers"},
+		{name: "clamp time range", expr: `Time.utc(2024, 1, 1).clamp(1...10)`, want: "time.clamp(range) is only supported for numbers"},
+		{name: "clamp single bound", expr: `1.hour.clamp(2.hours)`, want: "duration.clamp expects min and max"},
+		{name: "clamp arity", expr: `Time.utc(2024, 1, 1).clamp()`, want: "time.clamp expects min and max"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			script := compileScript(t, "def run()\n  "+tc.expr+"\nend")
+			requireCallErrorContains(t, script, "run", nil, CallOptions{}, tc.want)
+		})
+	}
+}
@@ -0,0 +1,91 @@
+package runtime
+
+import "testing"
+
+func TestDurationPlusMinus(t *testing.T) {
+	t.Parallel()
+
+	script := compileScript(t, `
+def plus
+  2.hours.plus(4.seconds)
+end
+
+def minus
+  2.hours.minus(4.seconds)
+end
+
+def minus_below_zero
+  4.seconds.minus(10.seconds)
+end
+
+def matches_operators
+  a = 90.minutes
+  b = 45.seconds
+  [a.plus(b) == a + b, a.minus(b) == a - b]
+end
+
+def arithmetic
+  {
+    add: 4.seconds + 2.hours,
+    subtract: 2.hours - 4.seconds,
+    multiply: 10.seconds * 3,
+    multiply_left: 3 * 10.seconds,
+    divide: 10.seconds / 2,
+    ratio: 10.seconds / 4.seconds
+  }
+end
+`)
+
+	durationCases := []struct {
+		fn   string
+		want int64
+	}{
+		{fn: "plus", want: 7204},
+		{fn: "minus", want: 7196},
+		{fn: "minus_below_zero", want: -6},
+	}
+	for _, tc := range durationCases {
+		got := callFunc(t, script, tc.fn, nil)
+		if got.Kind() != KindDuration || got.Duration().Seconds() != tc.want {
+			t.Fatalf("%s = %v, want %ds duration", tc.fn, got, tc.want)
+		}
+	}
+
+	compareArrays(t, callFunc(t, script, "matches_operators", nil), []Value{NewBool(true), NewBool(true)})
+
+	got := callFunc(t, script, "arithmetic", nil).Hash()
+	wantSeconds := map[string]int64{"add": 7204, "subtract": 7196, "multiply": 30, "multiply_left": 30, "divide": 5}
+	for key, want := range wantSeconds {
+		if got[key].Kind() != KindDuration || got[key].Duration().Seconds() != want {
+			t.Fatalf("%s = %v, want %ds duration", key, got[key], want)
+		}
+	}
+	if ratio := got["ratio"]; ratio.Kind() != KindFloat || ratio.Float() != 2.5 {
+		t.Fatalf("ratio = %v, want 2.5", ratio)
+	}
+}
+
+func TestDurationPlusMinusErrors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		expr string
+		want string
+	}{
+		{name: "plus numeric", expr: `1.hour.plus(30)`, want: "duration.plus expects a duration"},
+		{name: "minus time", expr: `1.hour.minus(Time.utc(2024, 1, 1))`, want: "duration.minus expects a duration"},
+		{name: "plus arity", expr: `1.hour.plus()`, want: "duration.plus expects 1 argument, got 0"},
+		{name: "minus keyword", expr: `1.hour.minus(1.second, clamp: true)`, want: "duration.minus does not accept keyword arguments"},
+		{name: "plus overflow", expr: `Duration.build(9223372036854775807).plus(1.second)`, want: "duration addition"},
+		{name: "minus overflow", expr: `Duration.build(-9223372036854775807 - 1).minus(1.second)`, want: "duration subtraction"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			script := compileScript(t, "def run()\n  "+tc.expr+"\nend")
+			requireCallErrorContains(t, script, "run", nil, CallOptions{}, tc.want)
+		})
+	}
+}
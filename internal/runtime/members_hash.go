@@ -12,7 +12,7 @@ import (
 // listed name resolves.
 var hashMemberNames = []string{
 	"size", "length", "empty?", "key?", "has_key?", "member?", "include?", "value?", "has_value?", "keys", "values", "values_at", "fetch", "fetch_values", "dig", "each", "each_with_index", "each_key", "each_value", "to_a", "default", "default_proc",
-	"merge", "update", "merge!", "replace", "store", "delete", "slice", "except", "flatten", "select", "reject", "map_with_index", "transform_keys", "deep_transform_keys", "remap_keys", "transform_values", "compact",
+	"merge", "update", "merge!", "replace", "store", "delete", "slice", "except", "flatten", "select", "reject", "filter_map", "map_with_index", "transform_keys", "deep_transform_keys", "remap_keys", "transform_values", "transform_entries", "compact",
 	"inspect",
 }
 
@@ -59,7 +59,7 @@ func hashMemberBuiltin(property string) (Value, error) {
 	switch property {
 	case "size", "length", "empty?", "key?", "has_key?", "member?", "include?", "value?", "has_value?", "keys", "values", "values_at", "fetch", "fetch_values", "dig", "each", "each_with_index", "each_key", "each_value", "to_a", "default", "default_proc":
 		return hashMemberQuery(property)
-	case "merge", "update", "merge!", "replace", "store", "delete", "slice", "except", "flatten", "select", "reject", "map_with_index", "transform_keys", "deep_transform_keys", "remap_keys", "transform_values", "compact":
+	case "merge", "update", "merge!", "replace", "store", "delete", "slice", "except", "flatten", "select", "reject", "filter_map", "map_with_index", "transform_keys", "deep_transform_keys", "remap_keys", "transform_values", "transform_entries", "compact":
 		return hashMemberTransforms(property)
 	case "inspect":
 		return newInspectBuiltin("hash"), nil
@@ -118,6 +118,9 @@ func formatMissingHashKey(key Value) string {
 	}
 }
 
+// sortedHashKeysInto sorts the keys of a string-keyed hash. Those keys all
+// surface as symbols, so plain string order is the value.SortHashEntries
+// order the typed path uses and both kinds of hash iterate alike.
 func sortedHashKeysInto(entries map[string]Value, buf []string) []string {
 	keys := buf[:0]
 	if cap(keys) < len(entries) {
@@ -132,38 +135,10 @@ func sortedHashKeysInto(entries map[string]Value, buf []string) []string {
 
 func sortedTypedHashEntriesInto(receiver Value, buf []HashEntry) []HashEntry {
 	entries := receiver.HashEntriesInto(buf)
-	sort.SliceStable(entries, func(i, j int) bool {
-		return hashEntrySortKey(entries[i].Key) < hashEntrySortKey(entries[j].Key)
-	})
+	sortHashEntries(entries)
 	return entries
 }
 
-func hashEntrySortKey(key Value) string {
-	switch key.Kind() {
-	case KindNil:
-		return "0:nil"
-	case KindBool:
-		if key.Bool() {
-			return "1:true"
-		}
-		return "1:false"
-	case KindInt:
-		return fmt.Sprintf("2:%020d", key.Int())
-	case KindFloat:
-		return "3:" + key.Inspect()
-	case KindString:
-		return "4:" + key.String()
-	case KindSymbol:
-		return "5:" + key.String()
-	case KindArray:
-		return "6:" + key.Inspect()
-	case KindRange:
-		return "7:" + key.Inspect()
-	default:
-		return "8:" + key.Inspect()
-	}
-}
-
 // sortedKeyBufferBytes returns the heap bytes sortedHashKeysInto allocates to
 // hold a sorted key list for keyCount keys. A count that fits the inline stack
 // buffer reuses it and allocates nothing; a larger count heaps a fresh []string
@@ -220,6 +195,85 @@ func typedHashTransformBufferBytes(outputEntries, scratchBytes int) int {
 	return saturatingAdd(hashTransformBufferBytes(outputEntries, scratchBytes), typedHashEntryMapBytes(outputEntries))
 }
 
+// hashTransformEntries implements hash.transform_entries, which yields each
+// key and value in sorted key order and builds a new hash from the
+// [new_key, new_value] pair the block returns. Later pairs overwrite earlier
+// ones when the block maps two entries onto the same key.
+func hashTransformEntries(exec *Execution, receiver Value, args []Value, kwargs map[string]Value, block Value) (Value, error) {
+	if len(args) > 0 {
+		return NewNil(), fmt.Errorf("hash.transform_entries does not take arguments")
+	}
+	if err := ensureBlock(block, "hash.transform_entries"); err != nil {
+		return NewNil(), err
+	}
+	count := receiver.HashLen()
+	// Reserve the output map and entry scratch before building the runner so
+	// its bind-charge baseline includes them, as transform_keys does. The block
+	// synthesizes both the key and the value, so the accumulator charges each
+	// incrementally and the temporary pair through checkTransient.
+	delta := exec.reserveLoopScratch(typedHashTransformBufferBytes(count, sortedHashEntryBufferBytes(count)))
+	defer exec.releaseLoopScratch(delta)
+	if err := exec.checkReservedLoopScratch(receiver, args, kwargs, block); err != nil {
+		return NewNil(), err
+	}
+	runner, err := newBlockCallRunner(exec, block, "hash.transform_entries", receiver, nil, kwargs)
+	if err != nil {
+		return NewNil(), err
+	}
+	acc := newHashBuildAccumulator(exec, receiver, args, kwargs, block)
+
+	var entryBuf [smallHashKeyBufferSize]HashEntry
+	var entries []HashEntry
+	if hashHasTypedEntries(receiver) {
+		entries = sortedTypedHashEntriesInto(receiver, entryBuf[:])
+	} else {
+		legacy := receiver.Hash()
+		var keyBuf [smallHashKeyBufferSize]string
+		entries = entryBuf[:0]
+		for _, key := range sortedHashKeysInto(legacy, keyBuf[:]) {
+			entries = append(entries, HashEntry{Key: NewSymbol(key), Value: legacy[key]})
+		}
+	}
+
+	out := NewHash(make(map[string]Value, count))
+	var blockArgs [2]Value
+	for _, entry := range entries {
+		if err := exec.step(); err != nil {
+			return NewNil(), err
+		}
+		blockArgs[0] = entry.Key
+		blockArgs[1] = entry.Value
+		pair, err := runner.call(blockArgs[:])
+		if err != nil {
+			return NewNil(), err
+		}
+		if err := exec.checkContext(); err != nil {
+			return NewNil(), err
+		}
+		if pair.Kind() != KindArray || len(pair.Array()) != 2 {
+			return NewNil(), fmt.Errorf("hash.transform_entries block must return a [key, value] pair")
+		}
+		if err := acc.checkTransient(pair); err != nil {
+			return NewNil(), err
+		}
+		nextKey, nextValue := pair.Array()[0], pair.Array()[1]
+		lookupKey, err := hashLookupKey(nextKey)
+		if err != nil {
+			return NewNil(), fmt.Errorf("hash.transform_entries block returned unsupported hash key: %w", err)
+		}
+		if err := hashSet(out, nextKey, nextValue); err != nil {
+			return NewNil(), fmt.Errorf("hash.transform_entries block returned unsupported hash key: %w", err)
+		}
+		if err := acc.addTypedSynthesizedKey(nextKey, hashDisplayKey(nextKey), lookupKey); err != nil {
+			return NewNil(), err
+		}
+		if err := acc.add(nextValue); err != nil {
+			return NewNil(), err
+		}
+	}
+	return out, nil
+}
+
 func deepTransformArrayBufferBytes(count int) int {
 	return saturatingAdd(estimatedValueBytes+estimatedSliceBaseBytes, saturatingMul(count, estimatedValueBytes))
 }
@@ -1445,6 +1499,11 @@ func hashMemberTransforms(property string) (Value, error) {
 						if err != nil {
 							return NewNil(), err
 						}
+						if !conflict {
+							if err := exec.checkCollectionSize("hash."+name, out.HashLen()+1); err != nil {
+								return NewNil(), err
+							}
+						}
 						if !conflict || !useBlock {
 							if err := hashSet(out, entry.Key, entry.Value); err != nil {
 								return NewNil(), err
@@ -1633,6 +1692,11 @@ func hashMemberTransforms(property string) (Value, error) {
 						return NewNil(), err
 					}
 					oldValue, conflict := out[key]
+					if !conflict {
+						if err := exec.checkCollectionSize("hash."+name, len(out)+1); err != nil {
+							return NewNil(), err
+						}
+					}
 					if !conflict || !useBlock {
 						// A non-conflict addition stores an argument value directly.
 						// Its payload is already counted in the call roots (acc.base)
@@ -2258,6 +2322,8 @@ func hashMemberTransforms(property string) (Value, error) {
 			}
 			return NewHash(out), nil
 		}), nil
+	case "filter_map":
+		return NewAutoBuiltin("hash.filter_map", hashFilterMap), nil
 	case "map_with_index":
 		return NewAutoBuiltin("hash.map_with_index", func(exec *Execution, receiver Value, args []Value, kwargs map[string]Value, block Value) (Value, error) {
 			if len(args) > 0 {
@@ -2663,6 +2729,8 @@ func hashMemberTransforms(property string) (Value, error) {
 			}
 			return NewHash(out), nil
 		}), nil
+	case "transform_entries":
+		return NewAutoBuiltin("hash.transform_entries", hashTransformEntries), nil
 	case "compact":
 		return NewAutoBuiltin("hash.compact", func(exec *Execution, receiver Value, args []Value, kwargs map[string]Value, block Value) (Value, error) {
 			if len(args) > 0 {
@@ -2709,3 +2777,65 @@ func hashMemberTransforms(property string) (Value, error) {
 		return NewNil(), fmt.Errorf("unknown hash method %s", property)
 	}
 }
+
+// hashFilterMap yields each (key, value) pair in sorted key order and collects
+// the truthy block results into an array, the hash counterpart of
+// array.filter_map. Like select, it skips results that are falsy under
+// Vibescript's Truthy model.
+func hashFilterMap(exec *Execution, receiver Value, args []Value, kwargs map[string]Value, block Value) (Value, error) {
+	if len(args) > 0 {
+		return NewNil(), fmt.Errorf("hash.filter_map does not take arguments")
+	}
+	if len(kwargs) > 0 {
+		return NewNil(), fmt.Errorf("hash.filter_map does not take keyword arguments")
+	}
+	runner, err := newBlockCallRunner(exec, block, "hash.filter_map", receiver, nil, kwargs)
+	if err != nil {
+		return NewNil(), err
+	}
+	acc := newArrayBuildAccumulator(exec, receiver, args, kwargs, block)
+	out := make([]Value, 0)
+	var blockArgs [2]Value
+	yield := func(key, value Value) error {
+		if err := exec.step(); err != nil {
+			return err
+		}
+		blockArgs[0] = key
+		blockArgs[1] = value
+		val, err := runner.call(blockArgs[:])
+		if err != nil {
+			return err
+		}
+		if err := exec.checkContext(); err != nil {
+			return err
+		}
+		if !val.Truthy() {
+			return nil
+		}
+		out = append(out, val)
+		return acc.addConservative(val, cap(out))
+	}
+	if hashHasTypedEntries(receiver) {
+		if err := acc.reserveScratch(sortedHashEntryBufferBytes(receiver.HashLen())); err != nil {
+			return NewNil(), err
+		}
+		var entryBuf [smallHashKeyBufferSize]HashEntry
+		for _, entry := range sortedTypedHashEntriesInto(receiver, entryBuf[:]) {
+			if err := yield(entry.Key, entry.Value); err != nil {
+				return NewNil(), err
+			}
+		}
+		return NewArray(out), nil
+	}
+	entries := receiver.Hash()
+	if err := acc.reserveScratch(sortedKeyBufferBytes(len(entries))); err != nil {
+		return NewNil(), err
+	}
+	var keyBuf [smallHashKeyBufferSize]string
+	for _, key := range sortedHashKeysInto(entries, keyBuf[:]) {
+		if err := yield(NewSymbol(key), entries[key]); err != nil {
+			return NewNil(), err
+		}
+	}
+	return NewArray(out), nil
+}
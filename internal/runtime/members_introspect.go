@@ -75,12 +75,11 @@ func newRespondToBuiltin(callerIsReceiver bool) Value {
 	})
 }
 
-// newClassPredicateBuiltin builds an is_a?/kind_of?/instance_of? predicate, each
-// distinguished by name only. All three currently test direct class identity:
-// an instance belongs to exactly its own class. Vibescript has no inheritance,
-// so is_a?/kind_of? (ancestry) and instance_of? (exact class) coincide; when a
-// superclass chain is added, is_a?/kind_of? will additionally walk it.
+// newClassPredicateBuiltin builds an is_a?/kind_of?/instance_of? predicate.
+// is_a? and kind_of? accept the instance's class or any of its ancestors,
+// while instance_of? requires the exact class.
 func newClassPredicateBuiltin(name string) Value {
+	exact := name == "instance_of?"
 	return NewAutoBuiltin(name, func(exec *Execution, receiver Value, args []Value, kwargs map[string]Value, block Value) (Value, error) {
 		if len(kwargs) > 0 {
 			return NewNil(), fmt.Errorf("%s does not take keyword arguments", name)
@@ -98,7 +97,11 @@ func newClassPredicateBuiltin(name string) Value {
 		if receiver.Kind() != KindInstance {
 			return NewBool(false), nil
 		}
-		return NewBool(valueInstance(receiver).Class == want), nil
+		class := valueInstance(receiver).Class
+		if exact {
+			return NewBool(class == want), nil
+		}
+		return NewBool(class.inherits(want)), nil
 	})
 }
 
@@ -239,7 +242,7 @@ func instanceRespondsTo(inst *Instance, method string, allowPrivate bool) bool {
 	if method == "class" {
 		return true
 	}
-	if fn, ok := inst.Class.Methods[method]; ok {
+	if fn, ok := inst.Class.findMethod(method); ok {
 		return allowPrivate || !fn.Private
 	}
 	if isUniversalMember(method) {
@@ -261,7 +264,7 @@ func classRespondsTo(cl *ClassDef, method string, allowPrivate bool) bool {
 	if method == "new" {
 		return true
 	}
-	if fn, ok := cl.ClassMethods[method]; ok {
+	if fn, ok := cl.findClassMethod(method); ok {
 		return allowPrivate || !fn.Private
 	}
 	if isUniversalMember(method) {
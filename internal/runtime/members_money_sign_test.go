@@ -0,0 +1,55 @@
+package runtime
+
+import "testing"
+
+func TestMoneySignMembers(t *testing.T) {
+	t.Parallel()
+
+	script := compileScript(t, `
+def refund(charged, refunded)
+  net = charged - refunded
+  [net.format, net.abs.format, net.negative?, net.positive?, net.zero?]
+end
+
+def settled
+  m = money("4.00 USD") - money("4.00 USD")
+  [m.zero?, m.positive?, m.negative?, m.abs.format]
+end
+
+def credit
+  m = money("2.50 EUR")
+  [m.abs.format, m.positive?, m.negative?]
+end
+`)
+
+	got := callFunc(t, script, "refund", []Value{mustMoneyValue(t, "5.00 USD"), mustMoneyValue(t, "12.25 USD")})
+	compareArrays(t, got, []Value{
+		NewString("-7.25 USD"),
+		NewString("7.25 USD"),
+		NewBool(true),
+		NewBool(false),
+		NewBool(false),
+	})
+
+	got = callFunc(t, script, "settled", nil)
+	compareArrays(t, got, []Value{NewBool(true), NewBool(false), NewBool(false), NewString("0.00 USD")})
+
+	got = callFunc(t, script, "credit", nil)
+	compareArrays(t, got, []Value{NewString("2.50 EUR"), NewBool(true), NewBool(false)})
+}
+
+func TestMoneySignMembersRejectArguments(t *testing.T) {
+	t.Parallel()
+
+	script := compileScript(t, `
+def abs_arg
+  money("1.00 USD").abs(1)
+end
+
+def zero_arg
+  money("1.00 USD").zero?(true)
+end
+`)
+	requireCallErrorContains(t, script, "abs_arg", nil, CallOptions{}, "money.abs does not take arguments")
+	requireCallErrorContains(t, script, "zero_arg", nil, CallOptions{}, "money.zero? does not take arguments")
+}
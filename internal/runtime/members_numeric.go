@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"math"
 	"math/big"
+	"strconv"
 )
 
 // The *MemberNames lists below mirror the names dispatched by the member
@@ -14,36 +15,36 @@ import (
 var (
 	intMemberNames = []string{
 		"seconds", "second", "minutes", "minute", "hours", "hour", "days", "day", "weeks", "week",
-		"abs", "clamp", "even?", "odd?", "times", "upto", "downto", "step",
+		"abs", "clamp", "between?", "even?", "odd?", "times", "upto", "downto", "step",
 		"zero?", "positive?", "negative?", "nonzero?", "next", "succ", "pred",
 		"round", "floor", "ceil",
 		"div", "divmod", "fdiv", "remainder", "modulo",
-		"to_s", "string", "to_i", "to_f",
+		"to_s", "string", "to_i", "to_f", "with_commas", "bit_length",
 		"inspect",
 	}
 	floatMemberNames = []string{
-		"abs", "clamp", "round", "floor", "ceil",
+		"abs", "clamp", "between?", "round", "floor", "ceil",
 		"zero?", "positive?", "negative?", "nonzero?",
 		"nan?", "infinite?", "finite?",
 		"div", "divmod", "fdiv", "remainder", "modulo",
 		"to_s", "string", "to_i", "to_f",
 		"inspect",
 	}
-	moneyMemberNames = []string{"currency", "cents", "amount", "format", "to_s", "string"}
+	moneyMemberNames = []string{"currency", "cents", "amount", "format", "div", "mul", "abs", "clamp", "between?", "zero?", "positive?", "negative?", "to_s", "string"}
 )
 
 var (
 	intBuiltinMemberNames = []string{
-		"abs", "clamp", "even?", "odd?", "times", "upto", "downto", "step",
+		"abs", "clamp", "between?", "even?", "odd?", "times", "upto", "downto", "step",
 		"zero?", "positive?", "negative?", "nonzero?", "next", "succ", "pred",
 		"round", "floor", "ceil",
 		"div", "divmod", "fdiv", "remainder", "modulo",
-		"to_s", "string", "to_i", "to_f",
+		"to_s", "string", "to_i", "to_f", "with_commas", "bit_length",
 		"inspect",
 	}
 	intBuiltinMembers       = newMemberTable(intBuiltinMemberNames)
 	floatBuiltinMembers     = newMemberTable(floatMemberNames)
-	moneyBuiltinMemberNames = []string{"format"}
+	moneyBuiltinMemberNames = []string{"format", "div", "mul", "abs", "clamp", "between?", "zero?", "positive?", "negative?"}
 	moneyBuiltinMembers     = newMemberTable(moneyBuiltinMemberNames)
 )
 
@@ -81,6 +82,10 @@ func intMemberBuiltin(property string) (Value, error) {
 		return NewAutoBuiltin("int.clamp", func(exec *Execution, receiver Value, args []Value, kwargs map[string]Value, block Value) (Value, error) {
 			return numericClamp("int.clamp", receiver, args, kwargs, block)
 		}), nil
+	case "between?":
+		return NewAutoBuiltin("int.between?", func(exec *Execution, receiver Value, args []Value, kwargs map[string]Value, block Value) (Value, error) {
+			return orderedBetween("int.between?", receiver, args, kwargs, block)
+		}), nil
 	case "even?":
 		return NewAutoBuiltin("int.even?", func(exec *Execution, receiver Value, args []Value, kwargs map[string]Value, block Value) (Value, error) {
 			if len(args) > 0 {
@@ -244,7 +249,7 @@ func intMemberBuiltin(property string) (Value, error) {
 			return numericModulo("int.modulo", receiver, divisor)
 		}), nil
 	case "to_s", "string":
-		return newToStringBuiltin("int", property), nil
+		return newIntToStringBuiltin(property), nil
 	case "to_i":
 		return newIntIdentityBuiltin("int.to_i"), nil
 	case "to_f":
@@ -254,6 +259,20 @@ func intMemberBuiltin(property string) (Value, error) {
 			}
 			return NewFloat(float64(receiver.Int())), nil
 		}), nil
+	case "with_commas":
+		return NewAutoBuiltin("int.with_commas", func(exec *Execution, receiver Value, args []Value, kwargs map[string]Value, block Value) (Value, error) {
+			if err := requireNullaryCall("int.with_commas", args, kwargs, block); err != nil {
+				return NewNil(), err
+			}
+			return NewString(groupIntDigits(receiver.Int(), ',')), nil
+		}), nil
+	case "bit_length":
+		return NewAutoBuiltin("int.bit_length", func(exec *Execution, receiver Value, args []Value, kwargs map[string]Value, block Value) (Value, error) {
+			if err := requireNullaryCall("int.bit_length", args, kwargs, block); err != nil {
+				return NewNil(), err
+			}
+			return NewInt(int64(intBitLength(receiver.Int()))), nil
+		}), nil
 	case "inspect":
 		return newInspectBuiltin("int"), nil
 	default:
@@ -261,6 +280,62 @@ func intMemberBuiltin(property string) (Value, error) {
 	}
 }
 
+// newIntToStringBuiltin returns the builtin backing Ruby's Integer#to_s. With
+// no argument it renders decimal digits like the other scalar to_s methods; an
+// optional radix between 2 and 36 renders the integer in that base using
+// lowercase digits, keeping a leading minus sign for negative receivers
+// (`255.to_s(16)` is "ff", `(-5).to_s(2)` is "-101"). property is the invoked
+// name so the `to_s` and `string` aliases each report under the name the
+// script used.
+func newIntToStringBuiltin(property string) Value {
+	name := "int." + property
+	return NewAutoBuiltin(name, func(exec *Execution, receiver Value, args []Value, kwargs map[string]Value, block Value) (Value, error) {
+		if len(args) > 1 {
+			return NewNil(), fmt.Errorf("%s expects at most one radix argument", name)
+		}
+		if err := requireNullaryCall(name, nil, kwargs, block); err != nil {
+			return NewNil(), err
+		}
+		if len(args) == 0 {
+			return NewString(receiver.String()), nil
+		}
+		if args[0].Kind() != KindInt {
+			return NewNil(), fmt.Errorf("%s radix must be an integer", name)
+		}
+		base := args[0].Int()
+		if base < 2 || base > 36 {
+			return NewNil(), fmt.Errorf("%s invalid radix %d", name, base)
+		}
+		return NewString(strconv.FormatInt(receiver.Int(), int(base))), nil
+	})
+}
+
+// groupIntDigits renders n in decimal with sep inserted between each group of
+// three digits counted from the right, so 1234567 becomes "1,234,567". The
+// sign stays in front of the first group.
+func groupIntDigits(n int64, sep byte) string {
+	digits := strconv.FormatInt(n, 10)
+	sign := ""
+	if n < 0 {
+		sign, digits = "-", digits[1:]
+	}
+	if len(digits) <= 3 {
+		return sign + digits
+	}
+	out := make([]byte, 0, len(sign)+len(digits)+(len(digits)-1)/3)
+	out = append(out, sign...)
+	lead := len(digits) % 3
+	if lead == 0 {
+		lead = 3
+	}
+	out = append(out, digits[:lead]...)
+	for i := lead; i < len(digits); i += 3 {
+		out = append(out, sep)
+		out = append(out, digits[i:i+3]...)
+	}
+	return string(out)
+}
+
 // newIntIdentityBuiltin returns the no-argument builtin backing Ruby's
 // Integer#to_i, which returns the receiver unchanged. name identifies the
 // builtin and its argument error.
@@ -293,6 +368,10 @@ func floatMemberBuiltin(property string) (Value, error) {
 		return NewAutoBuiltin("float.clamp", func(exec *Execution, receiver Value, args []Value, kwargs map[string]Value, block Value) (Value, error) {
 			return numericClamp("float.clamp", receiver, args, kwargs, block)
 		}), nil
+	case "between?":
+		return NewAutoBuiltin("float.between?", func(exec *Execution, receiver Value, args []Value, kwargs map[string]Value, block Value) (Value, error) {
+			return orderedBetween("float.between?", receiver, args, kwargs, block)
+		}), nil
 	case "round", "floor", "ceil":
 		mode := roundModeFor(property)
 		name := "float." + property
@@ -890,8 +969,49 @@ func moneyMember(m Money, property string) (Value, error) {
 func moneyMemberBuiltin(property string) (Value, error) {
 	switch property {
 	case "format":
-		return NewAutoBuiltin("money.format", func(exec *Execution, receiver Value, args []Value, kwargs map[string]Value, block Value) (Value, error) {
-			return NewString(receiver.Money().String()), nil
+		return NewAutoBuiltin("money.format", builtinMoneyFormat), nil
+	case "div":
+		return moneyScale("money.div", true), nil
+	case "mul":
+		return moneyScale("money.mul", false), nil
+	case "clamp":
+		return NewAutoBuiltin("money.clamp", func(exec *Execution, receiver Value, args []Value, kwargs map[string]Value, block Value) (Value, error) {
+			return orderedClamp("money.clamp", receiver, args, kwargs, block)
+		}), nil
+	case "between?":
+		return NewAutoBuiltin("money.between?", func(exec *Execution, receiver Value, args []Value, kwargs map[string]Value, block Value) (Value, error) {
+			return orderedBetween("money.between?", receiver, args, kwargs, block)
+		}), nil
+	case "abs":
+		return NewAutoBuiltin("money.abs", func(exec *Execution, receiver Value, args []Value, kwargs map[string]Value, block Value) (Value, error) {
+			if err := requireNullaryCall("money.abs", args, kwargs, block); err != nil {
+				return NewNil(), err
+			}
+			m := receiver.Money()
+			if m.Cents() >= 0 {
+				return receiver, nil
+			}
+			negated, err := m.MulInt(-1)
+			if err != nil {
+				return NewNil(), fmt.Errorf("money.abs overflow")
+			}
+			return NewMoney(negated), nil
+		}), nil
+	case "zero?", "positive?", "negative?":
+		name := "money." + property
+		return NewAutoBuiltin(name, func(exec *Execution, receiver Value, args []Value, kwargs map[string]Value, block Value) (Value, error) {
+			if err := requireNullaryCall(name, args, kwargs, block); err != nil {
+				return NewNil(), err
+			}
+			cents := receiver.Money().Cents()
+			switch property {
+			case "zero?":
+				return NewBool(cents == 0), nil
+			case "positive?":
+				return NewBool(cents > 0), nil
+			default:
+				return NewBool(cents < 0), nil
+			}
 		}), nil
 	default:
 		return NewNil(), fmt.Errorf("unknown money member %s", property)
@@ -46,6 +46,36 @@ func TestIntUptoDowntoReturnsReceiver(t *testing.T) {
 	}
 }
 
+func TestIntUptoDowntoReachInt64Bounds(t *testing.T) {
+	t.Parallel()
+
+	// A limit at the edge of the int64 range is yielded once and iteration stops
+	// there instead of incrementing past it.
+	tests := []struct {
+		name string
+		expr string
+		want []Value
+	}{
+		{
+			name: "upto max",
+			expr: "9223372036854775806.upto(9223372036854775807) { |i| out = out.push(i) }",
+			want: []Value{NewInt(9223372036854775806), NewInt(9223372036854775807)},
+		},
+		{
+			name: "downto min",
+			expr: "(-9223372036854775807).downto(-9223372036854775807 - 1) { |i| out = out.push(i) }",
+			want: []Value{NewInt(-9223372036854775807), NewInt(-9223372036854775807 - 1)},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			source := "def run()\n  out = []\n  " + tc.expr + "\n  out\nend"
+			compareArrays(t, callFunc(t, compileScript(t, source), "run", nil), tc.want)
+		})
+	}
+}
+
 func TestIntStep(t *testing.T) {
 	t.Parallel()
 
@@ -86,6 +116,36 @@ func TestIntStepReturnsReceiver(t *testing.T) {
 	}
 }
 
+func TestIntStepStopsAtInt64Bounds(t *testing.T) {
+	t.Parallel()
+
+	// The value after the last in-range yield would wrap past the int64 range, so
+	// step must stop there rather than wrap around and keep yielding.
+	tests := []struct {
+		name string
+		expr string
+		want []Value
+	}{
+		{
+			name: "ascending near max",
+			expr: "9223372036854775800.step(9223372036854775807, 5) { |i| out = out.push(i) }",
+			want: []Value{NewInt(9223372036854775800), NewInt(9223372036854775805)},
+		},
+		{
+			name: "descending near min",
+			expr: "(-9223372036854775800).step(-9223372036854775807 - 1, -5) { |i| out = out.push(i) }",
+			want: []Value{NewInt(-9223372036854775800), NewInt(-9223372036854775805)},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			source := "def run()\n  out = []\n  " + tc.expr + "\n  out\nend"
+			compareArrays(t, callFunc(t, compileScript(t, source), "run", nil), tc.want)
+		})
+	}
+}
+
 func TestIntEnumerationArgumentRejection(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,117 @@
+package runtime
+
+import "testing"
+
+// TestIntToStringRadix checks Integer#to_s with an optional radix, matching
+// Ruby's lowercase digits and leading minus sign.
+func TestIntToStringRadix(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		expr string
+		want string
+	}{
+		{"255.to_s", "255"},
+		{"255.to_s(16)", "ff"},
+		{"255.to_s(2)", "11111111"},
+		{"8.to_s(8)", "10"},
+		{"35.to_s(36)", "z"},
+		{"(-5).to_s(2)", "-101"},
+		{"0.to_s(16)", "0"},
+		{"255.string(16)", "ff"},
+		{"(-9223372036854775807 - 1).to_s(16)", "-8000000000000000"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.expr, func(t *testing.T) {
+			t.Parallel()
+			got := evalNumericExpr(t, tc.expr)
+			if !got.Equal(NewString(tc.want)) {
+				t.Fatalf("%s = %v, want %q", tc.expr, got, tc.want)
+			}
+		})
+	}
+}
+
+// TestIntToStringRadixRejection verifies the radix is validated like Ruby's
+// `invalid radix` ArgumentError.
+func TestIntToStringRadixRejection(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		expr string
+		want string
+	}{
+		{"255.to_s(1)", "int.to_s invalid radix 1"},
+		{"255.to_s(37)", "int.to_s invalid radix 37"},
+		{"255.to_s(-2)", "int.to_s invalid radix -2"},
+		{"255.to_s(16.0)", "int.to_s radix must be an integer"},
+		{"255.to_s(2, 8)", "int.to_s expects at most one radix argument"},
+		{"255.string(base: 2)", "int.string does not take keyword arguments"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.expr, func(t *testing.T) {
+			t.Parallel()
+			script := compileScript(t, "def run()\n  "+tc.expr+"\nend")
+			requireCallErrorContains(t, script, "run", nil, CallOptions{}, tc.want)
+		})
+	}
+}
+
+// TestIntWithCommas checks digit grouping for report output.
+func TestIntWithCommas(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		expr string
+		want string
+	}{
+		{"0.with_commas", "0"},
+		{"999.with_commas", "999"},
+		{"1000.with_commas", "1,000"},
+		{"1234567.with_commas", "1,234,567"},
+		{"123456.with_commas", "123,456"},
+		{"(-1234567).with_commas", "-1,234,567"},
+		{"(-123).with_commas", "-123"},
+		{"9223372036854775807.with_commas", "9,223,372,036,854,775,807"},
+		{"(-9223372036854775807 - 1).with_commas", "-9,223,372,036,854,775,808"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.expr, func(t *testing.T) {
+			t.Parallel()
+			got := evalNumericExpr(t, tc.expr)
+			if !got.Equal(NewString(tc.want)) {
+				t.Fatalf("%s = %v, want %q", tc.expr, got, tc.want)
+			}
+		})
+	}
+}
+
+// TestFloatRoundPlacesForReports pins the report-style float rounding that
+// pairs with the integer humanization helpers: no argument keeps returning an
+// int, a digit count keeps a float.
+func TestFloatRoundPlacesForReports(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		expr string
+		want Value
+	}{
+		{"3.14159.round(2)", NewFloat(3.14)},
+		{"3.14159.round", NewInt(3)},
+		{"3.14159.round(4)", NewFloat(3.1416)},
+		{"1234567.891.round(1)", NewFloat(1234567.9)},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.expr, func(t *testing.T) {
+			t.Parallel()
+			got := evalNumericExpr(t, tc.expr)
+			if got.Kind() != tc.want.Kind() || !got.Equal(tc.want) {
+				t.Fatalf("%s = %v (%v), want %v (%v)", tc.expr, got, got.Kind(), tc.want, tc.want.Kind())
+			}
+		})
+	}
+}
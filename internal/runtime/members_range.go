@@ -22,7 +22,7 @@ const rangeBuildInitialCap = 64
 // switch below; TestMemberSuggestionCandidatesResolve enforces that every
 // listed name resolves.
 var rangeMemberNames = []string{
-	"cover?", "include?", "member?", "first", "last", "size", "exclude_end?", "to_a",
+	"cover?", "include?", "member?", "first", "last", "take", "size", "exclude_end?", "to_a",
 	"each", "step", "map", "select", "reject", "find", "reduce", "count", "sum", "min", "max",
 }
 
@@ -34,6 +34,8 @@ func (exec *Execution) rangeMember(obj Value, property string, pos Position) (Va
 		return rangeMemberFirst(), nil
 	case "last":
 		return rangeMemberLast(), nil
+	case "take":
+		return rangeMemberTake(), nil
 	case "size":
 		return rangeMemberSize(), nil
 	case "exclude_end?":
@@ -153,13 +155,16 @@ func rangeMemberEach() Value {
 func rangeMemberStep() Value {
 	return NewAutoBuiltin("range.step", func(exec *Execution, receiver Value, args []Value, kwargs map[string]Value, block Value) (Value, error) {
 		if len(args) != 1 {
-			return NewNil(), fmt.Errorf("range.step expects one integer argument")
+			return NewNil(), fmt.Errorf("range.step expects one numeric argument")
 		}
 		if len(kwargs) > 0 {
 			return NewNil(), fmt.Errorf("range.step does not take keyword arguments")
 		}
+		if args[0].Kind() == KindFloat {
+			return rangeStepFloat(exec, receiver, args, kwargs, block)
+		}
 		if args[0].Kind() != KindInt {
-			return NewNil(), fmt.Errorf("range.step expects an integer step")
+			return NewNil(), fmt.Errorf("range.step expects an integer or float step")
 		}
 		stride := args[0].Int()
 		if stride <= 0 {
@@ -206,6 +211,48 @@ func rangeMemberStep() Value {
 	})
 }
 
+// rangeStepFloat is range.step with a float stride: it yields floats from the
+// range's start toward its end, so (0..1).step(0.25) samples 0.0, 0.25, 0.5,
+// 0.75, and 1.0. Each value is computed as start + i*stride rather than by
+// repeated addition, so rounding error does not accumulate across a long
+// sequence. The stride must be positive and finite; a tiny stride over a wide
+// span is still bounded by the sandbox step quota, which is charged per value.
+func rangeStepFloat(exec *Execution, receiver Value, args []Value, kwargs map[string]Value, block Value) (Value, error) {
+	stride := args[0].Float()
+	if math.IsNaN(stride) || math.IsInf(stride, 0) {
+		return NewNil(), fmt.Errorf("range.step step must be finite")
+	}
+	if stride <= 0 {
+		return NewNil(), fmt.Errorf("range.step step must be positive")
+	}
+	runner, err := newBlockCallRunner(exec, block, "range.step", receiver, args, kwargs)
+	if err != nil {
+		return NewNil(), err
+	}
+	rng := receiver.Range()
+	start, end := float64(rng.Start), float64(rng.End)
+	if rng.Start > rng.End {
+		stride = -stride
+	}
+	var blockArg [1]Value
+	for i := 0; ; i++ {
+		current := start + float64(i)*stride
+		switch {
+		case stride > 0 && (current > end || (rng.Exclusive && current == end)):
+			return receiver, nil
+		case stride < 0 && (current < end || (rng.Exclusive && current == end)):
+			return receiver, nil
+		}
+		if err := exec.step(); err != nil {
+			return NewNil(), err
+		}
+		blockArg[0] = NewFloat(current)
+		if _, err := runner.call(blockArg[:]); err != nil {
+			return NewNil(), err
+		}
+	}
+}
+
 // rangeMemberMap builds an array of the block's result for each integer in the
 // range, mirroring Array#map. The growing result is charged against the memory
 // quota per element so a wide range cannot accumulate an unbounded array, and
@@ -218,6 +265,13 @@ func rangeMemberMap() Value {
 		if len(kwargs) > 0 {
 			return NewNil(), fmt.Errorf("range.map does not take keyword arguments")
 		}
+		length, overflow := rangeLength(receiver.Range())
+		if overflow || length > int64(math.MaxInt) {
+			length = int64(math.MaxInt)
+		}
+		if err := exec.checkCollectionSize("range.map", int(length)); err != nil {
+			return NewNil(), err
+		}
 		runner, err := newBlockCallRunner(exec, block, "range.map", receiver, args, kwargs)
 		if err != nil {
 			return NewNil(), err
@@ -540,7 +594,26 @@ func rangeMemberFirst() Value {
 		if err != nil {
 			return NewNil(), err
 		}
-		return exec.rangeMaterialize(rng, count, false)
+		return exec.rangeMaterialize("range.first", rng, count, false)
+	})
+}
+
+// rangeMemberTake returns the first n iterated elements, like first(n). It
+// builds only that window, so taking a few elements of a huge range stays
+// within the step and memory quotas.
+func rangeMemberTake() Value {
+	return NewAutoBuiltin("range.take", func(exec *Execution, receiver Value, args []Value, kwargs map[string]Value, block Value) (Value, error) {
+		if len(kwargs) > 0 {
+			return NewNil(), fmt.Errorf("range.take does not take keyword arguments")
+		}
+		if len(args) != 1 {
+			return NewNil(), fmt.Errorf("range.take expects a count")
+		}
+		count, err := rangeCountArg(args[0], "range.take")
+		if err != nil {
+			return NewNil(), err
+		}
+		return exec.rangeMaterialize("range.take", receiver.Range(), count, false)
 	})
 }
 
@@ -563,7 +636,7 @@ func rangeMemberLast() Value {
 		if err != nil {
 			return NewNil(), err
 		}
-		return exec.rangeMaterialize(rng, count, true)
+		return exec.rangeMaterialize("range.last", rng, count, true)
 	})
 }
 
@@ -615,7 +688,7 @@ func rangeMemberToArray() Value {
 		if overflow {
 			return NewNil(), guardLimitErrorf("range.to_a result too large")
 		}
-		return exec.rangeMaterialize(rng, length, false)
+		return exec.rangeMaterialize("range.to_a", rng, length, false)
 	})
 }
 
@@ -680,7 +753,7 @@ func rangeLastElement(rng Range) int64 {
 // only clamped to the range length when that length is representable, and the
 // window's starting value is derived from the relevant endpoint, so a single
 // trailing or leading element never depends on the unrepresentable total.
-func (exec *Execution) rangeMaterialize(rng Range, limit int64, fromEnd bool) (Value, error) {
+func (exec *Execution) rangeMaterialize(method string, rng Range, limit int64, fromEnd bool) (Value, error) {
 	if limit <= 0 {
 		return NewArray([]Value{}), nil
 	}
@@ -697,6 +770,9 @@ func (exec *Execution) rangeMaterialize(rng Range, limit int64, fromEnd bool) (V
 	if limit > int64(math.MaxInt) {
 		return NewNil(), guardLimitErrorf("range materialization result too large")
 	}
+	if err := exec.checkCollectionSize(method, int(limit)); err != nil {
+		return NewNil(), err
+	}
 
 	ascending := rng.Start <= rng.End
 
@@ -69,6 +69,36 @@ func TestRangeStep(t *testing.T) {
 	}
 }
 
+func TestRangeStepFloat(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		expr string
+		want []Value
+	}{
+		{"float bounds", "(0.0..1.0).step(0.25)", []Value{NewFloat(0), NewFloat(0.25), NewFloat(0.5), NewFloat(0.75), NewFloat(1)}},
+		{"exclusive", "(0...1).step(0.25)", []Value{NewFloat(0), NewFloat(0.25), NewFloat(0.5), NewFloat(0.75)}},
+		{"overshoots end", "(1..2).step(0.4)", []Value{NewFloat(1), NewFloat(1.4), NewFloat(1.8)}},
+		{"descending", "(1..0).step(0.5)", []Value{NewFloat(1), NewFloat(0.5), NewFloat(0)}},
+		{"no accumulated drift", "(0..1).step(0.1)", []Value{
+			NewFloat(0), NewFloat(0.1), NewFloat(0.2), NewFloat(0.30000000000000004), NewFloat(0.4), NewFloat(0.5),
+			NewFloat(0.6000000000000001), NewFloat(0.7000000000000001), NewFloat(0.8), NewFloat(0.9), NewFloat(1),
+		}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			source := "def run()\n  out = []\n  " + tc.expr + " { |x| out = out + [x] }\n  out\nend"
+			compareArrays(t, callFunc(t, compileScript(t, source), "run", nil), tc.want)
+		})
+	}
+
+	script := compileScriptWithConfig(t, Config{StepQuota: 1000}, "def run()\n  (0..1).step(0.0000001) { |x| x }\nend")
+	requireCallErrorContains(t, script, "run", nil, CallOptions{}, "step quota exceeded")
+}
+
 func TestRangeStepSparseStrideRespectsStepQuota(t *testing.T) {
 	t.Parallel()
 
@@ -292,10 +322,14 @@ func TestRangeEnumerationArgumentRejection(t *testing.T) {
 		{"each no block", "(1..3).each", "requires a block"},
 		{"each with arg", "(1..3).each(2) { |i| i }", "does not take arguments"},
 		{"step no block", "(1..3).step(2)", "requires a block"},
-		{"step no arg", "(1..3).step { |i| i }", "expects one integer argument"},
+		{"step no arg", "(1..3).step { |i| i }", "expects one numeric argument"},
 		{"step zero", "(1..3).step(0) { |i| i }", "must be positive"},
 		{"step negative", "(1..3).step(-1) { |i| i }", "must be positive"},
-		{"step float", "(1..3).step(1.5) { |i| i }", "expects an integer step"},
+		{"step string", "(1..3).step(\"1\") { |i| i }", "expects an integer or float step"},
+		{"step float zero", "(1..3).step(0.0) { |i| i }", "must be positive"},
+		{"step float negative", "(1..3).step(-0.5) { |i| i }", "must be positive"},
+		{"step float nan", "(1..3).step(0.0 / 0.0) { |i| i }", "must be finite"},
+		{"step float no block", "(1..3).step(0.5)", "requires a block"},
 		{"step kwarg", "(1..3).step(1, by: 2) { |i| i }", "does not take keyword arguments"},
 		{"map no block", "(1..3).map", "requires a block"},
 		{"map with arg", "(1..3).map(2) { |i| i }", "does not take arguments"},
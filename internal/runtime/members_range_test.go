@@ -159,6 +159,9 @@ func TestRangeFirstLastCount(t *testing.T) {
 		// Descending first/last follow descending iteration order.
 		{"first descending", "(5..1).first(2)", []Value{NewInt(5), NewInt(4)}},
 		{"last descending", "(5..1).last(2)", []Value{NewInt(2), NewInt(1)}},
+		{"take n", "(1..5).take(2)", []Value{NewInt(1), NewInt(2)}},
+		{"take clamps", "(1...3).take(10)", []Value{NewInt(1), NewInt(2)}},
+		{"take descending", "(5..1).take(2)", []Value{NewInt(5), NewInt(4)}},
 	}
 
 	for _, tc := range tests {
@@ -198,6 +201,8 @@ func TestRangeHelperArgumentRejection(t *testing.T) {
 		{"last negative", "(1..5).last(-1)", "non-negative"},
 		{"first non-int", "(1..5).first(\"2\")", "integer count"},
 		{"last non-int", "(1..5).last(2.5)", "integer count"},
+		{"take no arg", "(1..5).take", "range.take expects a count"},
+		{"take negative", "(1..5).take(-1)", "non-negative"},
 		{"unknown method", "(1..3).reverse", "unknown range method"},
 	}
 
@@ -245,7 +250,7 @@ func TestRangeMaterializeLargeIsLinear(t *testing.T) {
 	}
 	done := make(chan outcome, 1)
 	go func() {
-		result, err := exec.rangeMaterialize(rng, count, false)
+		result, err := exec.rangeMaterialize("range.first", rng, count, false)
 		done <- outcome{result: result, err: err}
 	}()
 
@@ -282,10 +287,31 @@ func TestRangeMaterializeLargeTripsMemoryQuota(t *testing.T) {
 	}
 	rng := Range{Start: 1, End: count, Exclusive: false}
 
-	_, err := exec.rangeMaterialize(rng, count, false)
+	_, err := exec.rangeMaterialize("range.first", rng, count, false)
 	requireErrorIs(t, err, errMemoryQuotaExceeded)
 }
 
+func TestRangeFirstOfHugeRangeBuildsOnlyWindow(t *testing.T) {
+	t.Parallel()
+
+	// first(n) and take(n) build only the requested window, so a few elements of
+	// a billion-element range fit in quotas that would reject materializing even
+	// a small fraction of it.
+	for _, expr := range []string{
+		"(1..1_000_000_000).first(3)",
+		"(1..1_000_000_000).take(3)",
+		"(1_000_000_000..1).first(3).map { |i| 1_000_000_001 - i }",
+	} {
+		t.Run(expr, func(t *testing.T) {
+			t.Parallel()
+			source := "def run()\n  " + expr + "\nend"
+			script := compileScriptWithConfig(t, Config{StepQuota: 100, MemoryQuotaBytes: 16 * 1024}, source)
+			got := callFunc(t, script, "run", nil)
+			compareArrays(t, got, []Value{NewInt(1), NewInt(2), NewInt(3)})
+		})
+	}
+}
+
 func TestRangeMaterializeRejectsHugePreallocation(t *testing.T) {
 	t.Parallel()
 
@@ -367,7 +393,7 @@ func TestRangeMaterializeBoundsInitialCapacity(t *testing.T) {
 	var before, after goruntime.MemStats
 	goruntime.GC()
 	goruntime.ReadMemStats(&before)
-	_, err := exec.rangeMaterialize(rng, limit, false)
+	_, err := exec.rangeMaterialize("range.first", rng, limit, false)
 	goruntime.ReadMemStats(&after)
 
 	requireErrorIs(t, err, errStepQuotaExceeded)
@@ -401,7 +427,7 @@ func TestRangeMaterializeGrowsPastInitialCapacity(t *testing.T) {
 	}
 	count := int64(rangeMaterializeInitialCap) + 1000
 	rng := Range{Start: 1, End: count, Exclusive: false}
-	result, err := exec.rangeMaterialize(rng, count, false)
+	result, err := exec.rangeMaterialize("range.first", rng, count, false)
 	if err != nil {
 		t.Fatalf("rangeMaterialize: %v", err)
 	}
@@ -233,8 +233,10 @@ func TestFloatToIntRejectsNonFinite(t *testing.T) {
 func TestScalarConversionArgumentRejection(t *testing.T) {
 	t.Parallel()
 
+	// int.to_s/int.string take an optional radix, so their argument
+	// validation lives in TestIntToStringRadixRejection.
 	exprs := []string{
-		`42.to_s(1)`, `42.string(1)`, `42.to_i(1)`, `42.to_f(1)`, `42.nil?(1)`,
+		`42.to_i(1)`, `42.to_f(1)`, `42.nil?(1)`,
 		`3.14.to_s(1)`, `3.14.string(1)`, `3.14.to_i(1)`, `3.14.to_f(1)`, `3.14.nil?(1)`,
 		`"x".to_s(1)`, `"x".string(1)`, `"42".to_i(1)`, `"3.5".to_f(1)`, `"x".nil?(1)`,
 		`true.to_s(1)`, `true.string(1)`, `true.nil?(1)`,
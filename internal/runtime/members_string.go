@@ -23,7 +23,9 @@ var stringMemberNames = []string{
 	"size", "length", "bytesize", "ord", "chr", "getbyte", "byteslice", "hex", "oct", "empty?", "clear", "concat", "prepend", "insert", "replace", "start_with?", "end_with?", "include?", "casecmp", "casecmp?", "match", "match?", "scan", "index", "rindex", "slice",
 	"strip", "strip!", "squish", "squish!", "lstrip", "lstrip!", "rstrip", "rstrip!", "chomp", "chomp!", "chop", "chop!", "delete_prefix", "delete_prefix!", "delete_suffix", "delete_suffix!", "upcase", "upcase!", "downcase", "downcase!", "capitalize", "capitalize!", "swapcase", "swapcase!", "reverse", "reverse!",
 	"sub", "sub!", "gsub", "gsub!", "split", "partition", "rpartition", "chars", "lines", "bytes", "codepoints", "each_char", "each_line", "each_byte", "each_codepoint", "template",
-	"center", "ljust", "rjust", "clamp",
+	"center", "ljust", "rjust", "clamp", "between?",
+	"html_escape", "url_encode", "url_decode", "shell_escape",
+	"pluralize", "singularize",
 	"inspect",
 	"to_sym", "intern", "to_s", "string", "to_i", "to_f",
 }
@@ -47,8 +49,16 @@ func stringMemberBuiltin(property string) (Value, error) {
 		return stringMemberTextOps(property)
 	case "center", "ljust", "rjust":
 		return stringMemberPadding(property)
+	case "html_escape", "url_encode", "url_decode", "shell_escape":
+		return stringMemberEscapes(property)
+	case "pluralize", "singularize":
+		return stringMemberInflections(property)
 	case "clamp":
 		return stringMemberClamp(), nil
+	case "between?":
+		return NewAutoBuiltin("string.between?", func(exec *Execution, receiver Value, args []Value, kwargs map[string]Value, block Value) (Value, error) {
+			return orderedBetween("string.between?", receiver, args, kwargs, block)
+		}), nil
 	case "inspect":
 		return newInspectBuiltin("string"), nil
 	case "to_sym", "intern", "to_s", "string", "to_i", "to_f":
@@ -529,6 +539,9 @@ func stringSplitPartsScratchBytes(count int) int {
 }
 
 func reserveStringSplitResult(exec *Execution, receiver Value, args []Value, kwargs map[string]Value, block Value, count, extraScratch int) (*arrayBuildAccumulator, error) {
+	if err := exec.checkCollectionSize("string.split", count); err != nil {
+		return nil, err
+	}
 	if err := exec.checkStepBudgetFor(count); err != nil {
 		return nil, err
 	}
@@ -2514,8 +2527,12 @@ func stringMemberQuery(property string) (Value, error) {
 			if len(args) < 1 || len(args) > 2 {
 				return NewNil(), fmt.Errorf("string.match? expects a pattern and optional offset")
 			}
-			if args[0].Kind() != KindString {
-				return NewNil(), fmt.Errorf("string.match? pattern must be string")
+			pattern, ok := regexpObjectSource(args[0])
+			if !ok {
+				if args[0].Kind() != KindString {
+					return NewNil(), fmt.Errorf("string.match? pattern must be string or Regexp")
+				}
+				pattern = args[0].String()
 			}
 			offset := 0
 			if len(args) == 2 {
@@ -2525,7 +2542,6 @@ func stringMemberQuery(property string) (Value, error) {
 				}
 				offset = i
 			}
-			pattern := args[0].String()
 			text := receiver.String()
 			if err := validateRegexTextPattern("string.match?", text, pattern); err != nil {
 				return NewNil(), err
@@ -2544,10 +2560,13 @@ func stringMemberQuery(property string) (Value, error) {
 			if len(args) != 1 {
 				return NewNil(), fmt.Errorf("string.scan expects exactly one pattern")
 			}
-			if args[0].Kind() != KindString {
-				return NewNil(), fmt.Errorf("string.scan pattern must be string")
+			pattern, ok := regexpObjectSource(args[0])
+			if !ok {
+				if args[0].Kind() != KindString {
+					return NewNil(), fmt.Errorf("string.scan pattern must be string or Regexp")
+				}
+				pattern = args[0].String()
 			}
-			pattern := args[0].String()
 			text := receiver.String()
 			if err := validateRegexTextPattern("string.scan", text, pattern); err != nil {
 				return NewNil(), err
@@ -16,7 +16,8 @@ var (
 		"seconds", "second", "minutes", "minute", "hours", "hour", "days", "day", "weeks", "week",
 		"in_seconds", "in_minutes", "in_hours", "in_days", "in_weeks", "in_months", "in_years",
 		"iso8601", "parts", "to_i", "to_s", "string", "format", "eql?",
-		"after", "since", "from_now", "ago", "before", "until",
+		"after", "since", "from_now", "ago", "before", "until", "plus", "minus",
+		"clamp", "between?",
 	}
 	timeMemberNames = []string{
 		"year", "month", "mon", "mday", "day", "hour", "min", "sec", "usec", "tv_usec", "nsec", "tv_nsec", "subsec",
@@ -25,6 +26,7 @@ var (
 		"sunday?", "monday?", "tuesday?", "wednesday?", "thursday?", "friday?", "saturday?",
 		"<=>", "eql?", "to_s", "string", "to_a", "iso8601", "xmlschema", "rfc3339", "httpdate", "rfc2822", "rfc822", "format", "strftime",
 		"getutc", "getgm", "getlocal", "utc", "gmtime", "localtime", "round", "ceil", "floor",
+		"clamp", "between?",
 	}
 )
 
@@ -87,11 +89,23 @@ func durationMember(d Duration, property string, pos Position) (Value, error) {
 		}), nil
 	case "after", "since", "from_now":
 		return NewBuiltin("duration.after", func(exec *Execution, receiver Value, args []Value, kwargs map[string]Value, block Value) (Value, error) {
-			return callDurationAfter(d, args, kwargs)
+			return callDurationAfter(exec, d, args, kwargs)
 		}), nil
 	case "ago", "before", "until":
 		return NewBuiltin("duration.before", func(exec *Execution, receiver Value, args []Value, kwargs map[string]Value, block Value) (Value, error) {
-			return callDurationBefore(d, args, kwargs)
+			return callDurationBefore(exec, d, args, kwargs)
+		}), nil
+	case "plus", "minus":
+		return NewBuiltin("duration."+property, func(exec *Execution, receiver Value, args []Value, kwargs map[string]Value, block Value) (Value, error) {
+			return callDurationArithmetic(d, property, args, kwargs)
+		}), nil
+	case "clamp":
+		return NewBuiltin("duration.clamp", func(exec *Execution, receiver Value, args []Value, kwargs map[string]Value, block Value) (Value, error) {
+			return orderedClamp("duration.clamp", NewDuration(d), args, kwargs, block)
+		}), nil
+	case "between?":
+		return NewBuiltin("duration.between?", func(exec *Execution, receiver Value, args []Value, kwargs map[string]Value, block Value) (Value, error) {
+			return orderedBetween("duration.between?", NewDuration(d), args, kwargs, block)
 		}), nil
 	default:
 		return NewNil(), fmt.Errorf("unknown duration method %s%s", property, didYouMean(property, durationMemberNames))
@@ -100,21 +114,23 @@ func durationMember(d Duration, property string, pos Position) (Value, error) {
 
 func canCallDurationMemberDirect(property string) bool {
 	switch property {
-	case "eql?", "after", "since", "from_now", "ago", "before", "until":
+	case "eql?", "after", "since", "from_now", "ago", "before", "until", "plus", "minus":
 		return true
 	default:
 		return false
 	}
 }
 
-func callDurationMemberDirect(d Duration, property string, args []Value, kwargs map[string]Value, block Value) (Value, error) {
+func callDurationMemberDirect(exec *Execution, d Duration, property string, args []Value, kwargs map[string]Value, block Value) (Value, error) {
 	switch property {
 	case "eql?":
 		return callDurationEql(d, args, kwargs)
 	case "after", "since", "from_now":
-		return callDurationAfter(d, args, kwargs)
+		return callDurationAfter(exec, d, args, kwargs)
 	case "ago", "before", "until":
-		return callDurationBefore(d, args, kwargs)
+		return callDurationBefore(exec, d, args, kwargs)
+	case "plus", "minus":
+		return callDurationArithmetic(d, property, args, kwargs)
 	default:
 		return NewNil(), fmt.Errorf("unknown duration method %s%s", property, didYouMean(property, durationMemberNames))
 	}
@@ -133,11 +149,11 @@ func callDurationEql(d Duration, args []Value, kwargs map[string]Value) (Value,
 	return NewBool(d.Seconds() == args[0].Duration().Seconds()), nil
 }
 
-func callDurationAfter(d Duration, args []Value, kwargs map[string]Value) (Value, error) {
+func callDurationAfter(exec *Execution, d Duration, args []Value, kwargs map[string]Value) (Value, error) {
 	if err := rejectTemporalKwargs("duration.after", kwargs); err != nil {
 		return NewNil(), err
 	}
-	start, err := durationTimeArg(args, true, "after")
+	start, err := durationTimeArg(exec, args, true, "after")
 	if err != nil {
 		return NewNil(), err
 	}
@@ -145,11 +161,11 @@ func callDurationAfter(d Duration, args []Value, kwargs map[string]Value) (Value
 	return NewTime(result), nil
 }
 
-func callDurationBefore(d Duration, args []Value, kwargs map[string]Value) (Value, error) {
+func callDurationBefore(exec *Execution, d Duration, args []Value, kwargs map[string]Value) (Value, error) {
 	if err := rejectTemporalKwargs("duration.before", kwargs); err != nil {
 		return NewNil(), err
 	}
-	start, err := durationTimeArg(args, true, "before")
+	start, err := durationTimeArg(exec, args, true, "before")
 	if err != nil {
 		return NewNil(), err
 	}
@@ -157,10 +173,30 @@ func callDurationBefore(d Duration, args []Value, kwargs map[string]Value) (Valu
 	return NewTime(result), nil
 }
 
-func durationTimeArg(args []Value, allowEmpty bool, name string) (time.Time, error) {
+// callDurationArithmetic implements duration.plus and duration.minus, the
+// named forms of `+` and `-` between two durations. They share the operators'
+// overflow checks but, unlike the operators, reject bare numeric seconds.
+func callDurationArithmetic(d Duration, property string, args []Value, kwargs map[string]Value) (Value, error) {
+	method := "duration." + property
+	if err := rejectTemporalKwargs(method, kwargs); err != nil {
+		return NewNil(), err
+	}
+	if len(args) != 1 {
+		return NewNil(), fmt.Errorf("%s expects 1 argument, got %d", method, len(args))
+	}
+	if args[0].Kind() != KindDuration {
+		return NewNil(), fmt.Errorf("%s expects a duration", method)
+	}
+	if property == "plus" {
+		return addValues(NewDuration(d), args[0])
+	}
+	return subtractValues(NewDuration(d), args[0])
+}
+
+func durationTimeArg(exec *Execution, args []Value, allowEmpty bool, name string) (time.Time, error) {
 	if len(args) == 0 {
 		if allowEmpty {
-			return time.Now().UTC(), nil
+			return exec.now().UTC(), nil
 		}
 		return time.Time{}, fmt.Errorf("%s expects a time argument", name)
 	}
@@ -290,6 +326,14 @@ func timeMember(t time.Time, property string) (Value, error) {
 		return NewAutoBuiltin("time.floor", func(exec *Execution, receiver Value, args []Value, kwargs map[string]Value, block Value) (Value, error) {
 			return callTimeFloor(t, args, kwargs)
 		}), nil
+	case "clamp":
+		return NewBuiltin("time.clamp", func(exec *Execution, receiver Value, args []Value, kwargs map[string]Value, block Value) (Value, error) {
+			return orderedClamp("time.clamp", NewTime(t), args, kwargs, block)
+		}), nil
+	case "between?":
+		return NewBuiltin("time.between?", func(exec *Execution, receiver Value, args []Value, kwargs map[string]Value, block Value) (Value, error) {
+			return orderedBetween("time.between?", NewTime(t), args, kwargs, block)
+		}), nil
 	default:
 		return NewNil(), fmt.Errorf("unknown time method %s%s", property, didYouMean(property, timeMemberNames))
 	}
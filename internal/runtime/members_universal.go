@@ -3,6 +3,7 @@ package runtime
 import (
 	"fmt"
 	"slices"
+	"strings"
 )
 
 // universalMemberNames lists the Object-level helpers exposed on every value via
@@ -15,12 +16,18 @@ import (
 //     frozen? reports true because Vibescript does not model mutable freeze state.
 //   - nil? — true only for the nil receiver and false for every other value
 //     (Ruby's Object#nil?).
+//   - blank?/present? — Rails-style emptiness: nil, false, whitespace-only
+//     strings, and empty arrays and hashes are blank; present? negates it.
 //   - eql?/equal? — the equality predicates: `eql?` reports hash-key equality and
 //     `equal?` reports object identity.
 //   - tap/yield_self — the block helpers: `tap` yields the receiver to its block
 //     and returns the receiver (threading side effects through a pipeline without
 //     changing the value), while `yield_self` yields the receiver and returns the
 //     block's result (rewriting a value inline).
+//   - pp — writes the receiver's inspect form to the output writer and returns
+//     the receiver, so `compute().pp` debugs a pipeline stage in place.
+//   - compare — the method form of `<=>`: -1, 0, or 1, or nil when the pair
+//     cannot be ordered, so a sort key can be probed without raising.
 //   - respond_to?/is_a?/kind_of?/instance_of? — the introspection predicates:
 //     `respond_to?` reports whether the receiver has a callable member,
 //     `is_a?`/`kind_of?` test class ancestry, and `instance_of?` tests exact
@@ -41,10 +48,14 @@ var universalMemberNames = []string{
 	"freeze",
 	"frozen?",
 	"nil?",
+	"blank?",
+	"present?",
 	"eql?",
 	"equal?",
 	"tap",
 	"yield_self",
+	"pp",
+	"compare",
 	respondToMemberName,
 	isAMemberName,
 	kindOfMemberName,
@@ -55,7 +66,7 @@ var universalMemberNames = []string{
 // helpers that every value answers through the universal fallback.
 func isUniversalMember(property string) bool {
 	switch property {
-	case "itself", "dup", "clone", "freeze", "frozen?", "nil?", "eql?", "equal?", "tap", "yield_self":
+	case "itself", "dup", "clone", "freeze", "frozen?", "nil?", "blank?", "present?", "eql?", "equal?", "tap", "yield_self", "pp", "compare":
 		return true
 	default:
 		return isUniversalPredicate(property)
@@ -67,15 +78,15 @@ func isUniversalMember(property string) bool {
 // before typed dispatch on those receivers (see universalMemberAlwaysWins) and
 // reported as a cheap miss rather than routed through hashMember's miss path.
 //
-// itself, nil?, eql?, equal?, and the introspection predicates respond_to?/
-// is_a?/kind_of?/instance_of? qualify: they are methods, not keys, so a hash
-// entry or data field of that name is unreachable as data and never shadows the
-// helper. The block helpers tap/yield_self do NOT qualify: a hash entry keyed
-// tap/yield_self is ordinary data the typed dispatch returns, so they fall back
-// only on a genuine miss.
+// itself, nil?, blank?, present?, eql?, equal?, and the introspection
+// predicates respond_to?/is_a?/kind_of?/instance_of? qualify: they are methods,
+// not keys, so a hash entry or data field of that name is unreachable as data
+// and never shadows the helper. The block helpers tap/yield_self, pp, and
+// compare do NOT qualify: a hash entry keyed by one of them is ordinary data the
+// typed dispatch returns, so they fall back only on a genuine miss.
 func isUniversalDataSafe(property string) bool {
 	switch property {
-	case "itself", "dup", "clone", "freeze", "frozen?", "nil?", "eql?", "equal?":
+	case "itself", "dup", "clone", "freeze", "frozen?", "nil?", "blank?", "present?", "eql?", "equal?":
 		return true
 	default:
 		return isUniversalPredicate(property)
@@ -110,7 +121,7 @@ func isCallableMember(val Value) bool {
 // callerIsReceiver controls whether the respond_to? predicate may report private
 // methods: only the receiver itself can already dispatch them, so a public
 // dispatch reaching here passes false to keep them hidden. The value-only
-// helpers (itself, nil?, eql?, equal?, tap, yield_self) ignore it and delegate to
+// helpers (itself, nil?, eql?, equal?, tap, yield_self, pp) ignore it and delegate to
 // universalValueMember.
 func (exec *Execution) universalMember(obj Value, property string, callerIsReceiver bool) (Value, bool) {
 	switch property {
@@ -143,6 +154,10 @@ func universalValueMember(obj Value, property string) (Value, bool) {
 		// The predicate's name carries the receiver's kind so argument errors read
 		// naturally (for example "int.nil? does not take arguments").
 		return newNilPredicateBuiltin(obj.Kind().String()), true
+	case "blank?":
+		return newBlankPredicateBuiltin(obj.Kind().String(), false), true
+	case "present?":
+		return newBlankPredicateBuiltin(obj.Kind().String(), true), true
 	case "eql?":
 		return bindEqualityPredicate("eql?", obj, Value.Eql), true
 	case "equal?":
@@ -151,6 +166,10 @@ func universalValueMember(obj Value, property string) (Value, bool) {
 		return newUniversalBlockBuiltin("tap", true), true
 	case "yield_self":
 		return newUniversalBlockBuiltin("yield_self", false), true
+	case "pp":
+		return newPPMemberBuiltin(obj.Kind().String()), true
+	case "compare":
+		return newCompareMemberBuiltin(obj.Kind().String()), true
 	default:
 		return NewNil(), false
 	}
@@ -202,6 +221,72 @@ func newFrozenPredicateBuiltin(kind string) Value {
 	})
 }
 
+// newBlankPredicateBuiltin returns the no-argument blank? (or, with present
+// set, present?) builtin. See valueBlank for which values count as blank.
+func newBlankPredicateBuiltin(typeName string, present bool) Value {
+	name := typeName + ".blank?"
+	if present {
+		name = typeName + ".present?"
+	}
+	return NewAutoBuiltin(name, func(exec *Execution, receiver Value, args []Value, kwargs map[string]Value, block Value) (Value, error) {
+		if err := requireNullaryCall(name, args, kwargs, block); err != nil {
+			return NewNil(), err
+		}
+		return NewBool(valueBlank(receiver) != present), nil
+	})
+}
+
+// valueBlank reports Rails-style blankness: nil and false are blank, a string
+// is blank when empty or only whitespace, and an array or hash is blank when
+// empty. Every other value, including 0, true, and symbols, is present.
+func valueBlank(val Value) bool {
+	switch val.Kind() {
+	case KindNil:
+		return true
+	case KindBool:
+		return !val.Bool()
+	case KindString:
+		return strings.TrimSpace(val.String()) == ""
+	case KindArray:
+		return len(val.Array()) == 0
+	case KindHash:
+		return len(val.Hash()) == 0
+	default:
+		return false
+	}
+}
+
+// newPPMemberBuiltin returns the no-argument pp member, which writes the
+// receiver's inspect form like the pp builtin and returns the receiver.
+func newPPMemberBuiltin(typeName string) Value {
+	name := typeName + ".pp"
+	return NewAutoBuiltin(name, func(exec *Execution, receiver Value, args []Value, kwargs map[string]Value, block Value) (Value, error) {
+		if err := requireNullaryCall(name, args, kwargs, block); err != nil {
+			return NewNil(), err
+		}
+		return inspectOutput(exec, "pp", []Value{receiver}, nil, NewNil())
+	})
+}
+
+// newCompareMemberBuiltin returns the compare(other) member, which answers
+// exactly like `receiver <=> other`: an instance's own <=> is honored, and an
+// incomparable or unordered (NaN) pair yields nil instead of raising.
+func newCompareMemberBuiltin(typeName string) Value {
+	name := typeName + ".compare"
+	return NewBuiltin(name, func(exec *Execution, receiver Value, args []Value, kwargs map[string]Value, block Value) (Value, error) {
+		if len(args) != 1 {
+			return NewNil(), fmt.Errorf("%s expects 1 argument, got %d", name, len(args))
+		}
+		if len(kwargs) > 0 {
+			return NewNil(), fmt.Errorf("%s does not take keyword arguments", name)
+		}
+		if !block.IsNil() {
+			return NewNil(), fmt.Errorf("%s does not accept blocks", name)
+		}
+		return exec.evalBinaryOperator(tokenSpaceship, receiver, args[0], Position{})
+	})
+}
+
 // newUniversalBlockBuiltin returns the auto-invoked builtin for a universal
 // block helper. When returnReceiver is true the helper returns its receiver
 // (Object#tap); otherwise it returns the block's result (Object#yield_self).
@@ -0,0 +1,94 @@
+package runtime
+
+import "fmt"
+
+// MemoizeCacheKeyPrefix begins every key memoize passes to a host Cache. The
+// rest of the key is the canonical hash-key encoding of the script's key, so
+// memoize(:user) and memoize("user") never collide, and hosts can keep other
+// entries in the same store under a different prefix.
+const MemoizeCacheKeyPrefix = "vibes.memoize:"
+
+// Cache is a host-provided store for memoize results; see
+// CallOptions.Cache. Values are deep-copied on the way in and out, so a
+// script cannot mutate an entry another call reads. Implementations shared
+// between calls or tasks must be safe for concurrent use.
+type Cache interface {
+	Get(key string) (Value, bool)
+	Set(key string, value Value)
+}
+
+// memoEntry is one cached memoize result. The original key is kept beside
+// the value so the memory estimator can charge both.
+type memoEntry struct {
+	key   Value
+	value Value
+}
+
+// builtinMemoize returns the cached result for key, running the block to
+// compute it on the first request. The cache belongs to the Execution, so it
+// is shared by every function in one call and discarded when the call
+// returns, unless the host supplies CallOptions.Cache. A block that raises
+// caches nothing.
+func builtinMemoize(exec *Execution, receiver Value, args []Value, kwargs map[string]Value, block Value) (Value, error) {
+	if len(args) != 1 {
+		return NewNil(), fmt.Errorf("memoize expects a key")
+	}
+	if len(kwargs) > 0 {
+		return NewNil(), fmt.Errorf("memoize does not take keyword arguments")
+	}
+	runner, err := newBlockCallRunner(exec, block, "memoize", NewNil(), nil, nil)
+	if err != nil {
+		return NewNil(), err
+	}
+	if cache := exec.callOptions.Cache; cache != nil {
+		return memoizeInHostCache(cache, args[0], runner)
+	}
+	lookup, err := hashLookupKey(args[0])
+	if err != nil {
+		return NewNil(), fmt.Errorf("memoize: %w", err)
+	}
+	if entry, ok := exec.memoCache[lookup]; ok {
+		return entry.value, nil
+	}
+	val, err := runner.call(nil)
+	if err != nil {
+		return NewNil(), err
+	}
+	if _, ok := exec.memoCache[lookup]; !ok {
+		if err := exec.checkCollectionSize("memoize", len(exec.memoCache)+1); err != nil {
+			return NewNil(), err
+		}
+	}
+	if exec.memoCache == nil {
+		exec.memoCache = make(map[HashLookupKey]memoEntry)
+	}
+	exec.memoCache[lookup] = memoEntry{key: args[0], value: val}
+	if err := exec.checkMemory(); err != nil {
+		return NewNil(), err
+	}
+	return val, nil
+}
+
+// memoizeInHostCache serves memoize from a host Cache. Results must be data
+// because they outlive the call whose functions and instances they could
+// otherwise reference.
+func memoizeInHostCache(cache Cache, key Value, runner *blockCallRunner) (Value, error) {
+	encoded, err := canonicalHashKey(key)
+	if err != nil {
+		return NewNil(), fmt.Errorf("memoize: %w", err)
+	}
+	cacheKey := MemoizeCacheKeyPrefix + encoded
+	if cached, ok := cache.Get(cacheKey); ok {
+		return deepCloneValue(cached), nil
+	}
+	val, err := runner.call(nil)
+	if err != nil {
+		return NewNil(), err
+	}
+	stored, err := cloneCapabilityDataOnlyValue("memoize result", val)
+	if err != nil {
+		return NewNil(), err
+	}
+	cache.Set(cacheKey, stored)
+	return val, nil
+}
@@ -0,0 +1,187 @@
+package runtime
+
+import (
+	"context"
+	"sync"
+	"testing"
+)
+
+func TestMemoizeRunsBlockOncePerKey(t *testing.T) {
+	t.Parallel()
+
+	script := compileScript(t, `def repeated
+  runs = 0
+  values = [:a, :b, :a, :a, :b].map do |key|
+    memoize(key) do
+      runs = runs + 1
+      key.to_s + runs.to_s
+    end
+  end
+  [values, runs]
+end
+
+def structured_keys
+  runs = 0
+  first = memoize([:user, 1]) do
+    runs = runs + 1
+    "u1"
+  end
+  second = memoize([:user, 1]) do
+    runs = runs + 1
+    "other"
+  end
+  third = memoize([:user, 2]) do
+    runs = runs + 1
+    "u2"
+  end
+  [first, second, third, runs]
+end
+
+def falsy_results_are_cached
+  runs = 0
+  3.times do
+    memoize(:missing) do
+      runs = runs + 1
+      nil
+    end
+  end
+  runs
+end
+
+def errors_are_not_cached
+  runs = 0
+  2.times do
+    begin
+      memoize(:flaky) do
+        runs = runs + 1
+        raise "boom"
+      end
+    rescue
+      nil
+    end
+  end
+  runs
+end
+
+def counter
+  memoize(:counter) do
+    rand
+  end
+end
+
+def no_block
+  memoize(:key)
+end
+
+def bad_key
+  memoize({ a: 1 }) { 1 }
+end`)
+
+	got := callFunc(t, script, "repeated", nil)
+	compareArrays(t, got, []Value{
+		NewArray([]Value{NewString("a1"), NewString("b2"), NewString("a1"), NewString("a1"), NewString("b2")}),
+		NewInt(2),
+	})
+	got = callFunc(t, script, "structured_keys", nil)
+	compareArrays(t, got, []Value{NewString("u1"), NewString("u1"), NewString("u2"), NewInt(2)})
+	if got := callFunc(t, script, "falsy_results_are_cached", nil); !got.Equal(NewInt(1)) {
+		t.Fatalf("falsy_results_are_cached() = %v, want 1", got)
+	}
+	if got := callFunc(t, script, "errors_are_not_cached", nil); !got.Equal(NewInt(2)) {
+		t.Fatalf("errors_are_not_cached() = %v, want 2", got)
+	}
+	first := callFunc(t, script, "counter", nil)
+	second := callFunc(t, script, "counter", nil)
+	if first.Equal(second) {
+		t.Fatalf("memoize cache leaked across calls: %v", first)
+	}
+
+	requireCallErrorContains(t, script, "no_block", nil, CallOptions{}, "memoize requires a block")
+	requireCallErrorContains(t, script, "bad_key", nil, CallOptions{}, "memoize: unsupported hash key type hash")
+}
+
+func TestMemoizeBoundedByMaxCollectionSize(t *testing.T) {
+	t.Parallel()
+
+	script := compileScriptWithConfig(t, Config{MaxCollectionSize: 3}, `def run
+  total = 0
+  (1..4).each { |n| total = total + memoize(n) { n * n } }
+  total
+end`)
+	requireCallErrorContains(t, script, "run", nil, CallOptions{}, "memoize collection size limit exceeded (3 elements)")
+}
+
+type mapCache struct {
+	mu      sync.Mutex
+	entries map[string]Value
+	sets    int
+}
+
+func (c *mapCache) Get(key string) (Value, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	val, ok := c.entries[key]
+	return val, ok
+}
+
+func (c *mapCache) Set(key string, val Value) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.entries == nil {
+		c.entries = make(map[string]Value)
+	}
+	c.entries[key] = val
+	c.sets++
+}
+
+func TestMemoizeHostCachePersistsAcrossCalls(t *testing.T) {
+	t.Parallel()
+
+	script := compileScript(t, `def lookup(id)
+  memoize([:user, id]) do
+    { id: id, fetched_at: rand }
+  end
+end
+
+def mutate(id)
+  user = lookup(id)
+  user[:id] = "changed"
+  user
+end
+
+def callable
+  memoize(:callable) do
+    Digest
+  end
+end`)
+
+	cache := &mapCache{}
+	opts := CallOptions{Cache: cache}
+	first := callScript(t, context.Background(), script, "lookup", []Value{NewInt(7)}, opts)
+	second := callScript(t, context.Background(), script, "lookup", []Value{NewInt(7)}, opts)
+	if !second.Equal(first) {
+		t.Fatalf("second call = %v, want cached %v", second, first)
+	}
+	if cache.sets != 1 {
+		t.Fatalf("cache sets = %d, want 1", cache.sets)
+	}
+	key, err := canonicalHashKey(NewArray([]Value{NewSymbol("user"), NewInt(7)}))
+	if err != nil {
+		t.Fatalf("canonical key: %v", err)
+	}
+	if _, ok := cache.entries[MemoizeCacheKeyPrefix+key]; !ok {
+		t.Fatalf("cache keys = %v, want %q", cache.entries, MemoizeCacheKeyPrefix+key)
+	}
+
+	callScript(t, context.Background(), script, "mutate", []Value{NewInt(7)}, opts)
+	if got := callScript(t, context.Background(), script, "lookup", []Value{NewInt(7)}, opts); !got.Equal(first) {
+		t.Fatalf("cached entry changed to %v after script mutation, want %v", got, first)
+	}
+
+	uncached := callScript(t, context.Background(), script, "lookup", []Value{NewInt(7)}, CallOptions{})
+	if uncached.Equal(first) {
+		t.Fatalf("call without Cache reused host cache entry %v", uncached)
+	}
+
+	requireCallErrorContains(t, script, "callable", nil, opts, "memoize result must be data-only")
+}
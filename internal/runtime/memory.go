@@ -1639,6 +1639,13 @@ func (exec *Execution) estimateMemoryUsageBase(est *memoryEstimator) int {
 	for _, mod := range exec.modules {
 		total += est.value(mod)
 	}
+	if exec.memoCache != nil {
+		total += estimatedMapBaseBytes + len(exec.memoCache)*estimatedMapEntryBytes
+		for _, entry := range exec.memoCache {
+			total += est.value(entry.key)
+			total += est.value(entry.value)
+		}
+	}
 	for _, group := range exec.activeTaskGroups {
 		total += group.retainedSnapshotMemory(est)
 		total += group.jobPayloadMemory(est)
@@ -1684,6 +1691,14 @@ func (exec *Execution) estimateMemoryUsageBase(est *memoryEstimator) int {
 			total += estimatedStringHeaderBytes + len(name)
 		}
 	}
+	total += estimatedSliceBaseBytes + len(exec.capabilityNames)*estimatedStringHeaderBytes
+	for _, name := range exec.capabilityNames {
+		total += len(name)
+	}
+	total += estimatedSliceBaseBytes + len(exec.capabilityMethods)*estimatedStringHeaderBytes*2
+	for _, method := range exec.capabilityMethods {
+		total += len(method.Capability) + len(method.Name)
+	}
 	total += estimatedSliceBaseBytes + len(exec.moduleLoadStack)*estimatedStringHeaderBytes
 	for _, key := range exec.moduleLoadStack {
 		total += len(key)
@@ -1792,6 +1807,8 @@ func (est *memoryEstimator) value(val Value) int {
 		str := val.String()
 		size += estimatedStringHeaderBytes
 		size += est.stringPayloadSize(str)
+	case KindBigInt:
+		size += estimatedStringHeaderBytes + (val.BigInt().BitLen()+7)/8
 	case KindArray:
 		size += est.slice(val.Array())
 	case KindHash:
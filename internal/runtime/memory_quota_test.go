@@ -224,6 +224,112 @@ func TestMemoryQuotaAllowsExecution(t *testing.T) {
 	}
 }
 
+// TestMemoryQuotaStopsGrowingLoopsMidway checks that a loop growing a
+// collection fails as soon as it crosses the quota rather than when the loop or
+// function returns. Each body calls a host builtin that counts iterations, and
+// the loops are far longer than the quota allows, so a check that only ran on
+// completion would see every iteration.
+func TestMemoryQuotaStopsGrowingLoopsMidway(t *testing.T) {
+	t.Parallel()
+
+	const iterations = 100_000
+	tests := []struct {
+		name   string
+		source string
+	}{
+		{
+			name: "for_reassigned_push",
+			source: `def run()
+  items = []
+  for i in 1..100_000
+    tick()
+    items = items.push("abcdefghij")
+  end
+  items.size
+end`,
+		},
+		{
+			name: "for_compound_append",
+			source: `def run()
+  items = []
+  for i in 1..100_000
+    tick()
+    items += ["abcdefghij"]
+  end
+  items.size
+end`,
+		},
+		{
+			name: "while_reassigned_shovel",
+			source: `def run()
+  items = []
+  n = 0
+  while n < 100_000
+    tick()
+    items = items << [n]
+    n += 1
+  end
+  items.size
+end`,
+		},
+		{
+			name: "each_block_push",
+			source: `def run()
+  items = []
+  (1..100_000).each do |i|
+    tick()
+    items = items.push([i])
+  end
+  items.size
+end`,
+		},
+		{
+			name: "string_concatenation",
+			source: `def run()
+  text = ""
+  for i in 1..100_000
+    tick()
+    text += "abcdefghij"
+  end
+  text.length
+end`,
+		},
+		{
+			name: "hash_index_assignment",
+			source: `def run()
+  entries = {}
+  for i in 1..100_000
+    tick()
+    entries[i] = "abcdefghij"
+  end
+  entries.size
+end`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			engine := MustNewEngine(Config{StepQuota: 10 * iterations, MemoryQuotaBytes: 16 << 10})
+			var ticks int
+			engine.RegisterBuiltin("tick", func(exec *Execution, receiver Value, args []Value, kwargs map[string]Value, block Value) (Value, error) {
+				ticks++
+				return NewNil(), nil
+			})
+			script, err := engine.Compile(tc.source)
+			if err != nil {
+				t.Fatalf("compile failed: %v", err)
+			}
+
+			requireRunMemoryQuotaError(t, script, nil, CallOptions{})
+			if ticks == 0 || ticks >= iterations/100 {
+				t.Fatalf("loop ran %d of %d iterations before the quota error, want it to stop early", ticks, iterations)
+			}
+		})
+	}
+}
+
 // transientOOMCase exercises the common pattern for transient-allocation OOM
 // detection: build statements + env, probe to measure baseline, set a quota
 // just above the baseline but below baseline+transient, then verify the same
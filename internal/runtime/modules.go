@@ -147,6 +147,7 @@ func (e *Engine) compileAndCacheModule(key, root, relative, fullPath string, con
 		script: script,
 	}
 	script.moduleKey = key
+	script.moduleName = moduleDisplayName(key)
 	script.modulePath = entry.path
 	script.moduleRoot = filepath.Clean(root)
 
@@ -203,7 +204,7 @@ func executeModuleEntrypoint(exec *Execution, entry moduleEntry, moduleEnv *Env)
 		return nil
 	}
 
-	if err := exec.pushFrame(moduleDisplayName(entry.key), fn.Pos, entry.script, entry.script); err != nil {
+	if err := exec.pushFrame(entry.script.moduleName, fn.Pos, entry.script, entry.script); err != nil {
 		return err
 	}
 	defer exec.popFrame()
@@ -849,9 +850,6 @@ func builtinRequire(exec *Execution, receiver Value, args []Value, kwargs map[st
 	if !block.IsNil() {
 		return NewNil(), fmt.Errorf("require does not accept blocks")
 	}
-	if exec.root == nil {
-		return NewNil(), fmt.Errorf("require unavailable in this context")
-	}
 	alias, err := parseRequireAlias(kwargs)
 	if err != nil {
 		return NewNil(), err
@@ -864,8 +862,17 @@ func builtinRequire(exec *Execution, receiver Value, args []Value, kwargs map[st
 	default:
 		return NewNil(), fmt.Errorf("require expects a string or symbol module name")
 	}
+	return exec.requireModule(modNameVal.String(), alias)
+}
 
-	entry, err := exec.engine.loadModule(modNameVal.String(), exec.currentModuleContext())
+// requireModule loads the named module for the current call, as require
+// does, and returns its exports object. A non-empty alias also binds the
+// object under that global.
+func (exec *Execution) requireModule(name, alias string) (Value, error) {
+	if exec.root == nil {
+		return NewNil(), fmt.Errorf("require unavailable in this context")
+	}
+	entry, err := exec.engine.loadModule(name, exec.currentModuleContext())
 	if err != nil {
 		return NewNil(), err
 	}
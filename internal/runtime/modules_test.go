@@ -2,6 +2,7 @@ package runtime
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math"
 	"os"
@@ -473,6 +474,34 @@ end`)
 	}
 }
 
+func TestRequireQualifiesModuleFunctionFrames(t *testing.T) {
+	t.Parallel()
+	engine := moduleTestEngine(t)
+
+	script := compileScriptWithEngine(t, engine, `def run(value)
+  helpers = require("failing_helper")
+  helpers.divide(value)
+end`)
+
+	_, err := script.Call(context.Background(), "run", []Value{NewInt(3)}, CallOptions{AllowRequire: true})
+	var rtErr *RuntimeError
+	if !errors.As(err, &rtErr) {
+		t.Fatalf("expected RuntimeError, got %T: %v", err, err)
+	}
+	want := []string{"failing_helper.divide", "failing_helper.divide", "run"}
+	if len(rtErr.Frames) != len(want) {
+		t.Fatalf("expected %d frames, got %+v", len(want), rtErr.Frames)
+	}
+	for i, name := range want {
+		if rtErr.Frames[i].Function != name {
+			t.Fatalf("frame %d: expected %s, got %s", i, name, rtErr.Frames[i].Function)
+		}
+	}
+	if !strings.Contains(err.Error(), "at failing_helper.divide (2:") {
+		t.Fatalf("expected module-qualified frame in error, got %q", err.Error())
+	}
+}
+
 func TestRequireDetectsCircularExecutionForExportedModuleFunction(t *testing.T) {
 	t.Parallel()
 
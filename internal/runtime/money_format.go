@@ -0,0 +1,95 @@
+package runtime
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// moneyFormatOptions are the display keywords accepted by money.format.
+type moneyFormatOptions struct {
+	symbol    string
+	delimiter string
+	separator string
+	noCents   bool
+}
+
+// builtinMoneyFormat renders money. Without keywords it returns the canonical
+// "1234.50 USD" form. With any of symbol:, delimiter:, separator:, or
+// no_cents: it renders a display amount such as "$1,234.50" instead, which
+// omits the currency code.
+func builtinMoneyFormat(exec *Execution, receiver Value, args []Value, kwargs map[string]Value, block Value) (Value, error) {
+	if len(args) > 0 {
+		return NewNil(), fmt.Errorf("money.format does not take positional arguments")
+	}
+	if !block.IsNil() {
+		return NewNil(), fmt.Errorf("money.format does not accept blocks")
+	}
+	m := receiver.Money()
+	if len(kwargs) == 0 {
+		return NewString(m.String()), nil
+	}
+	opts := moneyFormatOptions{separator: "."}
+	for key, val := range kwargs {
+		switch key {
+		case "symbol", "delimiter", "separator":
+			if val.Kind() != KindString {
+				return NewNil(), fmt.Errorf("money.format %s must be a string", key)
+			}
+			switch key {
+			case "symbol":
+				opts.symbol = val.String()
+			case "delimiter":
+				opts.delimiter = val.String()
+			default:
+				opts.separator = val.String()
+			}
+		case "no_cents":
+			if val.Kind() != KindBool {
+				return NewNil(), fmt.Errorf("money.format no_cents must be a boolean")
+			}
+			opts.noCents = val.Bool()
+		default:
+			return NewNil(), fmt.Errorf("money.format does not accept keyword %s", key)
+		}
+	}
+	return NewString(formatMoneyDisplay(m, opts)), nil
+}
+
+// formatMoneyDisplay renders m as sign, symbol, grouped whole units, and
+// (unless noCents) the separator and two-digit cents. no_cents drops the
+// cents rather than rounding them, and a negative amount that drops to zero
+// whole units renders without its sign.
+func formatMoneyDisplay(m Money, opts moneyFormatOptions) string {
+	cents := m.Cents()
+	var magnitude uint64
+	sign := ""
+	if cents < 0 {
+		sign = "-"
+		magnitude = uint64(-(cents + 1)) + 1
+	} else {
+		magnitude = uint64(cents)
+	}
+	if opts.noCents && magnitude < 100 {
+		sign = ""
+	}
+	digits := strconv.FormatUint(magnitude/100, 10)
+
+	var b strings.Builder
+	b.WriteString(sign)
+	b.WriteString(opts.symbol)
+	lead := len(digits) % 3
+	if lead == 0 {
+		lead = 3
+	}
+	b.WriteString(digits[:lead])
+	for i := lead; i < len(digits); i += 3 {
+		b.WriteString(opts.delimiter)
+		b.WriteString(digits[i : i+3])
+	}
+	if !opts.noCents {
+		b.WriteString(opts.separator)
+		fmt.Fprintf(&b, "%02d", magnitude%100)
+	}
+	return b.String()
+}
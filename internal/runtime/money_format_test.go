@@ -0,0 +1,58 @@
+package runtime
+
+import "testing"
+
+func TestMoneyFormatOptions(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		expr string
+		want string
+	}{
+		{name: "default", expr: `money("1234.50 USD").format`, want: "1234.50 USD"},
+		{name: "default with parens", expr: `money("1234.50 USD").format()`, want: "1234.50 USD"},
+		{name: "us style", expr: `money("1234.50 USD").format(symbol: "$", delimiter: ",")`, want: "$1,234.50"},
+		{name: "european style", expr: `money("1234567.05 EUR").format(symbol: "€", delimiter: ".", separator: ",")`, want: "€1.234.567,05"},
+		{name: "no cents drops cents", expr: `money("1234.99 USD").format(symbol: "$", delimiter: ",", no_cents: true)`, want: "$1,234"},
+		{name: "negative amount", expr: `money("-1234.50 USD").format(symbol: "$", delimiter: ",")`, want: "-$1,234.50"},
+		{name: "negative below one unit without cents", expr: `money("-0.50 USD").format(symbol: "$", no_cents: true)`, want: "$0"},
+		{name: "small amount", expr: `money("0.05 USD").format(symbol: "$", delimiter: ",")`, want: "$0.05"},
+		{name: "separator only", expr: `money("12.30 CHF").format(separator: ",")`, want: "12,30"},
+		{name: "multi-character delimiter", expr: `money("1000000.00 USD").format(delimiter: " ")`, want: "1 000 000.00"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			script := compileScript(t, "def run()\n  "+tc.expr+"\nend")
+			got := callFunc(t, script, "run", nil)
+			if got.Kind() != KindString || got.String() != tc.want {
+				t.Fatalf("%s = %v, want %q", tc.expr, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestMoneyFormatRejectsBadOptions(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		expr string
+		want string
+	}{
+		{name: "symbol type", expr: `money("1.00 USD").format(symbol: :usd)`, want: "money.format symbol must be a string"},
+		{name: "no_cents type", expr: `money("1.00 USD").format(no_cents: "yes")`, want: "money.format no_cents must be a boolean"},
+		{name: "unknown keyword", expr: `money("1.00 USD").format(precision: 2)`, want: "money.format does not accept keyword precision"},
+		{name: "positional", expr: `money("1.00 USD").format("$")`, want: "money.format does not take positional arguments"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			script := compileScript(t, "def run()\n  "+tc.expr+"\nend")
+			requireCallErrorContains(t, script, "run", nil, CallOptions{}, tc.want)
+		})
+	}
+}
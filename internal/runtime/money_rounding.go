@@ -0,0 +1,102 @@
+package runtime
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+
+	"github.com/mgomes/vibescript/vibes/value"
+)
+
+var moneyRoundingModes = map[string]value.Rounding{
+	"truncate":  value.RoundTruncate,
+	"half_even": value.RoundHalfEven,
+	"half_up":   value.RoundHalfUp,
+	"floor":     value.RoundFloor,
+	"ceil":      value.RoundCeil,
+}
+
+// moneyRoundingArg reads the optional `rounding:` keyword, the only keyword
+// money.div and money.mul accept.
+func moneyRoundingArg(method string, kwargs map[string]Value) (value.Rounding, error) {
+	for key := range kwargs {
+		if key != "rounding" {
+			return 0, fmt.Errorf("%s does not accept keyword %s", method, key)
+		}
+	}
+	val, ok := kwargs["rounding"]
+	if !ok || val.IsNil() {
+		return value.RoundTruncate, nil
+	}
+	if val.Kind() != KindSymbol && val.Kind() != KindString {
+		return 0, fmt.Errorf("%s rounding must be a symbol", method)
+	}
+	mode, ok := moneyRoundingModes[val.String()]
+	if !ok {
+		return 0, fmt.Errorf("%s rounding must be one of :truncate, :half_even, :half_up, :floor, :ceil", method)
+	}
+	return mode, nil
+}
+
+// moneyScaleArg converts an int or float operand to an exact rational. Floats
+// use their shortest decimal form, so 0.0825 scales by exactly 825/10000
+// rather than by the nearest binary fraction.
+func moneyScaleArg(method, label string, val Value) (*big.Rat, error) {
+	switch val.Kind() {
+	case KindInt:
+		return new(big.Rat).SetInt64(val.Int()), nil
+	case KindFloat:
+		f := val.Float()
+		if math.IsNaN(f) || math.IsInf(f, 0) {
+			return nil, fmt.Errorf("%s %s must be finite", method, label)
+		}
+		r, ok := new(big.Rat).SetString(strconv.FormatFloat(f, 'g', -1, 64))
+		if !ok {
+			return nil, fmt.Errorf("%s %s must be finite", method, label)
+		}
+		return r, nil
+	default:
+		return nil, fmt.Errorf("%s %s must be int or float", method, label)
+	}
+}
+
+// moneyScale builds money.div and money.mul: the receiver's cents times (or
+// divided by) an int or float operand, rounded to a whole cent by
+// Money.Mul and Money.Div.
+func moneyScale(method string, divide bool) Value {
+	label := "factor"
+	if divide {
+		label = "divisor"
+	}
+	return NewAutoBuiltin(method, func(exec *Execution, receiver Value, args []Value, kwargs map[string]Value, block Value) (Value, error) {
+		if len(args) != 1 {
+			return NewNil(), fmt.Errorf("%s expects a %s", method, label)
+		}
+		if !block.IsNil() {
+			return NewNil(), fmt.Errorf("%s does not accept blocks", method)
+		}
+		mode, err := moneyRoundingArg(method, kwargs)
+		if err != nil {
+			return NewNil(), err
+		}
+		operand, err := moneyScaleArg(method, label, args[0])
+		if err != nil {
+			return NewNil(), err
+		}
+		var result Money
+		if divide {
+			if operand.Sign() == 0 {
+				return NewNil(), newTypedRuntimeError(runtimeErrorTypeZeroDiv, errors.New("division by zero"))
+			}
+			result, err = receiver.Money().Div(operand, mode)
+		} else {
+			result, err = receiver.Money().Mul(operand, mode)
+		}
+		if err != nil {
+			return NewNil(), err
+		}
+		return NewMoney(result), nil
+	})
+}
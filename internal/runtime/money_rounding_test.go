@@ -0,0 +1,71 @@
+package runtime
+
+import "testing"
+
+func TestMoneyDivMulRounding(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		expr string
+		want string
+	}{
+		// 0.25 USD / 10 is 2.5 cents, a tie each mode settles differently.
+		{name: "div default truncates like /", expr: `money("0.25 USD").div(10)`, want: "0.02 USD"},
+		{name: "div half_even tie to even", expr: `money("0.25 USD").div(10, rounding: :half_even)`, want: "0.02 USD"},
+		{name: "div half_even tie up to even", expr: `money("0.35 USD").div(10, rounding: :half_even)`, want: "0.04 USD"},
+		{name: "div half_up tie away from zero", expr: `money("0.25 USD").div(10, rounding: :half_up)`, want: "0.03 USD"},
+		{name: "div floor", expr: `money("10.00 USD").div(3, rounding: :floor)`, want: "3.33 USD"},
+		{name: "div ceil", expr: `money("10.00 USD").div(3, rounding: :ceil)`, want: "3.34 USD"},
+		{name: "div negative floor", expr: `money("-10.00 USD").div(3, rounding: :floor)`, want: "-3.34 USD"},
+		{name: "div negative ceil", expr: `money("-10.00 USD").div(3, rounding: :ceil)`, want: "-3.33 USD"},
+		{name: "div negative half_up", expr: `money("-0.25 USD").div(10, rounding: :half_up)`, want: "-0.03 USD"},
+		{name: "div by float", expr: `money("10.00 USD").div(0.5)`, want: "20.00 USD"},
+		{name: "div truncate by name", expr: `money("10.00 USD").div(3, rounding: "truncate")`, want: "3.33 USD"},
+		// 0.30 USD * 0.0825 is 2.475 cents; the float factor is taken as the
+		// exact decimal 0.0825.
+		{name: "mul default truncates", expr: `money("0.30 USD").mul(0.0825)`, want: "0.02 USD"},
+		{name: "mul half_even", expr: `money("0.30 USD").mul(0.0825, rounding: :half_even)`, want: "0.02 USD"},
+		{name: "mul half_up", expr: `money("0.30 USD").mul(0.0825, rounding: :half_up)`, want: "0.02 USD"},
+		{name: "mul ceil", expr: `money("0.30 USD").mul(0.0825, rounding: :ceil)`, want: "0.03 USD"},
+		{name: "mul exact tie half_even", expr: `money("10.00 USD").mul(0.0825, rounding: :half_even)`, want: "0.82 USD"},
+		{name: "mul exact tie half_up", expr: `money("10.00 USD").mul(0.0825, rounding: :half_up)`, want: "0.83 USD"},
+		{name: "mul int", expr: `money("1.50 EUR").mul(3)`, want: "4.50 EUR"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			script := compileScript(t, "def run()\n  "+tc.expr+".format\nend")
+			got := callFunc(t, script, "run", nil)
+			if got.Kind() != KindString || got.String() != tc.want {
+				t.Fatalf("%s = %v, want %s", tc.expr, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestMoneyDivMulErrors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		expr string
+		want string
+	}{
+		{name: "unknown mode", expr: `money("1.00 USD").div(3, rounding: :bankers)`, want: "money.div rounding must be one of :truncate, :half_even, :half_up, :floor, :ceil"},
+		{name: "mode type", expr: `money("1.00 USD").mul(2, rounding: 1)`, want: "money.mul rounding must be a symbol"},
+		{name: "unknown keyword", expr: `money("1.00 USD").div(3, mode: :ceil)`, want: "money.div does not accept keyword mode"},
+		{name: "missing operand", expr: `money("1.00 USD").div`, want: "money.div expects a divisor"},
+		{name: "operand type", expr: `money("1.00 USD").mul("2")`, want: "money.mul factor must be int or float"},
+		{name: "zero divisor", expr: `money("1.00 USD").div(0.0)`, want: "division by zero"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			script := compileScript(t, "def run()\n  "+tc.expr+"\nend")
+			requireCallErrorContains(t, script, "run", nil, CallOptions{}, tc.want)
+		})
+	}
+}
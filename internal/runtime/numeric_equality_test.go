@@ -0,0 +1,56 @@
+package runtime
+
+import "testing"
+
+// TestNumericEqualityAcrossIntAndFloat checks that `==` compares ints and
+// floats numerically, matching `<=>`, while the kinds stay distinct and the
+// hash-key predicate eql? remains kind-sensitive.
+func TestNumericEqualityAcrossIntAndFloat(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		expr string
+		want bool
+	}{
+		{"1 == 1.0", true},
+		{"1.0 == 1", true},
+		{"2 / 2 == 1", true},
+		{"4 / 2.0 == 2", true},
+		{"1 != 1.0", false},
+		{"1 == 1.5", false},
+		{"0.1 + 0.2 == 0.3", false},
+		{"(1 <=> 1.0) == 0", true},
+		{"[1, 2] == [1.0, 2.0]", true},
+		{"{ a: 1 } == { a: 1.0 }", true},
+		{"[1, 2, 3].include?(2.0)", true},
+		{"[1.5, 3.0].include?(3)", true},
+		{"[1, 2, 3].index(3.0) == 2", true},
+		{"{ a: 1 }.value?(1.0)", true},
+		{"1.eql?(1.0)", false},
+		{"[1].eql?([1.0])", false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.expr, func(t *testing.T) {
+			t.Parallel()
+			got := evalExpr(t, tc.expr)
+			if !got.Equal(NewBool(tc.want)) {
+				t.Fatalf("%s = %v, want %t", tc.expr, got, tc.want)
+			}
+		})
+	}
+}
+
+// TestNumericEqualityKeepsKindsDistinct pins that numeric equality does not
+// merge the kinds: a float result that equals an int is still a float.
+func TestNumericEqualityKeepsKindsDistinct(t *testing.T) {
+	t.Parallel()
+
+	got := evalExpr(t, "2 / 2.0")
+	if got.Kind() != KindFloat {
+		t.Fatalf("2 / 2.0 kind = %v, want float", got.Kind())
+	}
+	if !got.Equal(NewInt(1)) || got.Eql(NewInt(1)) {
+		t.Fatalf("2 / 2.0 should equal 1 but not eql? it")
+	}
+}
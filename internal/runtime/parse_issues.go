@@ -53,3 +53,23 @@ func collectParseIssues(err error, issues *[]ParseIssue) {
 		collectParseIssues(unwrapped.Unwrap(), issues)
 	}
 }
+
+// Check parses source without compiling it and returns every syntax error
+// the parser recovered from, in source order, or nil when the source parses
+// cleanly. Unlike Compile it stops at syntax: duplicate declarations and
+// other compile-time failures are not reported. Source larger than
+// Config.MaxSourceBytes is reported as a single issue at the zero Position.
+func (e *Engine) Check(source string) []ParseIssue {
+	_, parseErrors, err := parseSource(e, source)
+	if err == nil {
+		return nil
+	}
+	if len(parseErrors) == 0 {
+		return []ParseIssue{{Message: err.Error()}}
+	}
+	issues := make([]ParseIssue, 0, len(parseErrors))
+	for _, parseErr := range parseErrors {
+		collectParseIssues(parseErr, &issues)
+	}
+	return issues
+}
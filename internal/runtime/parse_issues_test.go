@@ -76,3 +76,40 @@ func TestParseIssuesReturnNilWithoutParsePositions(t *testing.T) {
 		t.Fatalf("ParseIssues(duplicate function) = %v, want nil", got)
 	}
 }
+
+func TestEngineCheckReportsEveryIndependentSyntaxError(t *testing.T) {
+	t.Parallel()
+	engine := MustNewEngine(Config{})
+	source := "def stats(values)\n  sum = )\n  values.size\nend\n\ndef mean(values)\n  mean = ]\n  values.size\nend\n"
+
+	issues := engine.Check(source)
+	if len(issues) != 2 {
+		t.Fatalf("Check returned %d issues, want 2: %v", len(issues), issues)
+	}
+	want := []struct{ line, column int }{{2, 9}, {7, 10}}
+	for i, issue := range issues {
+		if issue.Pos.Line != want[i].line || issue.Pos.Column != want[i].column {
+			t.Fatalf("issues[%d].Pos = %v, want %d:%d", i, issue.Pos, want[i].line, want[i].column)
+		}
+		if !strings.Contains(issue.Message, "unexpected token") {
+			t.Fatalf("issues[%d].Message = %q", i, issue.Message)
+		}
+	}
+}
+
+func TestEngineCheckCleanAndOversizedSource(t *testing.T) {
+	t.Parallel()
+	engine := MustNewEngine(Config{})
+	if issues := engine.Check("def run()\n  1\nend\n\ndef run()\n  2\nend\n"); issues != nil {
+		t.Fatalf("Check(duplicate function) = %v, want nil (syntax only)", issues)
+	}
+
+	limited := MustNewEngine(Config{MaxSourceBytes: 8})
+	issues := limited.Check("def run()\n  1\nend\n")
+	if len(issues) != 1 || !strings.Contains(issues[0].Message, "source exceeds maximum size") {
+		t.Fatalf("Check(oversized) = %v, want one size issue", issues)
+	}
+	if issues[0].Pos != (Position{}) {
+		t.Fatalf("size issue Pos = %v, want zero", issues[0].Pos)
+	}
+}
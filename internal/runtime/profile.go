@@ -0,0 +1,41 @@
+package runtime
+
+import (
+	"maps"
+	"sync"
+)
+
+// Profile counts the steps each function runs during a call. Pass one as
+// CallOptions.Profile and read Steps after the call returns. Reusing a
+// Profile across calls accumulates their counts. A Profile is safe for
+// concurrent use, so tasks spawned by the script count into it too.
+type Profile struct {
+	mu    sync.Mutex
+	steps map[string]int
+}
+
+// Steps returns the step count of each function that ran, keyed by the name
+// stack frames use: "helpers.triple" for a function from a required module
+// and "<script>" for steps outside any function.
+func (p *Profile) Steps() map[string]int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return maps.Clone(p.steps)
+}
+
+func (p *Profile) record(function string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.steps == nil {
+		p.steps = make(map[string]int)
+	}
+	p.steps[function]++
+}
+
+// profileFunction names the function the current step belongs to.
+func (exec *Execution) profileFunction() string {
+	if len(exec.callStack) == 0 {
+		return "<script>"
+	}
+	return exec.callStack[len(exec.callStack)-1].Function
+}
@@ -0,0 +1,50 @@
+package runtime
+
+import (
+	"context"
+	"testing"
+)
+
+func TestProfileCountsStepsPerFunction(t *testing.T) {
+	t.Parallel()
+	script := compileScriptDefault(t, `def fib(n)
+  if n < 2
+    return n
+  end
+  fib(n - 1) + fib(n - 2)
+end
+
+def label(value)
+  "fib=#{value}"
+end
+
+def run
+  label(fib(12))
+end`)
+
+	profile := &Profile{}
+	result := callScript(t, context.Background(), script, "run", nil, CallOptions{Profile: profile})
+	if !result.Equal(NewString("fib=144")) {
+		t.Fatalf("run = %v, want fib=144", result)
+	}
+
+	steps := profile.Steps()
+	hottest := ""
+	for name, count := range steps {
+		if hottest == "" || count > steps[hottest] {
+			hottest = name
+		}
+	}
+	if hottest != "fib" {
+		t.Fatalf("hottest function = %q, want fib (steps %v)", hottest, steps)
+	}
+	if steps["run"] == 0 || steps["label"] == 0 {
+		t.Fatalf("steps = %v, want run and label counted", steps)
+	}
+
+	first := steps["fib"]
+	callScript(t, context.Background(), script, "run", nil, CallOptions{Profile: profile})
+	if got := profile.Steps()["fib"]; got != 2*first {
+		t.Fatalf("fib steps after two calls = %d, want %d", got, 2*first)
+	}
+}
@@ -0,0 +1,167 @@
+package runtime
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"slices"
+	"sync"
+	"time"
+)
+
+// CallRecording captures the nondeterministic inputs of one call so it can
+// be replayed exactly: the random seed, the clock, and the result of every
+// contracted capability method in call order. Pass an empty recording as
+// CallOptions.Record, then pass the filled recording as CallOptions.Replay
+// to rerun the script without reaching the host.
+//
+// Recording and replay seed the script's random source with Seed (so rand,
+// uuid, and random_id repeat) and freeze the clock at Now. A zero Seed or Now
+// is chosen when recording starts, with Now read from CallOptions.Clock when
+// set; assign them beforehand to pick the values.
+// A recording covers one call, including the tasks and nested calls it makes.
+// Passing the same recording to a later call appends to Results instead of
+// starting over; use a fresh recording per call.
+// Calls made from concurrent tasks may interleave differently between runs,
+// so replay is only exact for scripts whose capability calls happen in a
+// fixed order.
+type CallRecording struct {
+	Seed    int64
+	Now     time.Time
+	Results []RecordedResult
+
+	mu      sync.Mutex
+	started bool
+}
+
+// RecordedResult is one capability method result in a CallRecording.
+type RecordedResult struct {
+	// Method is the contract name, for example "db.find".
+	Method string
+	// Value is a deep copy of the value the host returned.
+	Value Value
+	// Err is the host's error message, or empty when the call succeeded.
+	Err string
+	// ErrKind is the runtime error type the host's error surfaced as, for
+	// example "ArgumentError", so replay raises the same kind of error.
+	ErrKind string
+}
+
+// Entries returns a copy of the recorded results in call order.
+func (r *CallRecording) Entries() []RecordedResult {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return slices.Clone(r.Results)
+}
+
+// start fixes the seed and clock and clears any results left in the
+// recording. It only runs once per recording, so nested calls and later
+// calls that share it keep the results recorded so far.
+func (r *CallRecording) start(clock func() time.Time) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.started {
+		return
+	}
+	r.started = true
+	if r.Seed == 0 {
+		r.Seed = time.Now().UnixNano()
+	}
+	if r.Now.IsZero() {
+		r.Now = clockNow(clock).UTC()
+	}
+	r.Results = nil
+}
+
+func (r *CallRecording) record(method string, result Value, err error) {
+	entry := RecordedResult{Method: method, Value: deepCloneValue(result)}
+	if err != nil {
+		entry.Value = NewNil()
+		entry.Err = err.Error()
+		entry.ErrKind = classifyRuntimeErrorType(err)
+	}
+	r.mu.Lock()
+	r.Results = append(r.Results, entry)
+	r.mu.Unlock()
+}
+
+// replayCursor walks a CallRecording for one replayed call and the tasks it
+// spawns.
+type replayCursor struct {
+	mu   sync.Mutex
+	next int
+}
+
+func (c *replayCursor) take(rec *CallRecording, method string) (Value, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	rec.mu.Lock()
+	defer rec.mu.Unlock()
+	if c.next >= len(rec.Results) {
+		return NewNil(), fmt.Errorf("replay exhausted: no recorded result for %s", method)
+	}
+	entry := rec.Results[c.next]
+	if entry.Method != method {
+		return NewNil(), fmt.Errorf("replay mismatch: recorded %s, script called %s", entry.Method, method)
+	}
+	c.next++
+	if entry.Err != "" {
+		if entry.ErrKind != "" && entry.ErrKind != runtimeErrorTypeBase {
+			return NewNil(), newTypedRuntimeError(entry.ErrKind, errors.New(entry.Err))
+		}
+		return NewNil(), errors.New(entry.Err)
+	}
+	return deepCloneValue(entry.Value), nil
+}
+
+// deterministicRecording returns the recording that fixes this call's seed
+// and clock, if any.
+func (exec *Execution) deterministicRecording() *CallRecording {
+	if exec.callOptions.Replay != nil {
+		return exec.callOptions.Replay
+	}
+	return exec.callOptions.Record
+}
+
+// seedFromRecording seeds the random source from the recording that fixes
+// this call, as if the script had started with `srand(seed)`.
+func (exec *Execution) seedFromRecording() {
+	rec := exec.deterministicRecording()
+	if rec == nil {
+		return
+	}
+	exec.randSource = rand.New(rand.NewSource(rec.Seed))
+	exec.randSeed = rec.Seed
+	exec.randSeeded = true
+}
+
+// now returns the current time for `now`, `Time.now`, uuid, and duration
+// helpers: the recording's frozen clock during record and replay, otherwise
+// CallOptions.Clock or the wall clock.
+func (exec *Execution) now() time.Time {
+	if rec := exec.deterministicRecording(); rec != nil {
+		return rec.Now
+	}
+	return clockNow(exec.callOptions.Clock)
+}
+
+func clockNow(clock func() time.Time) time.Time {
+	if clock != nil {
+		return clock()
+	}
+	return time.Now()
+}
+
+// entropy returns n random bytes for uuid and random_id. Calls seeded by
+// srand or by a recording draw them from the seeded source so ids repeat;
+// otherwise they come from the engine's secure random source.
+func (exec *Execution) entropy(n int) ([]byte, error) {
+	if !exec.randSeeded || exec.randSource == nil {
+		return exec.engine.randomBytes(exec.Context(), n)
+	}
+	buf := make([]byte, n)
+	for i := range buf {
+		buf[i] = byte(exec.randSource.Int63())
+	}
+	return buf, nil
+}
@@ -0,0 +1,152 @@
+package runtime
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestRecordedCallReplaysWithoutHost(t *testing.T) {
+	t.Parallel()
+	script := compileScriptDefault(t, `def missing_message()
+  begin
+    db.find("Player", "gone")
+  rescue => err
+    err.message
+  end
+end
+
+def run(id)
+  player = db.find("Player", id)
+  missing = missing_message()
+  {
+    name: player[:name],
+    missing: missing,
+    roll: rand(1_000_000),
+    id: uuid,
+    token: random_id(8),
+    at: now,
+    year: Time.now.year
+  }
+end`)
+
+	live := &failingFindDB{rows: map[string]Value{
+		"p-1": NewHash(map[string]Value{"name": NewString("Ada")}),
+	}}
+	rec := &CallRecording{}
+	recorded := callScript(t, context.Background(), script, "run", []Value{NewString("p-1")}, CallOptions{
+		Capabilities: []CapabilityAdapter{MustNewDBCapability("db", live)},
+		Record:       rec,
+	})
+	if live.calls != 2 {
+		t.Fatalf("recording run called db.find %d times, want 2", live.calls)
+	}
+	entries := rec.Entries()
+	if len(entries) != 2 || entries[0].Method != "db.find" || entries[1].Err == "" {
+		t.Fatalf("recorded entries = %#v", entries)
+	}
+	if rec.Seed == 0 || rec.Now.IsZero() {
+		t.Fatalf("recording did not capture seed and clock: %#v", rec)
+	}
+
+	disabled := &failingFindDB{disabled: true}
+	replayed := callScript(t, context.Background(), script, "run", []Value{NewString("p-1")}, CallOptions{
+		Capabilities: []CapabilityAdapter{MustNewDBCapability("db", disabled)},
+		Replay:       rec,
+	})
+	if disabled.calls != 0 {
+		t.Fatalf("replay reached the host %d times", disabled.calls)
+	}
+	if !replayed.Equal(recorded) {
+		t.Fatalf("replay = %v, want %v", replayed, recorded)
+	}
+	if recorded.Hash()["name"].String() != "Ada" || recorded.Hash()["missing"].String() == "" {
+		t.Fatalf("recorded result = %v", recorded)
+	}
+}
+
+func TestReplayRejectsDivergentCalls(t *testing.T) {
+	t.Parallel()
+	script := compileScriptDefault(t, `def run()
+  db.find("Player", "p-1")
+  db.query("Player")
+end`)
+	rec := &CallRecording{Seed: 7, Now: time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC), Results: []RecordedResult{{Method: "db.find", Value: NewNil()}}}
+	opts := CallOptions{Capabilities: []CapabilityAdapter{MustNewDBCapability("db", &failingFindDB{disabled: true})}, Replay: rec}
+
+	err := callScriptErr(t, context.Background(), script, "run", nil, opts)
+	requireErrorContains(t, err, "replay exhausted: no recorded result for db.query")
+
+	rec.Results = []RecordedResult{{Method: "db.query", Value: NewArray(nil)}}
+	err = callScriptErr(t, context.Background(), script, "run", nil, opts)
+	requireErrorContains(t, err, "replay mismatch: recorded db.query, script called db.find")
+
+	opts.Record = &CallRecording{}
+	err = callScriptErr(t, context.Background(), script, "run", nil, opts)
+	requireErrorContains(t, err, "CallOptions.Record and CallOptions.Replay are mutually exclusive")
+}
+
+// failingFindDB serves db.find from rows and fails every call once disabled,
+// standing in for a host that must not be reached during replay.
+func TestRecordingKeepsResultsAndErrorKindsAcrossCalls(t *testing.T) {
+	t.Parallel()
+	script := compileScriptDefault(t, `def lookup(id)
+  begin
+    db.find("Player", id)
+    "found"
+  rescue => err
+    err.type
+  end
+end`)
+
+	live := &failingFindDB{rows: map[string]Value{"p-1": NewHash(nil)}}
+	shared := &CallRecording{}
+	for _, id := range []string{"p-1", "gone"} {
+		callScript(t, context.Background(), script, "lookup", []Value{NewString(id)}, CallOptions{
+			Capabilities: []CapabilityAdapter{MustNewDBCapability("db", live)},
+			Record:       shared,
+		})
+	}
+	if entries := shared.Entries(); len(entries) != 2 || entries[0].Err != "" || entries[1].ErrKind != runtimeErrorTypeBase {
+		t.Fatalf("recorded entries across calls = %#v", entries)
+	}
+
+	rec := &CallRecording{}
+	recorded := callScript(t, context.Background(), script, "lookup", []Value{NewString("bad")}, CallOptions{
+		Capabilities: []CapabilityAdapter{MustNewDBCapability("db", live)},
+		Record:       rec,
+	})
+	if entries := rec.Entries(); len(entries) != 1 || entries[0].ErrKind != runtimeErrorTypeArgument {
+		t.Fatalf("recorded entries = %#v", entries)
+	}
+	replayed := callScript(t, context.Background(), script, "lookup", []Value{NewString("bad")}, CallOptions{
+		Capabilities: []CapabilityAdapter{MustNewDBCapability("db", &failingFindDB{disabled: true})},
+		Replay:       rec,
+	})
+	if !recorded.Equal(NewString(runtimeErrorTypeArgument)) || !replayed.Equal(recorded) {
+		t.Fatalf("recorded %v, replayed %v, want %s for both", recorded, replayed, runtimeErrorTypeArgument)
+	}
+}
+
+type failingFindDB struct {
+	dbCapabilityStub
+	rows     map[string]Value
+	disabled bool
+	calls    int
+}
+
+func (db *failingFindDB) Find(ctx context.Context, req DBFindRequest) (Value, error) {
+	db.calls++
+	if db.disabled {
+		return NewNil(), errors.New("host disabled")
+	}
+	if req.ID.String() == "bad" {
+		return NewNil(), &RuntimeError{Type: runtimeErrorTypeArgument, Message: "bad id"}
+	}
+	row, ok := db.rows[req.ID.String()]
+	if !ok {
+		return NewNil(), errors.New("record not found")
+	}
+	return row, nil
+}
@@ -0,0 +1,130 @@
+package runtime
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestRetryRestartsBeginBlock(t *testing.T) {
+	t.Parallel()
+
+	script := compileScript(t, `
+def flaky(attempts)
+  if attempts < 2
+    raise("transient failure")
+  end
+  "ok after #{attempts}"
+end
+
+def run
+  attempts = 0
+  log = []
+  begin
+    log = log << "try"
+    result = flaky(attempts)
+  rescue => err
+    attempts += 1
+    log = log << err.message
+    retry
+  ensure
+    log = log << "ensure"
+  end
+  [result, attempts, log]
+end
+
+def guarded
+  attempts = 0
+  begin
+    attempts += 1
+    raise("down")
+  rescue
+    if attempts < 2
+      retry
+    end
+    "gave up after #{attempts}"
+  end
+end
+
+def nested
+  outer = 0
+  begin
+    outer += 1
+    begin
+      if outer < 3
+        raise("inner")
+      end
+      "outer attempt #{outer}"
+    rescue AssertionError
+      "unreachable"
+    end
+  rescue
+    retry
+  end
+end
+
+def retry_member(queue)
+  queue.retry
+end
+`)
+
+	compareArrays(t, callFunc(t, script, "run", nil), []Value{
+		NewString("ok after 2"),
+		NewInt(2),
+		NewArray([]Value{
+			NewString("try"), NewString("transient failure"),
+			NewString("try"), NewString("transient failure"),
+			NewString("try"), NewString("ensure"),
+		}),
+	})
+	if got := callFunc(t, script, "guarded", nil); !got.Equal(NewString("gave up after 2")) {
+		t.Fatalf("guarded = %v, want gave up after 2", got)
+	}
+	if got := callFunc(t, script, "nested", nil); !got.Equal(NewString("outer attempt 3")) {
+		t.Fatalf("nested = %v, want outer attempt 3", got)
+	}
+	queue := NewObject(map[string]Value{"retry": NewString("member")})
+	if got := callFunc(t, script, "retry_member", []Value{queue}); !got.Equal(NewString("member")) {
+		t.Fatalf("retry_member = %v, want member", got)
+	}
+}
+
+func TestRetryLimitIsEnforced(t *testing.T) {
+	t.Parallel()
+
+	source := `
+def run
+  attempts = 0
+  begin
+    attempts += 1
+    raise("always down")
+  rescue
+    retry
+  end
+end
+
+def count
+  attempts = 0
+  begin
+    begin
+      attempts += 1
+      raise("always down")
+    rescue
+      retry
+    end
+  rescue
+    "rescue cannot catch the limit"
+  end
+end
+`
+	script := compileScript(t, source)
+	requireCallErrorContains(t, script, "run", nil, CallOptions{}, "retry limit exceeded (3 retries)")
+
+	_, err := script.Call(t.Context(), "count", nil, CallOptions{})
+	var runtimeErr *RuntimeError
+	if !errors.As(err, &runtimeErr) || runtimeErr.Type != runtimeErrorTypeLimit {
+		t.Fatalf("count error = %v, want a %s", err, runtimeErrorTypeLimit)
+	}
+
+	raised := compileScriptWithConfig(t, Config{MaxRetries: 10}, source)
+	requireCallErrorContains(t, raised, "run", nil, CallOptions{}, "retry limit exceeded (10 retries)")
+}
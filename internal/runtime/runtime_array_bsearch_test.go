@@ -0,0 +1,58 @@
+package runtime
+
+import "testing"
+
+// TestArrayBsearch covers both Array#bsearch modes over a sorted array:
+// find-minimum with a boolean block and find-any with a comparator block.
+func TestArrayBsearch(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name   string
+		script string
+		want   Value
+	}{
+		{name: "minimum first at or above", script: `def run; [1, 4, 9, 16, 25].bsearch { |x| x >= 10 }; end`, want: NewInt(16)},
+		{name: "minimum exact", script: `def run; [1, 4, 9, 16, 25].bsearch { |x| x >= 9 }; end`, want: NewInt(9)},
+		{name: "minimum all match", script: `def run; [1, 4, 9].bsearch { |x| x >= 0 }; end`, want: NewInt(1)},
+		{name: "minimum none match", script: `def run; [1, 4, 9].bsearch { |x| x > 100 }; end`, want: NewNil()},
+		{name: "minimum nil is false", script: `def run; [1, 4, 9].bsearch { |x| x > 3 ? true : nil }; end`, want: NewInt(4)},
+		{name: "minimum float thresholds", script: `def run; [0.5, 1.5, 2.5].bsearch { |x| x > 1.0 }; end`, want: NewFloat(1.5)},
+		{name: "any found", script: `def run; [1, 4, 9, 16, 25].bsearch { |x| 16 <=> x }; end`, want: NewInt(16)},
+		{name: "any range", script: `def run; [1, 4, 9, 16, 25].bsearch { |x| x < 5 ? 1 : (x > 10 ? -1 : 0) }; end`, want: NewInt(9)},
+		{name: "any missing", script: `def run; [1, 4, 9, 16, 25].bsearch { |x| 10 <=> x }; end`, want: NewNil()},
+		{name: "any float comparator", script: `def run; [1, 2, 3].bsearch { |x| 2.0 - x }; end`, want: NewInt(2)},
+		{name: "empty", script: `def run; [].bsearch { |x| true }; end`, want: NewNil()},
+		{name: "sorted hashes", script: `def run; [{ at: 10 }, { at: 20 }, { at: 30 }].bsearch { |e| e[:at] >= 15 }[:at]; end`, want: NewInt(20)},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			got := callFunc(t, compileScript(t, tc.script), "run", nil)
+			if got.Kind() != tc.want.Kind() || !got.Equal(tc.want) {
+				t.Fatalf("bsearch = %v (%v), want %v (%v)", got, got.Kind(), tc.want, tc.want.Kind())
+			}
+		})
+	}
+}
+
+func TestArrayBsearchErrors(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name   string
+		script string
+		want   string
+	}{
+		{name: "string result", script: `def run; [1, 2].bsearch { |x| "yes" }; end`, want: "array.bsearch block must return true, false, nil, or a number, got string"},
+		{name: "argument", script: `def run; [1, 2].bsearch(1) { |x| true }; end`, want: "array.bsearch does not take arguments"},
+		{name: "no block", script: `def run; [1, 2].bsearch; end`, want: "array.bsearch requires a block"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			requireCallErrorContains(t, compileScript(t, tc.script), "run", nil, CallOptions{}, tc.want)
+		})
+	}
+}
@@ -63,6 +63,40 @@ func TestArrayShovelOperator(t *testing.T) {
 	}
 }
 
+func TestStringShovelOperator(t *testing.T) {
+	t.Parallel()
+
+	script := compileScript(t, `
+    def accumulate()
+      line = ""
+      words = []
+      ["a", "b", "c"].each do |word|
+        line = line << word << "-"
+        words = words << word << word.upcase
+      end
+      [line, words]
+    end
+
+    def non_mutating()
+      greeting = "hi"
+      louder = greeting << "!"
+      [greeting, louder]
+    end
+    `)
+
+	got := callFunc(t, script, "accumulate", nil)
+	compareArrays(t, got, []Value{
+		NewString("a-b-c-"),
+		NewArray([]Value{
+			NewString("a"), NewString("A"),
+			NewString("b"), NewString("B"),
+			NewString("c"), NewString("C"),
+		}),
+	})
+	got = callFunc(t, script, "non_mutating", nil)
+	compareArrays(t, got, []Value{NewString("hi"), NewString("hi!")})
+}
+
 // TestArrayShovelIsNonMutating documents the Vibescript-specific divergence
 // from Ruby: a bare "values << x" expression statement produces a new array and
 // leaves the receiver unchanged, because the language's collections are
@@ -314,7 +348,11 @@ func TestCollectionOperatorErrors(t *testing.T) {
 
 	script := compileScript(t, `
     def shovel_non_array()
-      5 << 3
+      :five << 3
+    end
+
+    def shovel_non_string_onto_string()
+      "five" << 3
     end
 
     def intersect_non_array_left()
@@ -336,6 +374,11 @@ func TestCollectionOperatorErrors(t *testing.T) {
 			fn:   "shovel_non_array",
 			want: "unsupported shovel operands",
 		},
+		{
+			name: "shovel non-string onto string",
+			fn:   "shovel_non_string_onto_string",
+			want: "string << expects a string, got int",
+		},
 		{
 			name: "intersection with non-array left",
 			fn:   "intersect_non_array_left",
@@ -240,6 +240,115 @@ func TestArrayDeleteErrors(t *testing.T) {
 		"array.delete does not take keyword arguments")
 }
 
+func TestArrayDeleteIfAndRejectBang(t *testing.T) {
+	t.Parallel()
+	script := compileScript(t, `
+    def delete_if_even(values)
+      { result: values.delete_if { |v| v % 2 == 0 }, source: values }
+    end
+
+    def reject_bang_even(values)
+      { result: values.reject! { |v| v % 2 == 0 }, source: values }
+    end
+    `)
+
+	ints := func(ns ...int64) []Value {
+		out := make([]Value, len(ns))
+		for i, n := range ns {
+			out[i] = NewInt(n)
+		}
+		return out
+	}
+	tests := []struct {
+		name     string
+		function string
+		input    []Value
+		want     Value
+	}{
+		{"delete_if removes matches", "delete_if_even", ints(1, 2, 3, 4), NewArray(ints(1, 3))},
+		{"delete_if without matches", "delete_if_even", ints(1, 3), NewArray(ints(1, 3))},
+		{"reject! removes matches", "reject_bang_even", ints(1, 2, 3, 4), NewArray(ints(1, 3))},
+		{"reject! without matches is nil", "reject_bang_even", ints(1, 3), NewNil()},
+		{"reject! emptying the array", "reject_bang_even", ints(2, 4), NewArray(ints())},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			result := callFunc(t, script, tt.function, []Value{NewArray(tt.input)}).Hash()
+			compareArrays(t, result["source"], tt.input)
+			if diff := valueDiff(tt.want, result["result"]); diff != "" {
+				t.Fatalf("result mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestArrayDeleteAt(t *testing.T) {
+	t.Parallel()
+	script := compileScript(t, `
+    def delete_at(values, index)
+      result = values.delete_at(index)
+      { source: values, array: result[:array], deleted: result[:deleted] }
+    end
+    `)
+
+	abc := func() Value {
+		return NewArray([]Value{NewString("a"), NewString("b"), NewString("c")})
+	}
+	tests := []struct {
+		name        string
+		index       int64
+		wantArray   []Value
+		wantDeleted Value
+	}{
+		{"head", 0, []Value{NewString("b"), NewString("c")}, NewString("a")},
+		{"middle", 1, []Value{NewString("a"), NewString("c")}, NewString("b")},
+		{"tail", 2, []Value{NewString("a"), NewString("b")}, NewString("c")},
+		{"negative index", -1, []Value{NewString("a"), NewString("b")}, NewString("c")},
+		{"negative head", -3, []Value{NewString("b"), NewString("c")}, NewString("a")},
+		{"past the end", 3, []Value{NewString("a"), NewString("b"), NewString("c")}, NewNil()},
+		{"negative past the start", -4, []Value{NewString("a"), NewString("b"), NewString("c")}, NewNil()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			result := callFunc(t, script, "delete_at", []Value{abc(), NewInt(tt.index)}).Hash()
+			compareArrays(t, result["source"], abc().Array())
+			compareArrays(t, result["array"], tt.wantArray)
+			if diff := valueDiff(tt.wantDeleted, result["deleted"]); diff != "" {
+				t.Fatalf("deleted mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestArrayDeleteAtErrors(t *testing.T) {
+	t.Parallel()
+	script := compileScript(t, `
+    def no_args(values)
+      values.delete_at
+    end
+
+    def string_index(values)
+      values.delete_at("1")
+    end
+
+    def with_block(values)
+      values.delete_at(0) { |v| v }
+    end
+    `)
+
+	base := []Value{NewArray([]Value{NewInt(1)})}
+	requireCallErrorContains(t, script, "no_args", base, CallOptions{},
+		"array.delete_at expects an index")
+	requireCallErrorContains(t, script, "string_index", base, CallOptions{},
+		"array.delete_at index must be integer")
+	requireCallErrorContains(t, script, "with_block", base, CallOptions{},
+		"array.delete_at does not accept blocks")
+}
+
 func TestArrayShift(t *testing.T) {
 	t.Parallel()
 	script := compileScript(t, `
@@ -684,6 +793,20 @@ func TestArrayHelpersDoNotAliasReceiverBacking(t *testing.T) {
       values
     end
 
+    def delete_at_miss(values)
+      result = values.delete_at(99)
+      out = result[:array]
+      out[0] = 999
+      values
+    end
+
+    def delete_at_hit(values)
+      result = values.delete_at(1)
+      out = result[:array]
+      out[0] = 999
+      values
+    end
+
     def insert_noop(values)
       out = values.insert(1)
       out[0] = 999
@@ -718,6 +841,8 @@ func TestArrayHelpersDoNotAliasReceiverBacking(t *testing.T) {
 		{"shift(n) copies the receiver", "shift_count"},
 		{"pop(n) copies the receiver", "pop_count"},
 		{"delete on a miss copies the receiver", "delete_miss"},
+		{"delete_at out of range copies the receiver", "delete_at_miss"},
+		{"delete_at copies the remaining elements", "delete_at_hit"},
 		{"delete on a hit copies the receiver", "delete_hit"},
 		{"insert with no values copies the receiver", "insert_noop"},
 		{"insert with values copies the receiver", "insert_values"},
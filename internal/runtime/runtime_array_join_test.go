@@ -101,9 +101,9 @@ func TestArrayJoinSeparatorDiagnostics(t *testing.T) {
 }
 
 // TestArrayJoinGuards covers the cycle and depth protections that bound the
-// recursion. Scripts cannot build cyclic or 1024-deep arrays, so the guards are
-// exercised by calling arrayJoin directly with constructed structures, mirroring
-// how flattenValues guards recursion.
+// recursion. Building a 1024-deep array from a script is impractical, so the
+// guards are exercised by calling arrayJoin directly with constructed
+// structures, mirroring how flattenValues guards recursion.
 func TestArrayJoinGuards(t *testing.T) {
 	t.Parallel()
 
@@ -41,6 +41,21 @@ func TestArrayReduceSymbolShorthand(t *testing.T) {
 			source: `def run(); [1, 2, 3].reduce(10, "+"); end`,
 			want:   NewInt(16),
 		},
+		{
+			name:   "initial times symbol",
+			source: `def run(); [2, 3, 4].reduce(1, :*); end`,
+			want:   NewInt(24),
+		},
+		{
+			name:   "divide symbol",
+			source: `def run(); [100, 5, 2].reduce(:/); end`,
+			want:   NewInt(10),
+		},
+		{
+			name:   "modulo symbol",
+			source: `def run(); [100, 7].reduce(:%); end`,
+			want:   NewInt(2),
+		},
 		{
 			name:   "initial concat symbol",
 			source: `def run(); ["b", "c"].reduce("a", :concat); end`,
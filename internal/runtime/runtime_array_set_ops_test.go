@@ -186,6 +186,85 @@ func TestArrayDifference(t *testing.T) {
 	}
 }
 
+func TestArrayConcatAndIntersection(t *testing.T) {
+	t.Parallel()
+
+	script := compileScript(t, `
+    def concat_two()
+      [1, 2].concat([3, 4])
+    end
+
+    def concat_many_keeps_duplicates()
+      [1, 2].concat([2], [], [1, 3])
+    end
+
+    def concat_no_args()
+      [1, 1].concat
+    end
+
+    def concat_matches_plus(a, b)
+      a.concat(b) == a + b
+    end
+
+    def intersection_two()
+      [1, 1, 2, 3].intersection([1, 3, 4])
+    end
+
+    def intersection_many()
+      [1, 2, 3, 4].intersection([2, 3, 4], [4, 3])
+    end
+
+    def intersection_no_args()
+      [1, 1, 2].intersection
+    end
+
+    def intersection_matches_amp(a, b)
+      a.intersection(b) == (a & b)
+    end
+
+    def intersection_nested()
+      [[1], [2]].intersection([[2], [3]])
+    end
+    `)
+
+	ints := func(ns ...int64) Value {
+		out := make([]Value, len(ns))
+		for i, n := range ns {
+			out[i] = NewInt(n)
+		}
+		return NewArray(out)
+	}
+	tests := []struct {
+		name string
+		fn   string
+		args []Value
+		want Value
+	}{
+		{name: "concat appends", fn: "concat_two", want: ints(1, 2, 3, 4)},
+		{name: "concat keeps duplicates", fn: "concat_many_keeps_duplicates", want: ints(1, 2, 2, 1, 3)},
+		{name: "concat with no arguments copies", fn: "concat_no_args", want: ints(1, 1)},
+		{name: "concat matches +", fn: "concat_matches_plus", args: []Value{ints(1, 2), ints(3, 4)}, want: NewBool(true)},
+		{name: "intersection dedups in receiver order", fn: "intersection_two", want: ints(1, 3)},
+		{name: "intersection folds every argument", fn: "intersection_many", want: ints(3, 4)},
+		{name: "intersection with no arguments deduplicates", fn: "intersection_no_args", want: ints(1, 2)},
+		{name: "intersection matches &", fn: "intersection_matches_amp", args: []Value{ints(1, 2, 2, 3), ints(2, 3, 5)}, want: NewBool(true)},
+		{name: "intersection compares nested values", fn: "intersection_nested", want: NewArray([]Value{ints(2)})},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			got := callFunc(t, script, tc.fn, tc.args)
+			if diff := valueDiff(tc.want, got); diff != "" {
+				t.Fatalf("mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+
+	requireCallErrorContains(t, compileScript(t, `def run(); [1].concat(2); end`), "run", nil, CallOptions{}, "array.concat arguments must be arrays")
+	requireCallErrorContains(t, compileScript(t, `def run(); [1].intersection([1], "x"); end`), "run", nil, CallOptions{}, "array.intersection arguments must be arrays")
+}
+
 func TestArraySetOpsDoNotMutateReceiver(t *testing.T) {
 	t.Parallel()
 
@@ -574,3 +653,54 @@ func TestDifferenceArrayValuesRemovalIsNotQuadratic(t *testing.T) {
 			"removal-set construction is likely quadratic again", n)
 	}
 }
+
+func TestArraySetOpsUseEqlAtEveryDepth(t *testing.T) {
+	t.Parallel()
+
+	script := compileScript(t, `def scalars()
+  [[1, 1.0].uniq, [1, 2] - [1.0], [1, 2] & [2.0], [1].union([1.0])]
+end
+
+def nested()
+  [[[1], [1.0]].uniq, [[1], [2]] - [[1.0]], [[1], [2]] & [[2.0]], [[1]].union([[1.0]])]
+end
+
+def same_kind()
+  [[[1], [1]].uniq, [[1], [2]] - [[1]], [{ a: 1.5 }] & [{ a: 1.5 }]]
+end`)
+
+	one, oneFloat := NewInt(1), NewFloat(1)
+	nestedOne := NewArray([]Value{one})
+	nestedOneFloat := NewArray([]Value{oneFloat})
+	nestedTwo := NewArray([]Value{NewInt(2)})
+
+	got := callFunc(t, script, "scalars", nil).Array()
+	compareArrays(t, got[0], []Value{one, oneFloat})
+	compareArrays(t, got[1], []Value{one, NewInt(2)})
+	compareArrays(t, got[2], []Value{})
+	compareArrays(t, got[3], []Value{one, oneFloat})
+
+	got = callFunc(t, script, "nested", nil).Array()
+	for i, want := range [][]Value{
+		{nestedOne, nestedOneFloat},
+		{nestedOne, nestedTwo},
+		{},
+		{nestedOne, nestedOneFloat},
+	} {
+		if len(got[i].Array()) != len(want) {
+			t.Fatalf("nested[%d] = %s, want %d elements", i, got[i].Inspect(), len(want))
+		}
+		for j, elem := range got[i].Array() {
+			if !elem.Eql(want[j]) {
+				t.Fatalf("nested[%d] = %s, want %v", i, got[i].Inspect(), want)
+			}
+		}
+	}
+
+	got = callFunc(t, script, "same_kind", nil).Array()
+	for i, want := range []int{1, 1, 1} {
+		if n := len(got[i].Array()); n != want {
+			t.Fatalf("same_kind[%d] = %s, want %d elements", i, got[i].Inspect(), want)
+		}
+	}
+}
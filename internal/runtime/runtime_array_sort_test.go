@@ -0,0 +1,113 @@
+package runtime
+
+import "testing"
+
+func TestArraySortReportsIncomparablePair(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		expr string
+		want string
+	}{
+		{
+			name: "int and string",
+			expr: `[3, "a", 1].sort`,
+			want: "array.sort values are not comparable: cannot compare string with int",
+		},
+		{
+			name: "string and int",
+			expr: `["b", 2].sort`,
+			want: "array.sort values are not comparable: cannot compare int with string",
+		},
+		{
+			name: "nan among floats",
+			expr: `[2.0, 0.0 / 0.0, 1.0].sort`,
+			want: "array.sort values are not comparable: cannot compare float NaN with float",
+		},
+		{
+			name: "nan among ints",
+			expr: `[1, 0.0 / 0.0].sort`,
+			want: "array.sort values are not comparable: cannot compare float NaN with int",
+		},
+		{
+			name: "money currencies",
+			expr: `[money("1.00 USD"), money("2.00 EUR")].sort`,
+			want: "array.sort values are not comparable: cannot compare EUR money with USD money",
+		},
+		{
+			name: "sort_by mixed keys",
+			expr: `[1, 2].sort_by { |v| v == 1 ? "one" : v }`,
+			want: "array.sort_by block values are not comparable: cannot compare int with string",
+		},
+		{
+			name: "sort_by nan key",
+			expr: `[1, 2].sort_by { |v| v == 1 ? 0.0 / 0.0 : 1.0 }`,
+			want: "array.sort_by block values are not comparable: cannot compare float with float NaN",
+		},
+		{
+			name: "sort_by composite key mismatch",
+			expr: `[1, 2].sort_by { |v| [0, v == 1 ? "one" : v] }`,
+			want: "array.sort_by block values are not comparable: cannot compare int with string",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			script := compileScript(t, "def run()\n  "+tt.expr+"\nend")
+			requireCallErrorContains(t, script, "run", nil, CallOptions{}, tt.want)
+		})
+	}
+}
+
+func TestArraySortOrdersMixedNumbers(t *testing.T) {
+	t.Parallel()
+
+	script := compileScript(t, `def run()
+  [3, 1.5, -2, 2.0].sort
+end`)
+	got := callFunc(t, script, "run", nil)
+	compareArrays(t, got, []Value{NewInt(-2), NewFloat(1.5), NewFloat(2.0), NewInt(3)})
+}
+
+func TestArraySortByCompositeKey(t *testing.T) {
+	t.Parallel()
+
+	script := compileScript(t, `def run()
+  players = [
+    { name: "cy", tier: 2, score: 40 },
+    { name: "al", tier: 1, score: 90 },
+    { name: "bo", tier: 2, score: 10 },
+    { name: "di", tier: 1, score: 30 }
+  ]
+  players.sort_by { |p| [p[:tier], p[:score]] }.map { |p| p[:name] }
+end
+
+def prefixes()
+  [[1, 2], [1], [0, 5], [1, 1, 9]].sort
+end`)
+	compareArrays(t, callFunc(t, script, "run", nil), []Value{
+		NewString("di"), NewString("al"), NewString("bo"), NewString("cy"),
+	})
+	compareArrays(t, callFunc(t, script, "prefixes", nil), []Value{
+		NewArray([]Value{NewInt(0), NewInt(5)}),
+		NewArray([]Value{NewInt(1)}),
+		NewArray([]Value{NewInt(1), NewInt(1), NewInt(9)}),
+		NewArray([]Value{NewInt(1), NewInt(2)}),
+	})
+}
+
+func TestArraySortRejectsSelfReferencingKeys(t *testing.T) {
+	t.Parallel()
+
+	cyclic := func() Value {
+		elems := []Value{NewInt(1), NewNil()}
+		arr := NewArray(elems)
+		elems[1] = arr
+		return arr
+	}
+	script := compileScript(t, `def run(a, b)
+  [a, b].sort
+end`)
+	requireCallErrorContains(t, script, "run", []Value{cyclic(), cyclic()}, CallOptions{}, "array.sort values are not comparable: arrays nested more than 10000 levels deep")
+}
@@ -0,0 +1,64 @@
+package runtime
+
+import "testing"
+
+func TestArrayTallyByCountsComputedKeys(t *testing.T) {
+	t.Parallel()
+
+	script := compileScript(t, `
+def run(players)
+  players.tally_by { |p| p[:active] ? "active:" + p[:status] : "inactive" }
+end
+`)
+	players := NewArray([]Value{
+		NewHash(map[string]Value{"status": NewString("ready"), "active": NewBool(true)}),
+		NewHash(map[string]Value{"status": NewString("queued"), "active": NewBool(true)}),
+		NewHash(map[string]Value{"status": NewString("ready"), "active": NewBool(true)}),
+		NewHash(map[string]Value{"status": NewString("ready"), "active": NewBool(false)}),
+		NewHash(map[string]Value{"status": NewString("queued"), "active": NewBool(false)}),
+	})
+	got := callFunc(t, script, "run", []Value{players})
+	if got.Kind() != KindHash {
+		t.Fatalf("tally_by kind = %v, want hash", got.Kind())
+	}
+	compareHash(t, got.Hash(), map[string]Value{
+		"active:ready":  NewInt(2),
+		"active:queued": NewInt(1),
+		"inactive":      NewInt(2),
+	})
+}
+
+func TestArrayTallyByMatchesGroupBySizes(t *testing.T) {
+	t.Parallel()
+
+	script := compileScript(t, `
+def run
+  values = [1, 2, 3, 4, 5, 6, 7]
+  grouped = values.group_by { |v| v % 3 }.transform_values { |items| items.size }
+  tallied = values.tally_by { |v| v % 3 }
+  [tallied == grouped, tallied[1], [].tally_by { |v| v }]
+end
+`)
+	got := callFunc(t, script, "run", nil)
+	compareArrays(t, got, []Value{NewBool(true), NewInt(3), NewHash(map[string]Value{})})
+}
+
+func TestArrayTallyByErrors(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name   string
+		script string
+		want   string
+	}{
+		{name: "no block", script: `def run; [1, 2].tally_by; end`, want: "array.tally_by requires a block"},
+		{name: "argument", script: `def run; [1, 2].tally_by(1) { |v| v }; end`, want: "array.tally_by does not take arguments"},
+		{name: "unsupported key", script: `def run; [1, 2].tally_by { |v| 0.0 / 0.0 }; end`, want: "array.tally_by value is unsupported hash key"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			requireCallErrorContains(t, compileScript(t, tc.script), "run", nil, CallOptions{}, tc.want)
+		})
+	}
+}
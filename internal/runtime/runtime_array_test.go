@@ -2,6 +2,7 @@ package runtime
 
 import (
 	"context"
+	"strings"
 	"testing"
 )
 
@@ -752,6 +753,10 @@ func TestArrayFlattenDepthArguments(t *testing.T) {
       [1, [2, [3]]].flatten("1")
     end
 
+    def flatten_huge()
+      [1, [2, [3]]].flatten(1_000_000_000)
+    end
+
     def flatten_too_many()
       [1, [2, [3]]].flatten(1, 2)
     end
@@ -759,7 +764,7 @@ func TestArrayFlattenDepthArguments(t *testing.T) {
 
 	// nil, no argument, and any negative depth flatten fully, matching Ruby.
 	full := []Value{NewInt(1), NewInt(2), NewInt(3)}
-	for _, fn := range []string{"flatten_default", "flatten_nil", "flatten_negative", "flatten_deep_negative", "flatten_two"} {
+	for _, fn := range []string{"flatten_default", "flatten_nil", "flatten_negative", "flatten_deep_negative", "flatten_two", "flatten_huge"} {
 		compareArrays(t, callFunc(t, script, fn, nil), full)
 	}
 
@@ -779,6 +784,66 @@ func TestArrayFlattenDepthArguments(t *testing.T) {
 	requireCallErrorContains(t, script, "flatten_too_many", nil, CallOptions{}, "array.flatten accepts at most one depth argument")
 }
 
+// TestFlattenValuesDeepNesting exercises flattenValues on structures scripts
+// cannot build directly: nesting hundreds of levels deep and a
+// self-referential array.
+func TestFlattenValuesDeepNesting(t *testing.T) {
+	t.Parallel()
+
+	const levels = 500
+	deep := []Value{NewInt(levels)}
+	for i := levels - 1; i >= 0; i-- {
+		deep = []Value{NewInt(int64(i)), NewArray(deep)}
+	}
+
+	full, err := flattenValues(deep, -1, "array.flatten")
+	if err != nil {
+		t.Fatalf("full flatten: %v", err)
+	}
+	if len(full) != levels+1 {
+		t.Fatalf("full flatten length = %d, want %d", len(full), levels+1)
+	}
+	for i, v := range full {
+		if v.Kind() != KindInt || v.Int() != int64(i) {
+			t.Fatalf("full flatten[%d] = %v, want %d", i, v, i)
+		}
+	}
+
+	// A depth far beyond the nesting matches a full flatten.
+	huge, err := flattenValues(deep, 1<<40, "array.flatten")
+	if err != nil {
+		t.Fatalf("huge depth flatten: %v", err)
+	}
+	compareArrays(t, NewArray(huge), full)
+
+	// A partial depth stops with the remaining nesting intact.
+	partial, err := flattenValues(deep, 3, "array.flatten")
+	if err != nil {
+		t.Fatalf("partial flatten: %v", err)
+	}
+	if len(partial) != 5 || partial[3].Int() != 3 || partial[4].Kind() != KindArray {
+		t.Fatalf("partial flatten = %v, want four ints then the nested rest", NewArray(partial))
+	}
+
+	// Depth zero is a shallow copy that never descends.
+	cyclic := make([]Value, 2)
+	cyclic[0] = NewInt(1)
+	cyclic[1] = NewArray(cyclic)
+	shallow, err := flattenValues(cyclic, 0, "array.flatten")
+	if err != nil {
+		t.Fatalf("flatten(0) on cyclic array: %v", err)
+	}
+	if len(shallow) != 2 || &shallow[0] == &cyclic[0] {
+		t.Fatalf("flatten(0) should return a distinct two-element copy")
+	}
+
+	for _, depth := range []int{-1, 1, 1 << 40} {
+		if _, err := flattenValues(cyclic, depth, "array.flatten"); err == nil || !strings.Contains(err.Error(), "array.flatten does not support cyclic structures") {
+			t.Fatalf("flatten(%d) on cyclic array error = %v, want cyclic structure error", depth, err)
+		}
+	}
+}
+
 func TestArrayConcatAndSubtract(t *testing.T) {
 	t.Parallel()
 	script := compileScript(t, `
@@ -1539,6 +1604,65 @@ func TestArraySum(t *testing.T) {
 	}
 }
 
+func TestArraySumMoney(t *testing.T) {
+	t.Parallel()
+
+	usd := func(cents int64) Value {
+		m, err := newMoneyFromCents(cents, "USD")
+		if err != nil {
+			t.Fatalf("newMoneyFromCents: %v", err)
+		}
+		return NewMoney(m)
+	}
+	tests := []struct {
+		name string
+		body string
+		want Value
+	}{
+		{
+			name: "sums same-currency money",
+			body: `[money("1.25 USD"), money("2.50 USD"), money("0.25 USD")].sum`,
+			want: usd(400),
+		},
+		{
+			name: "block results may be money",
+			body: `[{ amount: money("3.00 USD") }, { amount: money("4.00 USD") }].sum { |d| d[:amount] }`,
+			want: usd(700),
+		},
+		{
+			name: "initial keyword seeds the total",
+			body: `[money("1.00 USD")].sum(initial: money("5.00 USD"))`,
+			want: usd(600),
+		},
+		{
+			name: "initial keyword covers the empty array",
+			body: `[].sum(initial: money("0.00 USD"))`,
+			want: usd(0),
+		},
+		{
+			name: "empty array without initial stays zero",
+			body: `[].sum`,
+			want: NewInt(0),
+		},
+		{
+			name: "numbers still sum",
+			body: `[1, 2.5].sum(initial: 1)`,
+			want: NewFloat(4.5),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			script := compileScriptDefault(t, "def run()\n  "+tt.body+"\nend\n")
+			got := callFunc(t, script, "run", nil)
+			if got.Kind() != tt.want.Kind() || !got.Equal(tt.want) {
+				t.Fatalf("%s = %v (%v), want %v (%v)", tt.body, got, got.Kind(), tt.want, tt.want.Kind())
+			}
+		})
+	}
+}
+
 func TestArraySumErrors(t *testing.T) {
 	t.Parallel()
 
@@ -1568,9 +1692,29 @@ func TestArraySumErrors(t *testing.T) {
 			want: "array.sum accepts at most an initial value",
 		},
 		{
-			name: "rejects keyword arguments",
+			name: "rejects unknown keyword arguments",
 			body: `[1].sum(foo: 1)`,
-			want: "array.sum does not take keyword arguments",
+			want: "array.sum does not accept keyword foo",
+		},
+		{
+			name: "rejects both initial forms",
+			body: `[1].sum(0, initial: 0)`,
+			want: "array.sum accepts an initial value or initial:, not both",
+		},
+		{
+			name: "money currencies must match",
+			body: `[money("1.00 USD"), money("2.00 EUR")].sum`,
+			want: "array.sum cannot add EUR money to a USD total",
+		},
+		{
+			name: "money initial currency must match",
+			body: `[money("1.00 EUR")].sum(initial: money("0.00 USD"))`,
+			want: "array.sum cannot add EUR money to a USD total",
+		},
+		{
+			name: "numbers cannot mix with money",
+			body: `[1, money("1.00 USD")].sum`,
+			want: "array.sum cannot add incompatible values",
 		},
 	}
 
@@ -3,6 +3,7 @@ package runtime
 import (
 	"bytes"
 	"context"
+	"fmt"
 	"io"
 	"strings"
 	"sync"
@@ -102,6 +103,62 @@ end`)
 	}
 }
 
+func TestOutputHelperPPPassesValueThrough(t *testing.T) {
+	t.Parallel()
+
+	var stdout bytes.Buffer
+	script := compileScriptWithConfig(t, Config{OutputWriter: &stdout}, `def compute()
+  { name: "vibe", tags: [:a, "b"] }
+end
+
+def run()
+  doubled = [3, 1, 2].sort.pp.map { |x| x * 2 }
+  record = compute().pp
+  label = pp("total")
+  pair = pp(1, nil)
+  { doubled: doubled, record: record, label: label, pair: pair }
+end`)
+
+	result := callFunc(t, script, "run", nil)
+	compareHash(t, result.Hash(), map[string]Value{
+		"doubled": arrayVal(intVal(2), intVal(4), intVal(6)),
+		"record": hashVal(map[string]Value{
+			"name": strVal("vibe"),
+			"tags": arrayVal(NewSymbol("a"), strVal("b")),
+		}),
+		"label": strVal("total"),
+		"pair":  arrayVal(intVal(1), NewNil()),
+	})
+	want := "[1, 2, 3]\n{name: \"vibe\", tags: [:a, \"b\"]}\n\"total\"\n1\nnil\n"
+	if got := stdout.String(); got != want {
+		t.Fatalf("stdout = %q, want %q", got, want)
+	}
+}
+
+func TestOutputHelperPPErrors(t *testing.T) {
+	t.Parallel()
+
+	script := compileScript(t, `def builtin_without_writer()
+  pp(1)
+end
+
+def member_without_writer()
+  1.pp
+end`)
+	requireCallErrorContains(t, script, "builtin_without_writer", nil, CallOptions{}, "pp output writer is not configured")
+	requireCallErrorContains(t, script, "member_without_writer", nil, CallOptions{}, "pp output writer is not configured")
+
+	withWriter := compileScriptWithConfig(t, Config{OutputWriter: io.Discard}, `def member_argument()
+  1.pp(2)
+end
+
+def builtin_keyword()
+  pp(1, label: "x")
+end`)
+	requireCallErrorContains(t, withWriter, "member_argument", nil, CallOptions{}, "int.pp does not take arguments")
+	requireCallErrorContains(t, withWriter, "builtin_keyword", nil, CallOptions{}, "pp does not accept keyword arguments")
+}
+
 func TestDurationMethods(t *testing.T) {
 	t.Parallel()
 	script := compileScript(t, `
@@ -1205,6 +1262,78 @@ func TestJSONBuiltins(t *testing.T) {
 	requireCallErrorContains(t, script, "stringify_unsupported", nil, CallOptions{}, "JSON.stringify unsupported value type function")
 }
 
+func TestJSONParseLines(t *testing.T) {
+	t.Parallel()
+	script := compileScript(t, `
+    def parse_log()
+      JSON.parse_lines("{\"level\":\"info\",\"n\":1}\n\n  \n[1,2]\n\"done\"\n")
+    end
+
+    def parse_empty()
+      JSON.parse_lines("")
+    end
+
+    def parse_malformed()
+      JSON.parse_lines("{\"ok\":true}\n\n{\"ok\":tru}\n{}")
+    end
+    `)
+
+	parsed := callFunc(t, script, "parse_log", nil)
+	if parsed.Kind() != KindArray || len(parsed.Array()) != 3 {
+		t.Fatalf("parse_log = %s, want 3 values", parsed.Inspect())
+	}
+	first := parsed.Array()[0].Hash()
+	if !first["level"].Equal(NewString("info")) || !first["n"].Equal(NewInt(1)) {
+		t.Fatalf("first line = %s", parsed.Array()[0].Inspect())
+	}
+	compareArrays(t, parsed.Array()[1], []Value{NewInt(1), NewInt(2)})
+	if !parsed.Array()[2].Equal(NewString("done")) {
+		t.Fatalf("last line = %s, want done", parsed.Array()[2].Inspect())
+	}
+
+	compareArrays(t, callFunc(t, script, "parse_empty", nil), []Value{})
+	requireCallErrorContains(t, script, "parse_malformed", nil, CallOptions{}, "JSON.parse_lines invalid JSON at line 3, column 7")
+}
+
+func TestJSONDig(t *testing.T) {
+	t.Parallel()
+	script := compileScript(t, `
+    def payload()
+      JSON.parse("{\"data\":{\"items\":[{\"id\":1,\"tags\":[\"a\",\"b\"]},{\"id\":2}]},\"total\":2}")
+    end
+
+    def dig_all()
+      data = payload()
+      [
+        JSON.dig(data, "total"),
+        JSON.dig(data, "data.items.1.id"),
+        JSON.dig(data, "data.items.0.tags.-1"),
+        JSON.dig(data, "data.items.5.id"),
+        JSON.dig(data, "data.missing.id"),
+        JSON.dig(data, "total.deeper"),
+        JSON.dig(data, "data.items.first"),
+        JSON.dig(data, "").size
+      ]
+    end
+
+    def dig_bad_path()
+      JSON.dig({}, 1)
+    end
+    `)
+
+	compareArrays(t, callFunc(t, script, "dig_all", nil), []Value{
+		NewInt(2),
+		NewInt(2),
+		NewString("b"),
+		NewNil(),
+		NewNil(),
+		NewNil(),
+		NewNil(),
+		NewInt(2),
+	})
+	requireCallErrorContains(t, script, "dig_bad_path", nil, CallOptions{}, "JSON.dig expects a value and a string path")
+}
+
 func TestJSONParseObjectDataExposesEntries(t *testing.T) {
 	t.Parallel()
 
@@ -1955,6 +2084,44 @@ func TestNowBuiltin(t *testing.T) {
 	})
 }
 
+func TestCallOptionsClockDrivesCurrentTime(t *testing.T) {
+	t.Parallel()
+	script := compileScript(t, `
+    def current()
+      {
+        now: now,
+        time_now: Time.now(in: "America/New_York").to_s,
+        from_now: 90.seconds.from_now().to_s,
+        ago: 1.day.ago().to_s,
+        explicit: 60.seconds.after(Time.utc(2024, 1, 1)).to_s,
+        uuid_ms: uuid[0, 8]
+      }
+    end
+    `)
+
+	fixed := time.Date(2026, 3, 14, 15, 9, 26, 0, time.UTC)
+	result, err := script.Call(context.Background(), "current", nil, CallOptions{
+		Clock: func() time.Time { return fixed },
+	})
+	if err != nil {
+		t.Fatalf("call failed: %v", err)
+	}
+	got := result.Hash()
+	want := map[string]string{
+		"now":      "2026-03-14T15:09:26Z",
+		"time_now": "2026-03-14T11:09:26-04:00",
+		"from_now": "2026-03-14T15:10:56Z",
+		"ago":      "2026-03-13T15:09:26Z",
+		"explicit": "2024-01-01T00:01:00Z",
+		"uuid_ms":  fmt.Sprintf("%08x", fixed.UnixMilli()>>16),
+	}
+	for key, wantValue := range want {
+		if val := got[key]; val.Kind() != KindString || val.String() != wantValue {
+			t.Fatalf("current()[%s] = %v, want %s", key, val, wantValue)
+		}
+	}
+}
+
 func TestTimeISO8601Precision(t *testing.T) {
 	t.Parallel()
 	cases := []struct {
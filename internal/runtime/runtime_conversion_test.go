@@ -106,17 +106,17 @@ func TestArrayToHashRejectsMisuse(t *testing.T) {
 		{
 			name:    "non-array element",
 			source:  "def run() [:a, :b].to_h end",
-			wantErr: "array.to_h expects an array of two-element pairs",
+			wantErr: "array.to_h expects an array of two-element pairs, got symbol at index 0",
 		},
 		{
 			name:    "pair too short",
 			source:  "def run() [[:a]].to_h end",
-			wantErr: "array.to_h pair must have exactly two elements",
+			wantErr: "array.to_h pair must have exactly two elements, got 1 at index 0",
 		},
 		{
 			name:    "pair too long",
-			source:  "def run() [[:a, 1, 2]].to_h end",
-			wantErr: "array.to_h pair must have exactly two elements",
+			source:  "def run() [[:a, 1], [:b, 2], [:c, 1, 2]].to_h end",
+			wantErr: "array.to_h pair must have exactly two elements, got 3 at index 2",
 		},
 		{
 			name:    "unsupported key type",
@@ -126,7 +126,7 @@ func TestArrayToHashRejectsMisuse(t *testing.T) {
 		{
 			name:    "block returns a non-pair",
 			source:  "def run() [:a].to_h { |s| s } end",
-			wantErr: "array.to_h expects an array of two-element pairs",
+			wantErr: "array.to_h expects an array of two-element pairs, got symbol at index 0",
 		},
 		{
 			name:    "positional argument",
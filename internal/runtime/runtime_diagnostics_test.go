@@ -617,3 +617,43 @@ func TestRuntimeErrorNoCallStack(t *testing.T) {
 		t.Fatalf("expected at least one frame")
 	}
 }
+
+func TestRuntimeErrorUnderlinesWholeCall(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name      string
+		body      string
+		underline string
+	}{
+		{
+			name:      "builtin_member_call",
+			body:      "items = [1, 2]\n  items.fetch(10) + 1",
+			underline: "   |   ^^^^^^^^^^^^^^^",
+		},
+		{
+			name:      "nested_call_keeps_inner_span",
+			body:      "items = [1, 2]\n  items.fetch(10).to_s",
+			underline: "   |   ^^^^^^^^^^^^^^^",
+		},
+		{
+			name:      "error_inside_callee_keeps_caret",
+			body:      "[1].map { |n| n / 0 }",
+			underline: "   |                   ^",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			script := compileScript(t, "def run()\n  "+tc.body+"\nend")
+			_, err := script.Call(context.Background(), "run", nil, CallOptions{})
+			var rtErr *RuntimeError
+			if !errors.As(err, &rtErr) {
+				t.Fatalf("expected RuntimeError, got %v", err)
+			}
+			lines := strings.Split(rtErr.CodeFrame, "\n")
+			if got := lines[len(lines)-1]; got != tc.underline {
+				t.Fatalf("underline = %q, want %q\n%s", got, tc.underline, rtErr.CodeFrame)
+			}
+		})
+	}
+}
@@ -3,6 +3,7 @@ package runtime
 import (
 	"context"
 	"errors"
+	"strconv"
 	"testing"
 )
 
@@ -122,6 +123,45 @@ end`)
 	compareArrays(t, got["mixed_group_symbol"], []Value{NewSymbol("name")})
 }
 
+func TestHashIterationOrderIsSharedAcrossWalks(t *testing.T) {
+	t.Parallel()
+
+	script := compileScript(t, `def run()
+  hash = { 10 => "ten", -5 => "neg five", "b" => "string", :a => "symbol", 2.5 => "float", -10 => "neg ten", 10.5 => "big float", nil => "nil" }
+  each_keys = []
+  each_values = []
+  hash.each do |key, value|
+    each_keys = each_keys + [key]
+    each_values = each_values + [value]
+  end
+  key_walk = []
+  hash.each_key { |key| key_walk = key_walk + [key] }
+  [hash.keys, hash.values, each_keys, each_values, hash.to_a.map { |pair| pair[0] }, key_walk]
+end`)
+
+	wantKeys := []Value{
+		NewNil(),
+		NewInt(-10), NewInt(-5), NewInt(10),
+		NewFloat(2.5), NewFloat(10.5),
+		NewString("b"),
+		NewSymbol("a"),
+	}
+	wantValues := []Value{
+		NewString("nil"),
+		NewString("neg ten"), NewString("neg five"), NewString("ten"),
+		NewString("float"), NewString("big float"),
+		NewString("string"),
+		NewString("symbol"),
+	}
+	got := callFunc(t, script, "run", nil).Array()
+	compareArrays(t, got[0], wantKeys)
+	compareArrays(t, got[1], wantValues)
+	compareArrays(t, got[2], wantKeys)
+	compareArrays(t, got[3], wantValues)
+	compareArrays(t, got[4], wantKeys)
+	compareArrays(t, got[5], wantKeys)
+}
+
 func TestTypedHashAnnotationsUseOriginalKeyValues(t *testing.T) {
 	t.Parallel()
 
@@ -253,6 +293,59 @@ end`)
 	})
 }
 
+// TestSymbolAndStringKeysStayDistinctInLookups pins down that key lookups
+// never convert between a symbol and the string with the same name: a
+// symbol-keyed entry is invisible to string keys and vice versa.
+func TestSymbolAndStringKeysStayDistinctInLookups(t *testing.T) {
+	t.Parallel()
+
+	script := compileScript(t, `def run()
+  sym = { name: 1 }
+  str = { "name" => 1 }
+  {
+    slice_sym_by_str: sym.slice("name"),
+    slice_str_by_sym: str.slice(:name),
+    slice_sym_by_sym: sym.slice(:name).keys,
+    except_sym_by_str: sym.except("name").keys,
+    except_str_by_sym: str.except(:name).keys,
+    except_str_by_str: str.except("name"),
+    fetch_sym_by_str: sym.fetch("name", :missing),
+    fetch_str_by_sym: str.fetch(:name, :missing),
+    dig_sym_by_str: sym.dig("name"),
+    dig_str_by_sym: str.dig(:name),
+    key_sym_by_str: sym.key?("name"),
+    key_str_by_sym: str.key?(:name)
+  }
+end
+
+def strict_fetch()
+  { name: 1 }.fetch("name")
+end`)
+
+	got := callFunc(t, script, "run", nil).Hash()
+	empty := NewHash(map[string]Value{})
+	checks := map[string]Value{
+		"slice_sym_by_str":  empty,
+		"slice_str_by_sym":  empty,
+		"slice_sym_by_sym":  NewArray([]Value{NewSymbol("name")}),
+		"except_sym_by_str": NewArray([]Value{NewSymbol("name")}),
+		"except_str_by_sym": NewArray([]Value{NewString("name")}),
+		"except_str_by_str": empty,
+		"fetch_sym_by_str":  NewSymbol("missing"),
+		"fetch_str_by_sym":  NewSymbol("missing"),
+		"dig_sym_by_str":    NewNil(),
+		"dig_str_by_sym":    NewNil(),
+		"key_sym_by_str":    NewBool(false),
+		"key_str_by_sym":    NewBool(false),
+	}
+	for key, want := range checks {
+		if got := got[key]; !got.Equal(want) {
+			t.Fatalf("%s = %s, want %s", key, got.Inspect(), want.Inspect())
+		}
+	}
+	requireCallErrorContains(t, script, "strict_fetch", nil, CallOptions{}, `hash.fetch key not found: "name"`)
+}
+
 func TestTypedHashMergeDoesNotMaterializeReceiverMirror(t *testing.T) {
 	t.Parallel()
 
@@ -1199,6 +1292,78 @@ func TestHashEachBlockArgumentShape(t *testing.T) {
 	}
 }
 
+func TestHashFilterMap(t *testing.T) {
+	t.Parallel()
+	script := compileScript(t, `
+		def present_keys(h)
+			h.filter_map { |key, value| value ? key : nil }
+		end
+
+		def labels(h)
+			h.filter_map do |key, value|
+				if value > 1
+					"#{key}=#{value}"
+				end
+			end
+		end
+
+		def none_kept(h)
+			h.filter_map { |key, value| nil }
+		end
+
+		def typed_keys()
+			{ 1 => "one", 2 => nil }.filter_map { |key, value| value ? key : nil }
+		end
+	`)
+
+	tests := []struct {
+		name string
+		fn   string
+		args []Value
+		want []Value
+	}{
+		{
+			name: "drops entries whose block returns nil",
+			fn:   "present_keys",
+			args: []Value{NewHash(map[string]Value{"b": NewNil(), "a": NewInt(1), "c": NewString("x")})},
+			want: []Value{NewSymbol("a"), NewSymbol("c")},
+		},
+		{
+			name: "keeps block results in sorted key order",
+			fn:   "labels",
+			args: []Value{NewHash(map[string]Value{"z": NewInt(3), "m": NewInt(1), "a": NewInt(2)})},
+			want: []Value{NewString("a=2"), NewString("z=3")},
+		},
+		{
+			name: "all nil yields an empty array",
+			fn:   "none_kept",
+			args: []Value{NewHash(map[string]Value{"a": NewInt(1)})},
+			want: []Value{},
+		},
+		{
+			name: "empty hash",
+			fn:   "present_keys",
+			args: []Value{NewHash(map[string]Value{})},
+			want: []Value{},
+		},
+		{
+			name: "typed keys are yielded as-is",
+			fn:   "typed_keys",
+			want: []Value{NewInt(1)},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			compareArrays(t, callFunc(t, script, tt.fn, tt.args), tt.want)
+		})
+	}
+
+	requireCallErrorContains(t, compileScript(t, `def run(); { a: 1 }.filter_map; end`), "run", nil, CallOptions{}, "hash.filter_map requires a block")
+	requireCallErrorContains(t, compileScript(t, `def run(); { a: 1 }.filter_map(1) { |k, v| k }; end`), "run", nil, CallOptions{}, "hash.filter_map does not take arguments")
+}
+
 func TestHashEachReturnsReceiver(t *testing.T) {
 	t.Parallel()
 	script := compileScript(t, `
@@ -1808,7 +1973,8 @@ func TestHashValuePredicates(t *testing.T) {
 		{name: "value? nested hash present", method: "value?", hash: "{ a: { b: 1 } }", value: "{ b: 1 }", want: true},
 		{name: "has_value? int present", method: "has_value?", hash: "{ a: 1 }", value: "1", want: true},
 		{name: "has_value? int absent", method: "has_value?", hash: "{ a: 1 }", value: "2", want: false},
-		{name: "has_value? distinguishes int and float", method: "has_value?", hash: "{ a: 1 }", value: "1.0", want: false},
+		{name: "has_value? matches int and float numerically", method: "has_value?", hash: "{ a: 1 }", value: "1.0", want: true},
+		{name: "has_value? rejects fractional float", method: "has_value?", hash: "{ a: 1 }", value: "1.5", want: false},
 	}
 
 	for _, tt := range tests {
@@ -2118,3 +2284,149 @@ func TestHashValueOmissionUndefinedLocal(t *testing.T) {
 
 	requireCallErrorContains(t, script, "build", nil, CallOptions{}, "undefined variable name")
 }
+
+func TestHashTransformEntries(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		expr string
+		want string
+	}{
+		{
+			name: "renames keys and modifies values in one pass",
+			expr: `{ apple_cents: 120, pear_cents: 95 }.transform_entries { |key, cents| [key.to_s.delete_suffix("_cents").to_sym, cents / 100.0] }`,
+			want: "{apple: 1.2, pear: 0.95}",
+		},
+		{
+			name: "typed keys keep their identity",
+			expr: `{ "a" => 1, 2 => 3 }.transform_entries { |key, value| [value, key] }`,
+			want: `{1: "a", 3: 2}`,
+		},
+		{
+			name: "yields in sorted key order and later pairs win",
+			expr: `{ b: 2, c: 3, a: 1 }.transform_entries { |key, value| [:all, key] }`,
+			want: "{all: :c}",
+		},
+		{
+			name: "empty hash",
+			expr: `{}.transform_entries { |key, value| [key, value] }`,
+			want: "{}",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			script := compileScript(t, "def run()\n  "+tc.expr+"\nend")
+			got := callFunc(t, script, "run", nil)
+			if got.Kind() != KindHash {
+				t.Fatalf("expected hash, got %v", got.Kind())
+			}
+			if rendered := got.Inspect(); rendered != tc.want {
+				t.Fatalf("transform_entries = %s, want %s", rendered, tc.want)
+			}
+		})
+	}
+}
+
+func TestHashTransformEntriesRejectsMisuse(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		expr string
+		want string
+	}{
+		{name: "missing block", expr: "{ a: 1 }.transform_entries", want: "hash.transform_entries requires a block"},
+		{name: "argument", expr: "{ a: 1 }.transform_entries(1) { |k, v| [k, v] }", want: "hash.transform_entries does not take arguments"},
+		{name: "non-pair result", expr: "{ a: 1 }.transform_entries { |k, v| k }", want: "hash.transform_entries block must return a [key, value] pair"},
+		{name: "three-element result", expr: "{ a: 1 }.transform_entries { |k, v| [k, v, v] }", want: "hash.transform_entries block must return a [key, value] pair"},
+		{name: "unsupported key", expr: "{ a: 1 }.transform_entries { |k, v| [{ x: 1 }, v] }", want: "hash.transform_entries block returned unsupported hash key"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			script := compileScript(t, "def run()\n  "+tc.expr+"\nend")
+			requireCallErrorContains(t, script, "run", nil, CallOptions{}, tc.want)
+		})
+	}
+}
+
+func TestHashSubsetComparisons(t *testing.T) {
+	t.Parallel()
+
+	script := compileScript(t, `def run()
+  expected = { role: "admin", active: true }
+  actual = { role: "admin", active: true, name: "alex" }
+  other = { role: "viewer", active: true }
+  [
+    expected <= actual,
+    expected < actual,
+    actual <= expected,
+    actual > expected,
+    actual >= expected,
+    expected <= expected,
+    expected < expected,
+    expected <= other,
+    expected >= other,
+    {} <= expected,
+    { "role" => "admin" } <= actual,
+    { tags: [1, 2] } <= { tags: [1, 2], id: 1 }
+  ]
+end`)
+
+	compareArrays(t, callFunc(t, script, "run", nil), []Value{
+		NewBool(true),
+		NewBool(true),
+		NewBool(false),
+		NewBool(true),
+		NewBool(true),
+		NewBool(true),
+		NewBool(false),
+		NewBool(false),
+		NewBool(false),
+		NewBool(true),
+		NewBool(false),
+		NewBool(true),
+	})
+}
+
+func TestHashSubsetComparisonsUseValueEquality(t *testing.T) {
+	t.Parallel()
+
+	script := compileScriptWithConfig(t, Config{StepQuota: 200}, `class Version
+  def initialize(number)
+    @number = number
+  end
+
+  def number
+    @number
+  end
+
+  def ==(other)
+    @number == other.number
+  end
+end
+
+def run()
+  [
+    { current: Version.new(1) } <= { current: Version.new(1), id: 7 },
+    { current: Version.new(2) } <= { current: Version.new(1), id: 7 }
+  ]
+end
+
+def compare(left, right)
+  left <= right
+end`)
+
+	compareArrays(t, callFunc(t, script, "run", nil), []Value{NewBool(true), NewBool(false)})
+
+	entries := make(map[string]Value, 500)
+	for i := range 500 {
+		entries["k"+strconv.Itoa(i)] = NewInt(int64(i))
+	}
+	large := NewHash(entries)
+	requireCallErrorContains(t, script, "compare", []Value{large, large}, CallOptions{}, "step quota exceeded")
+}
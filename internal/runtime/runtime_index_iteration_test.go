@@ -39,6 +39,23 @@ func TestIndexAwareIterationHappyPaths(t *testing.T) {
 			source: `def run(); [10, 20, 30].map_with_index do |value, index| value + index end; end`,
 			want:   []Value{NewInt(10), NewInt(21), NewInt(32)},
 		},
+		{
+			name:   "array select_with_index keeps elements by index",
+			source: `def run(); ["a", "b", "c", "d"].select_with_index do |value, index| index.even? end; end`,
+			want:   []Value{NewString("a"), NewString("c")},
+		},
+		{
+			name:   "array select_with_index combines value and index",
+			source: `def run(); [5, 1, 7, 2].select_with_index { |value, index| value > index * 2 }; end`,
+			want:   []Value{NewInt(5), NewInt(7)},
+		},
+		{
+			name: "array map_with_index numbered report lines",
+			source: `def run()
+  ["north", "south"].map_with_index { |region, i| "#{i + 1}. #{region}" }
+end`,
+			want: []Value{NewString("1. north"), NewString("2. south")},
+		},
 		{
 			// Ruby's Hash#each_with_index yields the [key, value] pair plus the
 			// index; Vibescript visits entries in sorted key order so the index is
@@ -185,6 +202,16 @@ func TestIndexAwareIterationErrors(t *testing.T) {
 			source: `def run(); [1, 2].map_with_index(foo: 1) do |v, i| v end; end`,
 			want:   "array.map_with_index does not take keyword arguments",
 		},
+		{
+			name:   "array select_with_index without block",
+			source: `def run(); [1, 2].select_with_index; end`,
+			want:   "array.select_with_index requires a block",
+		},
+		{
+			name:   "array select_with_index with arguments",
+			source: `def run(); [1, 2].select_with_index(1) do |v, i| v end; end`,
+			want:   "array.select_with_index does not take arguments",
+		},
 		{
 			name:   "hash each_with_index without block",
 			source: `def run(); { a: 1 }.each_with_index; end`,
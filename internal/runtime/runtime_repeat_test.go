@@ -0,0 +1,69 @@
+package runtime
+
+import "testing"
+
+func TestStringAndArrayRepetition(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		expr string
+		want Value
+	}{
+		{name: "string repeat", expr: `"ab" * 3`, want: NewString("ababab")},
+		{name: "string repeat zero", expr: `"ab" * 0`, want: NewString("")},
+		{name: "compound string repeat", expr: `line = "-"
+  line *= 4
+  line`, want: NewString("----")},
+		{name: "array repeat", expr: `[0] * 3`, want: NewArray([]Value{NewInt(0), NewInt(0), NewInt(0)})},
+		{name: "array repeat keeps order", expr: `[1, 2] * 2`, want: NewArray([]Value{NewInt(1), NewInt(2), NewInt(1), NewInt(2)})},
+		{name: "empty array repeat", expr: `[] * 5`, want: NewArray([]Value{})},
+		{name: "empty array huge repeat", expr: `[] * 9223372036854775807`, want: NewArray([]Value{})},
+		{name: "empty string huge repeat", expr: `"" * 9223372036854775807`, want: NewString("")},
+		{name: "array join", expr: `[1, 2] * ","`, want: NewString("1,2")},
+		{name: "nested array join", expr: `[1, [2, 3]] * "-"`, want: NewString("1-2-3")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			script := compileScript(t, "def run()\n  "+tt.expr+"\nend")
+			got := callFunc(t, script, "run", nil)
+			if !got.Equal(tt.want) {
+				t.Fatalf("%s = %v, want %v", tt.expr, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestStringAndArrayRepetitionErrors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		expr string
+		want string
+	}{
+		{name: "negative string count", expr: `"ab" * -1`, want: "string repeat count must be non-negative"},
+		{name: "float string count", expr: `"ab" * 1.5`, want: "string repeat count must be an integer"},
+		{name: "negative array count", expr: `[1] * -2`, want: "array repeat count must be non-negative"},
+		{name: "array count type", expr: `[1] * nil`, want: "array repeat count must be an integer"},
+		{name: "huge string", expr: `"ab" * 9223372036854775807`, want: "memory quota exceeded"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			script := compileScript(t, "def run()\n  "+tt.expr+"\nend")
+			requireCallErrorContains(t, script, "run", nil, CallOptions{}, tt.want)
+		})
+	}
+
+	limited := compileScriptWithConfig(t, Config{MaxCollectionSize: 10}, `def run()
+  [1, 2, 3] * 4
+end`)
+	requireCallErrorContains(t, limited, "run", nil, CallOptions{}, "array * collection size limit exceeded (10 elements)")
+
+	quota := compileScriptWithConfig(t, Config{MemoryQuotaBytes: 4096}, `def run()
+  [1] * 100000
+end`)
+	requireCallErrorContains(t, quota, "run", nil, CallOptions{}, "memory quota exceeded")
+}
@@ -226,9 +226,13 @@ func (s *Script) Classes() []*ClassDef {
 	}
 	sort.Strings(names)
 	out := make([]*ClassDef, 0, len(names))
+	cloned := make(map[string]*ClassDef, len(names))
 	for _, name := range names {
-		out = append(out, cloneClassForSnapshot(s.classes[name]))
+		classClone := cloneClassForSnapshot(s.classes[name])
+		cloned[name] = classClone
+		out = append(out, classClone)
 	}
+	relinkSuperclasses(s.classes, cloned)
 	return out
 }
 
@@ -294,9 +298,20 @@ func cloneClassesForCall(classes map[string]*ClassDef, env *Env) map[string]*Cla
 		}
 		cloned[name] = classClone
 	}
+	relinkSuperclasses(classes, cloned)
 	return cloned
 }
 
+// relinkSuperclasses points each cloned class at the clone of its original
+// superclass, so an inherited method resolves to the same call's copy.
+func relinkSuperclasses(original, cloned map[string]*ClassDef) {
+	for name, classDef := range original {
+		if classDef.Superclass != nil {
+			cloned[name].Superclass = cloned[classDef.Superclass.Name]
+		}
+	}
+}
+
 func cloneEnumsForCall(enums map[string]*EnumDef) map[string]*EnumDef {
 	if len(enums) == 0 {
 		return nil
@@ -0,0 +1,144 @@
+package runtime
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+// Session reuses one prepared root environment across calls into a script.
+// Script.Call clones every function, class, and enum and rebinds capabilities
+// and globals on each invocation; a Session does that work once in NewSession,
+// so each Session.Call only allocates the per-call frame and Execution.
+//
+// The root environment is shared state: globals and capabilities bound at
+// creation stay bound, class bodies run once, and class variables or root
+// bindings a call mutates are visible to later calls. Calls on one Session are
+// serialized; use separate Sessions (or Script.Call) for concurrent work.
+type Session struct {
+	mu        sync.Mutex
+	script    *Script
+	root      *Env
+	opts      CallOptions
+	functions map[string]*ScriptFunction
+	classes   map[string]*ClassDef
+	enums     map[string]*EnumDef
+
+	capabilityContracts       map[*Builtin]CapabilityMethodContract
+	capabilityContractScopes  map[*Builtin]*capabilityContractScope
+	capabilityContractsByName map[string]CapabilityMethodContract
+	capabilityNames           []string
+	capabilityMethods         []CapabilityMethod
+}
+
+// NewSession prepares a root environment for repeated calls. opts.Globals and
+// opts.Capabilities are bound once here, using ctx for capability binding.
+// opts.Keywords is ignored; pass keywords to each Session.Call instead.
+func (s *Script) NewSession(ctx context.Context, opts CallOptions) (*Session, error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	rootCapacity := len(s.classes) + len(opts.Globals) + len(opts.Capabilities)*2
+	root := newEnvWithCapacity(nil, rootCapacity)
+	s.engine.attachBuiltins(root, len(s.functions)+len(s.enums))
+
+	sess := &Session{
+		script:    s,
+		root:      root,
+		opts:      opts,
+		functions: cloneFunctionsForCall(s.functions, root),
+		classes:   cloneClassesForCall(s.classes, root),
+		enums:     cloneEnumsForCall(s.enums),
+	}
+	for n, fnDecl := range sess.functions {
+		root.DefineStatic(n, NewFunction(fnDecl))
+	}
+	for n, classDef := range sess.classes {
+		root.Define(n, NewClass(classDef))
+	}
+	for n, enumDef := range sess.enums {
+		root.DefineStatic(n, NewEnum(enumDef))
+	}
+	rebinder := newCallFunctionRebinder(s, root, sess.classes, sess.enums)
+
+	exec := newExecutionForCall(s, ctx, root, opts)
+	if err := bindCapabilitiesForCall(exec, root, rebinder, opts.Capabilities); err != nil {
+		return nil, err
+	}
+	if err := bindGlobalsForCall(exec, root, rebinder, opts.Globals); err != nil {
+		return nil, err
+	}
+	if err := exec.checkContext(); err != nil {
+		return nil, err
+	}
+	if err := exec.checkMemory(); err != nil {
+		return nil, fmt.Errorf("check memory after binding session: %w", err)
+	}
+
+	sess.capabilityContracts = exec.capabilityContracts
+	sess.capabilityContractScopes = exec.capabilityContractScopes
+	sess.capabilityContractsByName = exec.capabilityContractsByName
+	sess.capabilityNames = exec.capabilityNames
+	sess.capabilityMethods = exec.capabilityMethods
+	return sess, nil
+}
+
+// Call invokes the named function against the session's root environment.
+// Arguments and keywords are rebound per call exactly as Script.Call rebinds
+// them, and the result is cloned for the host the same way.
+func (sess *Session) Call(ctx context.Context, name string, args []Value, keywords map[string]Value) (Value, error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if err := ctx.Err(); err != nil {
+		return NewNil(), err
+	}
+
+	fn, ok := sess.functions[name]
+	if !ok {
+		candidates := functionSuggestionCandidates(sess.script.functions)
+		return NewNil(), fmt.Errorf("function %s not found%s", name, didYouMean(name, candidates))
+	}
+
+	sess.mu.Lock()
+	defer sess.mu.Unlock()
+
+	exec := newExecutionForCall(sess.script, ctx, sess.root, sess.opts)
+	exec.capabilityContracts = sess.capabilityContracts
+	exec.capabilityContractScopes = sess.capabilityContractScopes
+	exec.capabilityContractsByName = sess.capabilityContractsByName
+	exec.capabilityNames = sess.capabilityNames
+	exec.capabilityMethods = sess.capabilityMethods
+	rebinder := newCallFunctionRebinder(sess.script, sess.root, sess.classes, sess.enums)
+
+	if err := exec.checkContext(); err != nil {
+		return NewNil(), err
+	}
+	if err := initializeClassBodiesForCall(exec, sess.root, sess.classes, sess.script.classOrder, deferredClassBodiesForFunction(fn, sess.script.deferredClassBodies)); err != nil {
+		return NewNil(), err
+	}
+	if err := exec.checkContext(); err != nil {
+		return NewNil(), err
+	}
+
+	callEnv, err := prepareCallEnvForFunction(exec, sess.root, rebinder, fn, args, keywords)
+	if err != nil {
+		return NewNil(), exec.wrapError(err, fn.Pos)
+	}
+
+	val, err := executeFunctionForCall(exec, fn, callEnv)
+	if err != nil {
+		return NewNil(), err
+	}
+	if err := exec.checkContext(); err != nil {
+		return NewNil(), err
+	}
+	if valueNeedsHostClone(val) {
+		return cloneValueForHost(val), nil
+	}
+	return val, nil
+}
@@ -0,0 +1,134 @@
+package runtime
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestSessionCallMatchesScriptCall(t *testing.T) {
+	t.Parallel()
+
+	var source strings.Builder
+	for i := 1; i <= 50; i++ {
+		fmt.Fprintf(&source, "def f%03d(n)\n  n * %d + offset\nend\n\n", i, i)
+	}
+	script := compileScript(t, source.String())
+	opts := CallOptions{Globals: map[string]Value{"offset": NewInt(7)}}
+
+	session, err := script.NewSession(context.Background(), opts)
+	if err != nil {
+		t.Fatalf("NewSession failed: %v", err)
+	}
+	for i := 1; i <= 50; i++ {
+		name := fmt.Sprintf("f%03d", i)
+		args := []Value{NewInt(int64(i))}
+		want, err := script.Call(context.Background(), name, args, opts)
+		if err != nil {
+			t.Fatalf("Script.Call(%s) failed: %v", name, err)
+		}
+		got, err := session.Call(context.Background(), name, args, nil)
+		if err != nil {
+			t.Fatalf("Session.Call(%s) failed: %v", name, err)
+		}
+		if !got.Equal(want) {
+			t.Fatalf("Session.Call(%s) = %v, want %v", name, got, want)
+		}
+	}
+}
+
+func TestSessionKeepsStateAcrossCalls(t *testing.T) {
+	t.Parallel()
+
+	script := compileScript(t, `class Counter
+  @@count = 0
+
+  def self.bump
+    @@count = @@count + 1
+  end
+end
+
+def bump
+  Counter.bump
+end
+
+def player
+  ctx[:player_id]
+end
+
+def greet(name, greeting: "hi")
+  greeting + " " + name
+end`)
+
+	session, err := script.NewSession(context.Background(), CallOptions{
+		Capabilities: []CapabilityAdapter{
+			MustNewContextCapability("ctx", func(context.Context) (Value, error) {
+				return NewHash(map[string]Value{"player_id": NewString("p-1")}), nil
+			}),
+		},
+	})
+	if err != nil {
+		t.Fatalf("NewSession failed: %v", err)
+	}
+
+	for want := int64(1); want <= 3; want++ {
+		got, err := session.Call(context.Background(), "bump", nil, nil)
+		if err != nil {
+			t.Fatalf("bump failed: %v", err)
+		}
+		if got.Kind() != KindInt || got.Int() != want {
+			t.Fatalf("bump = %v, want %d", got, want)
+		}
+	}
+
+	got, err := session.Call(context.Background(), "player", nil, nil)
+	if err != nil {
+		t.Fatalf("player failed: %v", err)
+	}
+	if got.Kind() != KindString || got.String() != "p-1" {
+		t.Fatalf("player = %v, want p-1", got)
+	}
+
+	got, err = session.Call(context.Background(), "greet", []Value{NewString("ada")}, map[string]Value{"greeting": NewString("hello")})
+	if err != nil {
+		t.Fatalf("greet failed: %v", err)
+	}
+	if got.String() != "hello ada" {
+		t.Fatalf("greet = %q, want %q", got.String(), "hello ada")
+	}
+
+	// A plain Script.Call still starts from fresh class state.
+	fresh, err := script.Call(context.Background(), "bump", nil, CallOptions{})
+	if err != nil {
+		t.Fatalf("Script.Call bump failed: %v", err)
+	}
+	if fresh.Int() != 1 {
+		t.Fatalf("Script.Call bump = %v, want 1", fresh)
+	}
+}
+
+func TestSessionCallErrors(t *testing.T) {
+	t.Parallel()
+
+	script := compileScript(t, `def run()
+  1
+end`)
+	session, err := script.NewSession(context.Background(), CallOptions{})
+	if err != nil {
+		t.Fatalf("NewSession failed: %v", err)
+	}
+
+	if _, err := session.Call(context.Background(), "rnu", nil, nil); err == nil || !strings.Contains(err.Error(), "function rnu not found") {
+		t.Fatalf("unknown function error = %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := session.Call(ctx, "run", nil, nil); err == nil || !strings.Contains(err.Error(), "context canceled") {
+		t.Fatalf("canceled call error = %v", err)
+	}
+	if _, err := script.NewSession(ctx, CallOptions{}); err == nil {
+		t.Fatal("NewSession with a canceled context should fail")
+	}
+}
@@ -34,6 +34,9 @@ func TestSpaceshipIncomparableReturnsNil(t *testing.T) {
 		{"money diff currency", `money("10.00 USD") <=> money("10.00 EUR")`},
 		{"money vs int", `money("10.00 USD") <=> 5`},
 		{"time member call wrong type", `Time.utc(2024, 1, 1).<=>(1)`},
+		{"compare int vs string", `1.compare("a")`},
+		{"compare nan", `(0.0 / 0.0).compare(1.0)`},
+		{"compare hash", `{a: 1}.compare({a: 1})`},
 	}
 
 	for _, tc := range exprs {
@@ -72,6 +75,9 @@ func TestSpaceshipComparableReturnsOrder(t *testing.T) {
 		{"time less", `Time.utc(2024, 1, 1) <=> Time.utc(2024, 1, 2)`, -1},
 		{"time equal", `Time.utc(2024, 1, 1) <=> Time.utc(2024, 1, 1)`, 0},
 		{"time member call", `Time.utc(2024, 1, 2).<=>(Time.utc(2024, 1, 1))`, 1},
+		{"compare int less", `1.compare(2)`, -1},
+		{"compare string equal", `"a".compare("a")`, 0},
+		{"compare money greater", `money("20.00 USD").compare(money("10.00 USD"))`, 1},
 	}
 
 	for _, tc := range tests {
@@ -99,12 +105,15 @@ func TestRelationalIncomparableRaises(t *testing.T) {
 		expr string
 		want string
 	}{
-		{"int lt string", `1 < "a"`, "unsupported comparison operands"},
-		{"string gt int", `"a" > 1`, "unsupported comparison operands"},
-		{"time lte int", `Time.utc(2024, 1, 1) <= 1`, "unsupported comparison operands"},
-		{"int gte time", `1 >= Time.utc(2024, 1, 1)`, "unsupported comparison operands"},
+		{"int lt string", `1 < "a"`, "cannot compare int with string"},
+		{"string gt int", `"a" > 1`, "cannot compare string with int"},
+		{"time lte int", `Time.utc(2024, 1, 1) <= 1`, "cannot compare time with int"},
+		{"int gte time", `1 >= Time.utc(2024, 1, 1)`, "cannot compare int with time"},
 		{"money diff currency lt", `money("10.00 USD") < money("10.00 EUR")`, "money currency mismatch for comparison"},
 		{"money diff currency gte", `money("10.00 USD") >= money("10.00 EUR")`, "money currency mismatch for comparison"},
+		{"nil lt int", `nil < 1`, "cannot compare nil with int"},
+		{"array gt array", `[1] > [0]`, "cannot compare array with array"},
+		{"nan lt string", `(0.0 / 0.0) < "a"`, "cannot compare float NaN with string"},
 	}
 
 	for _, tc := range tests {
@@ -197,3 +206,76 @@ func TestSpaceshipIncomparableDirectOrder(t *testing.T) {
 		})
 	}
 }
+
+// TestSpaceshipDrivesSortBlocks verifies that `<=>` results feed array.sort
+// comparator blocks directly, so custom sorts need no hand-rolled -1/0/1.
+func TestSpaceshipDrivesSortBlocks(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		expr string
+		want string
+	}{
+		{
+			name: "ints ascending",
+			expr: `[3, 1, 2].sort { |a, b| a <=> b }`,
+			want: `[1, 2, 3]`,
+		},
+		{
+			name: "ints descending",
+			expr: `[3, 1, 2].sort { |a, b| b <=> a }`,
+			want: `[3, 2, 1]`,
+		},
+		{
+			name: "mixed int and float",
+			expr: `[2.5, 1, 2].sort { |a, b| a <=> b }`,
+			want: `[1, 2, 2.5]`,
+		},
+		{
+			name: "strings",
+			expr: `["pear", "apple", "fig"].sort { |a, b| a <=> b }`,
+			want: `["apple", "fig", "pear"]`,
+		},
+		{
+			name: "hash field",
+			expr: `[{name: "a", score: 7}, {name: "b", score: 3}, {name: "c", score: 5}].sort { |a, b| a.score <=> b.score }.map { |p| p.name }`,
+			want: `["b", "c", "a"]`,
+		},
+		{
+			name: "money same currency",
+			expr: `[money("3.00 USD"), money("1.00 USD"), money("2.00 USD")].sort { |a, b| a <=> b }.map { |m| m.cents }`,
+			want: `[100, 200, 300]`,
+		},
+		{
+			name: "durations",
+			expr: `[5.minutes, 30.seconds, 1.hour].sort { |a, b| a <=> b }.map { |d| d.seconds }`,
+			want: `[30, 300, 3600]`,
+		},
+		{
+			name: "tie broken by second key",
+			expr: `[{t: 1, s: 2}, {t: 0, s: 9}, {t: 1, s: 1}].sort { |a, b| c = a.t <=> b.t; c == 0 ? a.s <=> b.s : c }.map { |p| p.s }`,
+			want: `[9, 1, 2]`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			got := evalExpr(t, tc.expr)
+			if got.Inspect() != tc.want {
+				t.Fatalf("%s = %s, want %s", tc.expr, got.Inspect(), tc.want)
+			}
+		})
+	}
+}
+
+// TestSpaceshipIncomparableInSortBlockRaises verifies that a nil `<=>` result
+// reaching array.sort is reported as a non-numeric comparator rather than
+// being treated as a tie.
+func TestSpaceshipIncomparableInSortBlockRaises(t *testing.T) {
+	t.Parallel()
+
+	script := compileScript(t, "def run()\n  [1, \"a\"].sort { |a, b| a <=> b }\nend")
+	requireCallErrorContains(t, script, "run", nil, CallOptions{}, "array.sort block must return numeric comparator")
+}
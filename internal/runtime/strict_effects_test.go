@@ -203,3 +203,101 @@ func TestDataOnlyScanSharedEntryMapDistinctDefaults(t *testing.T) {
 		}
 	})
 }
+
+func TestStrictEffectsDisablesMutatingCapabilities(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		source string
+		want   string
+	}{
+		{name: "db_update", source: `db.update("Player", "p-1", { score: 1 })`, want: "strict effects: db.update is disabled"},
+		{name: "jobs_enqueue", source: `jobs.enqueue("audit", { id: "p-1" })`, want: "strict effects: jobs.enqueue is disabled"},
+		{name: "events_publish", source: `events.publish("scored", { id: "p-1" })`, want: "strict effects: events.publish is disabled"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			script := compileScriptWithConfig(t, Config{StrictEffects: true}, "def run()\n  "+tc.source+"\nend")
+			db := &dbCapabilityStub{}
+			queue := &jobQueueStub{}
+			publisher := &eventsCapabilityStub{}
+			err := callScriptErr(t, context.Background(), script, "run", nil, callOptionsWithCapabilities(
+				MustNewDBCapability("db", db),
+				MustNewJobQueueCapability("jobs", queue),
+				MustNewEventsCapability("events", publisher),
+			))
+			requireErrorContains(t, err, tc.want)
+			if len(db.updateCalls)+len(queue.enqueueCalls)+len(publisher.publishCalls) != 0 {
+				t.Fatalf("mutating capability ran under strict effects")
+			}
+		})
+	}
+}
+
+func TestStrictEffectsAllowsReadOnlyCapabilities(t *testing.T) {
+	t.Parallel()
+	script := compileScriptWithConfig(t, Config{StrictEffects: true}, `def run()
+  player = db.find("Player", "p-1")
+  db.query("Player")
+  player
+end`)
+
+	db := &dbCapabilityStub{findResult: NewHash(map[string]Value{"id": NewString("p-1")})}
+	result := callScript(t, context.Background(), script, "run", nil, callOptionsWithCapabilities(MustNewDBCapability("db", db)))
+	if result.Kind() != KindHash || len(db.findCalls) != 1 || len(db.queryCalls) != 1 {
+		t.Fatalf("read-only calls = %#v (find %d, query %d)", result, len(db.findCalls), len(db.queryCalls))
+	}
+}
+
+func TestStrictEffectsAllowsMutatingCapabilitiesWhenOptedIn(t *testing.T) {
+	t.Parallel()
+	script := compileScriptWithConfig(t, Config{StrictEffects: true}, `def run()
+  db.update("Player", "p-1", { score: 1 })
+  jobs.enqueue("audit", { id: "p-1" })
+end`)
+
+	db := &dbCapabilityStub{updateResult: NewBool(true)}
+	queue := &jobQueueStub{}
+	opts := callOptionsWithCapabilities(MustNewDBCapability("db", db), MustNewJobQueueCapability("jobs", queue))
+	opts.AllowEffects = true
+	callScript(t, context.Background(), script, "run", nil, opts)
+	if len(db.updateCalls) != 1 || len(queue.enqueueCalls) != 1 {
+		t.Fatalf("mutating calls = update %d, enqueue %d; want 1 each", len(db.updateCalls), len(queue.enqueueCalls))
+	}
+
+	lenient := compileScriptDefault(t, `def run()
+  db.update("Player", "p-1", { score: 1 })
+end`)
+	callScript(t, context.Background(), lenient, "run", nil, callOptionsWithCapabilities(MustNewDBCapability("db", db)))
+	if len(db.updateCalls) != 2 {
+		t.Fatalf("mutating call without strict effects did not run")
+	}
+}
+
+func TestStrictEffectsHonorsSignatureMutatingFlag(t *testing.T) {
+	t.Parallel()
+	script := compileScriptWithConfig(t, Config{StrictEffects: true}, `def run()
+  db.find("Player", "p-1")
+end`)
+
+	contract := MustNewSignatureContract("db.find", CapabilitySignature{Args: []string{"string", "string"}, Mutating: true})
+	if !contract.Mutating {
+		t.Fatalf("signature contract dropped Mutating")
+	}
+	err := callScriptErr(t, context.Background(), script, "run", nil, callOptionsWithCapabilities(mutatingFindCapability{contract: contract}))
+	requireErrorContains(t, err, "strict effects: db.find is disabled without CallOptions.AllowEffects")
+}
+
+type mutatingFindCapability struct {
+	contract CapabilityMethodContract
+}
+
+func (mutatingFindCapability) Bind(binding CapabilityBinding) (map[string]Value, error) {
+	return budgetProbeCapability{}.Bind(binding)
+}
+
+func (c mutatingFindCapability) CapabilityContracts() map[string]CapabilityMethodContract {
+	return map[string]CapabilityMethodContract{"db.find": c.contract}
+}
@@ -0,0 +1,139 @@
+package runtime
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestStringBangMethodsDoNotMutateReceiver(t *testing.T) {
+	t.Parallel()
+
+	script := compileScript(t, `def discarded()
+  name = "  Ada  "
+  name.strip!
+  name.upcase!()
+  name
+end
+
+def reassigned()
+  name = "  Ada  "
+  name = name.strip
+  name = name.upcase
+  name
+end
+
+def unchanged_bang()
+  name = "ada"
+  name.downcase!
+end
+
+def bang_with_fallback()
+  name = "ada"
+  name = name.downcase! || name
+  name
+end`)
+
+	tests := []struct {
+		fn   string
+		want Value
+	}{
+		{fn: "discarded", want: NewString("  Ada  ")},
+		{fn: "reassigned", want: NewString("ADA")},
+		{fn: "unchanged_bang", want: NewNil()},
+		{fn: "bang_with_fallback", want: NewString("ada")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.fn, func(t *testing.T) {
+			t.Parallel()
+			got := callFunc(t, script, tt.fn, nil)
+			if !got.Equal(tt.want) {
+				t.Fatalf("%s() = %v, want %v", tt.fn, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWarnDiscardedBangReportsDiscardedStringResults(t *testing.T) {
+	t.Parallel()
+
+	source := `def run(name, tags)
+  name.strip!
+  name.gsub!("a", "b")
+  tags.merge!({extra: true})
+  kept = name.upcase!
+  name.downcase!
+end`
+	args := []Value{NewString(" ada "), NewHash(map[string]Value{})}
+
+	var stderr bytes.Buffer
+	script := compileScriptWithConfig(t, Config{ErrorWriter: &stderr, WarnDiscardedBang: true}, source)
+	callFunc(t, script, "run", args)
+
+	lines := strings.Split(strings.TrimSpace(stderr.String()), "\n")
+	wants := []string{
+		"result of string strip! is discarded; strings are immutable, so assign the result of strip instead",
+		"result of string gsub! is discarded; strings are immutable, so assign the result of gsub instead",
+		"result of hash merge! is discarded; hashes are immutable, so assign the result of merge instead",
+	}
+	if len(lines) != len(wants) {
+		t.Fatalf("warnings = %q, want %d lines", stderr.String(), len(wants))
+	}
+	for i, want := range wants {
+		if !strings.HasPrefix(lines[i], "warning: ") || !strings.Contains(lines[i], want) {
+			t.Fatalf("warning %d = %q, want %q", i, lines[i], want)
+		}
+	}
+
+	var quiet bytes.Buffer
+	script = compileScriptWithConfig(t, Config{ErrorWriter: &quiet}, source)
+	callFunc(t, script, "run", args)
+	if quiet.Len() != 0 {
+		t.Fatalf("warnings without WarnDiscardedBang = %q, want none", quiet.String())
+	}
+}
+
+func TestWarnDiscardedBangCoversCollectionsAndNestedBodies(t *testing.T) {
+	t.Parallel()
+
+	source := `def run(names, counts)
+  names.reject! { |name| name == "" }
+  counts.delete(:old)
+  for name in names
+    name.strip!
+  end
+  if names.empty?
+    counts.update({empty: true})
+  else
+    names.delete_if { |name| name == "x" }
+  end
+  "#{names.first}".upcase!
+  names.reject! { |name| name == "" }
+end`
+	args := []Value{
+		NewArray([]Value{NewString(" ada "), NewString("")}),
+		NewHash(map[string]Value{"old": NewInt(1)}),
+	}
+
+	var stderr bytes.Buffer
+	script := compileScriptWithConfig(t, Config{ErrorWriter: &stderr, WarnDiscardedBang: true}, source)
+	callFunc(t, script, "run", args)
+
+	lines := strings.Split(strings.TrimSpace(stderr.String()), "\n")
+	wants := []string{
+		"2:3: result of array reject! is discarded; arrays are immutable, so assign the result of reject instead",
+		"3:3: result of hash delete is discarded; hashes are immutable, so assign the result of delete instead",
+		"5:5: result of string strip! is discarded",
+		"5:5: result of string strip! is discarded",
+		"10:5: result of array delete_if is discarded",
+		"12:3: result of string upcase! is discarded",
+	}
+	if len(lines) != len(wants) {
+		t.Fatalf("warnings = %q, want %d lines", stderr.String(), len(wants))
+	}
+	for i, want := range wants {
+		if !strings.Contains(lines[i], want) {
+			t.Fatalf("warning %d = %q, want %q", i, lines[i], want)
+		}
+	}
+}
@@ -0,0 +1,129 @@
+package runtime
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// htmlEscaper replaces the five characters that are significant in HTML text
+// and attribute values, matching Ruby's CGI.escapeHTML.
+var htmlEscaper = strings.NewReplacer(
+	"&", "&amp;",
+	"<", "&lt;",
+	">", "&gt;",
+	`"`, "&quot;",
+	"'", "&#39;",
+)
+
+func stringMemberEscapes(property string) (Value, error) {
+	switch property {
+	case "html_escape":
+		return stringUnaryBuiltin("string.html_escape", func(s string) (string, error) {
+			return htmlEscaper.Replace(s), nil
+		}), nil
+	case "url_encode":
+		return stringUnaryBuiltin("string.url_encode", func(s string) (string, error) {
+			return urlEncode(s), nil
+		}), nil
+	case "url_decode":
+		return stringUnaryBuiltin("string.url_decode", func(s string) (string, error) {
+			decoded, err := url.QueryUnescape(s)
+			if err != nil {
+				return "", fmt.Errorf("string.url_decode invalid percent-encoding")
+			}
+			return decoded, nil
+		}), nil
+	case "shell_escape":
+		return stringUnaryBuiltin("string.shell_escape", func(s string) (string, error) {
+			return shellEscape(s), nil
+		}), nil
+	default:
+		return NewNil(), fmt.Errorf("unknown string method %s", property)
+	}
+}
+
+// stringUnaryBuiltin wraps a whole-string transform, such as an escaper or an
+// inflection, as a method that takes no arguments, keywords, or block.
+func stringUnaryBuiltin(method string, transform func(string) (string, error)) Value {
+	return NewAutoBuiltin(method, func(exec *Execution, receiver Value, args []Value, kwargs map[string]Value, block Value) (Value, error) {
+		if len(args) > 0 {
+			return NewNil(), fmt.Errorf("%s does not take arguments", method)
+		}
+		if len(kwargs) > 0 {
+			return NewNil(), fmt.Errorf("%s does not take keyword arguments", method)
+		}
+		if !block.IsNil() {
+			return NewNil(), fmt.Errorf("%s does not accept blocks", method)
+		}
+		out, err := transform(receiver.String())
+		if err != nil {
+			return NewNil(), err
+		}
+		return NewString(out), nil
+	})
+}
+
+// urlEncode percent-encodes every byte outside RFC 3986's unreserved set, so a
+// space becomes %20 and the result is safe in both a path segment and a query
+// component (Ruby's ERB::Util.url_encode).
+func urlEncode(s string) string {
+	const hex = "0123456789ABCDEF"
+	var b strings.Builder
+	b.Grow(len(s))
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if isURLUnreserved(c) {
+			b.WriteByte(c)
+			continue
+		}
+		b.WriteByte('%')
+		b.WriteByte(hex[c>>4])
+		b.WriteByte(hex[c&0x0f])
+	}
+	return b.String()
+}
+
+func isURLUnreserved(c byte) bool {
+	switch {
+	case 'a' <= c && c <= 'z', 'A' <= c && c <= 'Z', '0' <= c && c <= '9':
+		return true
+	case c == '-', c == '.', c == '_', c == '~':
+		return true
+	default:
+		return false
+	}
+}
+
+// shellEscape quotes s for a POSIX shell word the way Ruby's Shellwords.escape
+// does. An empty string becomes a pair of single quotes, characters outside a
+// conservative safe set are backslash-escaped, and a newline is wrapped in
+// single quotes because a backslash before it would continue the line.
+func shellEscape(s string) string {
+	if s == "" {
+		return "''"
+	}
+	var b strings.Builder
+	b.Grow(len(s))
+	for _, r := range s {
+		switch {
+		case r == '\n':
+			b.WriteString("'\n'")
+		case isShellSafe(r):
+			b.WriteRune(r)
+		default:
+			b.WriteByte('\\')
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+func isShellSafe(r rune) bool {
+	switch {
+	case 'a' <= r && r <= 'z', 'A' <= r && r <= 'Z', '0' <= r && r <= '9':
+		return true
+	default:
+		return strings.ContainsRune("_-.,:+/@", r)
+	}
+}
@@ -0,0 +1,77 @@
+package runtime
+
+import "testing"
+
+func TestStringEscapeHelpers(t *testing.T) {
+	t.Parallel()
+
+	script := compileScript(t, `def html(s)
+  s.html_escape
+end
+
+def url_encode(s)
+  s.url_encode
+end
+
+def url_decode(s)
+  s.url_decode
+end
+
+def shell(s)
+  s.shell_escape
+end
+
+def url_round_trip(s)
+  s.url_encode.url_decode
+end`)
+
+	tests := []struct {
+		name string
+		fn   string
+		in   string
+		want string
+	}{
+		{name: "html escapes markup", fn: "html", in: `<a href="x?a=1&b=2">Tom's</a>`, want: "&lt;a href=&quot;x?a=1&amp;b=2&quot;&gt;Tom&#39;s&lt;/a&gt;"},
+		{name: "html leaves plain text", fn: "html", in: "café 100%", want: "café 100%"},
+		{name: "url encodes reserved characters", fn: "url_encode", in: "a b&c=d/é", want: "a%20b%26c%3Dd%2F%C3%A9"},
+		{name: "url keeps unreserved characters", fn: "url_encode", in: "A-z_0.9~", want: "A-z_0.9~"},
+		{name: "url decodes percent escapes", fn: "url_decode", in: "a%20b%26c%3Dd%2F%C3%A9", want: "a b&c=d/é"},
+		{name: "url decodes plus as space", fn: "url_decode", in: "a+b", want: "a b"},
+		{name: "url round trip", fn: "url_round_trip", in: "q=1+1 & more", want: "q=1+1 & more"},
+		{name: "shell escapes metacharacters", fn: "shell", in: "it's $HOME; rm -rf *", want: `it\'s\ \$HOME\;\ rm\ -rf\ \*`},
+		{name: "shell keeps safe characters", fn: "shell", in: "user@host:/tmp/a-b_c.txt", want: "user@host:/tmp/a-b_c.txt"},
+		{name: "shell quotes empty string", fn: "shell", in: "", want: "''"},
+		{name: "shell wraps newlines", fn: "shell", in: "a\nb", want: "a'\n'b"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			got := callFunc(t, script, tc.fn, []Value{NewString(tc.in)})
+			if got.Kind() != KindString || got.String() != tc.want {
+				t.Fatalf("%s(%q) = %v, want %q", tc.fn, tc.in, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestStringEscapeHelperErrors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		expr string
+		want string
+	}{
+		{name: "invalid percent escape", expr: `"100%".url_decode`, want: "string.url_decode invalid percent-encoding"},
+		{name: "arguments", expr: `"x".html_escape(true)`, want: "string.html_escape does not take arguments"},
+		{name: "keywords", expr: `"x".url_encode(space: "+")`, want: "string.url_encode does not take keyword arguments"},
+		{name: "block", expr: `"x".shell_escape { |c| c }`, want: "string.shell_escape does not accept blocks"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			script := compileScript(t, "def run()\n  "+tc.expr+"\nend")
+			requireCallErrorContains(t, script, "run", nil, CallOptions{}, tc.want)
+		})
+	}
+}
@@ -0,0 +1,117 @@
+package runtime
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+func stringMemberInflections(property string) (Value, error) {
+	switch property {
+	case "pluralize":
+		return stringUnaryBuiltin("string.pluralize", func(s string) (string, error) {
+			return pluralizeWord(s), nil
+		}), nil
+	case "singularize":
+		return stringUnaryBuiltin("string.singularize", func(s string) (string, error) {
+			return singularizeWord(s), nil
+		}), nil
+	default:
+		return NewNil(), fmt.Errorf("unknown string method %s", property)
+	}
+}
+
+// builtinPluralize formats a count with its noun, such as "1 item" or
+// "3 items". The singular is used only for a count of exactly 1; otherwise
+// the explicit plural applies, defaulting to pluralizeWord(singular).
+func builtinPluralize(exec *Execution, receiver Value, args []Value, kwargs map[string]Value, block Value) (Value, error) {
+	if len(args) < 2 || len(args) > 3 {
+		return NewNil(), fmt.Errorf("pluralize expects count, singular, and optional plural")
+	}
+	if len(kwargs) > 0 {
+		return NewNil(), fmt.Errorf("pluralize does not accept keyword arguments")
+	}
+	if !block.IsNil() {
+		return NewNil(), fmt.Errorf("pluralize does not accept blocks")
+	}
+	count := args[0]
+	if count.Kind() != KindInt && count.Kind() != KindFloat {
+		return NewNil(), fmt.Errorf("pluralize count must be numeric")
+	}
+	if args[1].Kind() != KindString {
+		return NewNil(), fmt.Errorf("pluralize singular must be string")
+	}
+	word := args[1].String()
+	one := (count.Kind() == KindInt && count.Int() == 1) || (count.Kind() == KindFloat && count.Float() == 1)
+	if !one {
+		switch {
+		case len(args) == 3 && !args[2].IsNil():
+			if args[2].Kind() != KindString {
+				return NewNil(), fmt.Errorf("pluralize plural must be string or nil")
+			}
+			word = args[2].String()
+		default:
+			word = pluralizeWord(word)
+		}
+	}
+	return NewString(count.String() + " " + word), nil
+}
+
+// pluralizeWord applies the regular English plural rules: sibilant endings
+// take "es", a consonant before a final "y" becomes "ies", and everything
+// else takes "s". The suffix is upper-cased when the word ends in an
+// upper-case letter. Irregular nouns are not handled.
+func pluralizeWord(word string) string {
+	if word == "" {
+		return word
+	}
+	lower := strings.ToLower(word)
+	var stem, suffix string
+	switch {
+	case hasAnySuffix(lower, "s", "x", "z", "ch", "sh"):
+		stem, suffix = word, "es"
+	case strings.HasSuffix(lower, "y") && len(lower) > 1 && !isEnglishVowel(lower[len(lower)-2]):
+		stem, suffix = word[:len(word)-1], "ies"
+	default:
+		stem, suffix = word, "s"
+	}
+	return stem + matchTrailingCase(word, suffix)
+}
+
+// singularizeWord reverses pluralizeWord's rules. Words that do not look
+// plural, including ones ending in "ss", are returned unchanged.
+func singularizeWord(word string) string {
+	lower := strings.ToLower(word)
+	switch {
+	case strings.HasSuffix(lower, "ies") && len(lower) > 3:
+		return word[:len(word)-3] + matchTrailingCase(word, "y")
+	case hasAnySuffix(lower, "sses", "xes", "zes", "ches", "shes"):
+		return word[:len(word)-2]
+	case strings.HasSuffix(lower, "ss") || !strings.HasSuffix(lower, "s"):
+		return word
+	default:
+		return word[:len(word)-1]
+	}
+}
+
+func hasAnySuffix(s string, suffixes ...string) bool {
+	for _, suffix := range suffixes {
+		if strings.HasSuffix(s, suffix) {
+			return true
+		}
+	}
+	return false
+}
+
+func isEnglishVowel(c byte) bool {
+	return strings.IndexByte("aeiou", c) >= 0
+}
+
+func matchTrailingCase(word, suffix string) string {
+	last, _ := utf8.DecodeLastRuneInString(word)
+	if unicode.IsUpper(last) {
+		return strings.ToUpper(suffix)
+	}
+	return suffix
+}
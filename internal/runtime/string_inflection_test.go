@@ -0,0 +1,79 @@
+package runtime
+
+import "testing"
+
+func TestPluralize(t *testing.T) {
+	t.Parallel()
+
+	script := compileScript(t, `def counts
+  [
+    pluralize(0, "item"),
+    pluralize(1, "item"),
+    pluralize(3, "item"),
+    pluralize(1, "person", "people"),
+    pluralize(2, "person", "people"),
+    pluralize(2.5, "mile"),
+    pluralize(2, "item", nil),
+    pluralize(3, "box"),
+    pluralize(2, "city")
+  ]
+end
+
+def bad_count
+  pluralize("3", "item")
+end`)
+
+	compareArrays(t, callFunc(t, script, "counts", nil), []Value{
+		NewString("0 items"),
+		NewString("1 item"),
+		NewString("3 items"),
+		NewString("1 person"),
+		NewString("2 people"),
+		NewString("2.5 miles"),
+		NewString("2 items"),
+		NewString("3 boxes"),
+		NewString("2 cities"),
+	})
+	requireCallErrorContains(t, script, "bad_count", nil, CallOptions{}, "pluralize count must be numeric")
+}
+
+func TestStringPluralizeAndSingularize(t *testing.T) {
+	t.Parallel()
+
+	script := compileScript(t, `def plural(s)
+  s.pluralize
+end
+
+def singular(s)
+  s.singularize
+end`)
+
+	tests := []struct {
+		singular string
+		plural   string
+	}{
+		{"item", "items"},
+		{"box", "boxes"},
+		{"match", "matches"},
+		{"wish", "wishes"},
+		{"class", "classes"},
+		{"buzz", "buzzes"},
+		{"city", "cities"},
+		{"day", "days"},
+		{"ITEM", "ITEMS"},
+		{"CITY", "CITIES"},
+	}
+	for _, tc := range tests {
+		if got := callFunc(t, script, "plural", []Value{NewString(tc.singular)}); got.String() != tc.plural {
+			t.Fatalf("%q.pluralize = %q, want %q", tc.singular, got.String(), tc.plural)
+		}
+		if got := callFunc(t, script, "singular", []Value{NewString(tc.plural)}); got.String() != tc.singular {
+			t.Fatalf("%q.singularize = %q, want %q", tc.plural, got.String(), tc.singular)
+		}
+	}
+	for _, word := range []string{"glass", "data", ""} {
+		if got := callFunc(t, script, "singular", []Value{NewString(word)}); got.String() != word {
+			t.Fatalf("%q.singularize = %q, want unchanged", word, got.String())
+		}
+	}
+}
@@ -121,6 +121,21 @@ func TestStringMatchPredicate(t *testing.T) {
 			script: `def run() "abc".match?("c", 2.9) end`,
 			want:   true,
 		},
+		{
+			name:   "compiled regexp hit",
+			script: `def run() "ID-12".match?(Regexp.new("ID-[0-9]+")) end`,
+			want:   true,
+		},
+		{
+			name:   "compiled regexp miss",
+			script: `def run() "ID-AB".match?(Regexp.new("ID-[0-9]+")) end`,
+			want:   false,
+		},
+		{
+			name:   "compiled regexp with offset",
+			script: `def run() "abcabc".match?(Regexp.new("\\Aabc"), 3) end`,
+			want:   false,
+		},
 	}
 
 	for _, tc := range tests {
@@ -159,7 +174,7 @@ func TestStringMatchPredicateErrors(t *testing.T) {
 		{
 			name:   "non-string pattern",
 			script: `def run() "abc".match?(123) end`,
-			want:   "string.match? pattern must be string",
+			want:   "string.match? pattern must be string or Regexp",
 		},
 		{
 			name:   "negative offset",
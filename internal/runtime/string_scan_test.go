@@ -68,6 +68,20 @@ func TestStringScanCaptureShape(t *testing.T) {
 			source: `def run() "abc".scan("(z)(z)") end`,
 			want:   []Value{},
 		},
+		{
+			name:   "compiled regex with two groups returns pairs",
+			source: `def run() "host=db port=5432 user=app".scan(Regexp.new("(\\w+)=(\\w+)")) end`,
+			want: []Value{
+				NewArray([]Value{NewString("host"), NewString("db")}),
+				NewArray([]Value{NewString("port"), NewString("5432")}),
+				NewArray([]Value{NewString("user"), NewString("app")}),
+			},
+		},
+		{
+			name:   "compiled regex without groups returns full matches",
+			source: `def run() "a1 b2".scan(Regexp.new("[a-z][0-9]")) end`,
+			want:   []Value{NewString("a1"), NewString("b2")},
+		},
 	}
 
 	for _, tt := range tests {
@@ -317,7 +331,12 @@ func TestStringScanArgumentRejection(t *testing.T) {
 		{
 			name:   "non-string pattern",
 			source: `def run() "abc".scan(1) end`,
-			want:   "string.scan pattern must be string",
+			want:   "string.scan pattern must be string or Regexp",
+		},
+		{
+			name:   "object that is not a regex",
+			source: `def run() "abc".scan({ source: "a" }) end`,
+			want:   "string.scan pattern must be string or Regexp",
 		},
 		{
 			name:   "invalid regex",
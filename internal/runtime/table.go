@@ -0,0 +1,149 @@
+package runtime
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// builtinToTable renders rows as an aligned plain-text table. headers: names
+// the columns in order; a hash row contributes the value stored under each
+// header, and an array row contributes its elements by position. Each column
+// is as wide as its widest cell, columns are separated by two spaces, and a
+// dashed rule sits under the header line. Cells render like puts does, with
+// nil and missing cells left blank.
+func builtinToTable(exec *Execution, receiver Value, args []Value, kwargs map[string]Value, block Value) (Value, error) {
+	if len(args) != 1 || args[0].Kind() != KindArray {
+		return NewNil(), fmt.Errorf("to_table expects an array of rows")
+	}
+	if !block.IsNil() {
+		return NewNil(), fmt.Errorf("to_table does not accept blocks")
+	}
+	var headers []Value
+	for key, val := range kwargs {
+		if key != "headers" {
+			return NewNil(), fmt.Errorf("to_table does not accept keyword %s", key)
+		}
+		if val.Kind() != KindArray || len(val.Array()) == 0 {
+			return NewNil(), fmt.Errorf("to_table headers must be a non-empty array")
+		}
+		headers = val.Array()
+	}
+	if headers == nil {
+		return NewNil(), fmt.Errorf("to_table requires headers:")
+	}
+
+	rows := args[0].Array()
+	table := make([][]string, 0, len(rows)+1)
+	headerCells := make([]string, len(headers))
+	for i, header := range headers {
+		text, err := tableCell(exec, header)
+		if err != nil {
+			return NewNil(), err
+		}
+		headerCells[i] = text
+	}
+	table = append(table, headerCells)
+	for i, row := range rows {
+		if err := exec.step(); err != nil {
+			return NewNil(), err
+		}
+		cells := make([]string, len(headers))
+		for col, header := range headers {
+			cell, err := tableRowValue(row, header, col)
+			if err != nil {
+				return NewNil(), fmt.Errorf("to_table row %d: %w", i, err)
+			}
+			if cells[col], err = tableCell(exec, cell); err != nil {
+				return NewNil(), err
+			}
+		}
+		table = append(table, cells)
+	}
+
+	widths := make([]int, len(headers))
+	for _, cells := range table {
+		for col, cell := range cells {
+			widths[col] = max(widths[col], utf8.RuneCountInString(cell))
+		}
+	}
+	size := 0
+	for _, width := range widths {
+		size += width + 2
+	}
+	if size*(len(table)+1) > maxOutputHelperBytes {
+		return NewNil(), guardLimitErrorf("to_table output exceeds limit %d bytes", maxOutputHelperBytes)
+	}
+
+	var b strings.Builder
+	b.Grow(size * (len(table) + 1))
+	writeLine := func(cells []string) {
+		var line strings.Builder
+		for col, cell := range cells {
+			if col > 0 {
+				line.WriteString("  ")
+			}
+			line.WriteString(cell)
+			line.WriteString(strings.Repeat(" ", widths[col]-utf8.RuneCountInString(cell)))
+		}
+		b.WriteString(strings.TrimRight(line.String(), " "))
+		b.WriteByte('\n')
+	}
+	writeLine(table[0])
+	rule := make([]string, len(widths))
+	for col, width := range widths {
+		rule[col] = strings.Repeat("-", width)
+	}
+	writeLine(rule)
+	for _, cells := range table[1:] {
+		writeLine(cells)
+	}
+	return NewString(b.String()), nil
+}
+
+// tableRowValue picks the cell for column col from a hash or array row. A
+// string header missing from a hash row falls back to the symbol of the same
+// name and a symbol header to the string, so `headers: ["name"]` reads rows
+// written as `{ name: ... }`.
+func tableRowValue(row, header Value, col int) (Value, error) {
+	switch row.Kind() {
+	case KindHash, KindObject:
+		val, ok, err := hashGet(row, header)
+		if err != nil || ok {
+			return val, err
+		}
+		var alternate Value
+		switch header.Kind() {
+		case KindString:
+			alternate = NewSymbol(header.String())
+		case KindSymbol:
+			alternate = NewString(header.String())
+		default:
+			return NewNil(), nil
+		}
+		val, ok, err = hashGet(row, alternate)
+		if err != nil || !ok {
+			return NewNil(), err
+		}
+		return val, nil
+	case KindArray:
+		elems := row.Array()
+		if col >= len(elems) {
+			return NewNil(), nil
+		}
+		return elems[col], nil
+	default:
+		return NewNil(), fmt.Errorf("expected a hash or array, got %s", row.Kind())
+	}
+}
+
+func tableCell(exec *Execution, val Value) (string, error) {
+	if val.IsNil() {
+		return "", nil
+	}
+	text, err := renderOutputValue(exec, "to_table", val, false)
+	if err != nil {
+		return "", err
+	}
+	return strings.ReplaceAll(text, "\n", " "), nil
+}
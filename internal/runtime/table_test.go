@@ -0,0 +1,66 @@
+package runtime
+
+import (
+	"context"
+	"testing"
+)
+
+func TestToTableAlignsColumns(t *testing.T) {
+	t.Parallel()
+
+	script := compileScriptDefault(t, `def hashes
+  rows = [
+    { name: "alice", score: 12, team: "red" },
+    { name: "bob", score: 7 },
+    { name: "carolina", score: 105, team: "blue" }
+  ]
+  to_table(rows, headers: [:name, :score, :team])
+end
+
+def arrays
+  to_table([["x", 1], ["long value", nil]], headers: ["key", "value"])
+end
+
+def string_headers
+  to_table([{ name: "alice", score: 12 }, { "name" => "bob", "score" => 7 }], headers: ["name", :score])
+end
+
+def missing_headers
+  to_table([])
+end
+
+def bad_row
+  to_table([1], headers: [:a])
+end`)
+
+	got := callScript(t, context.Background(), script, "hashes", nil, CallOptions{})
+	want := "name      score  team\n" +
+		"--------  -----  ----\n" +
+		"alice     12     red\n" +
+		"bob       7\n" +
+		"carolina  105    blue\n"
+	if got.String() != want {
+		t.Fatalf("hashes table =\n%s\nwant\n%s", got.String(), want)
+	}
+
+	got = callScript(t, context.Background(), script, "arrays", nil, CallOptions{})
+	want = "key         value\n" +
+		"----------  -----\n" +
+		"x           1\n" +
+		"long value\n"
+	if got.String() != want {
+		t.Fatalf("arrays table =\n%s\nwant\n%s", got.String(), want)
+	}
+
+	got = callScript(t, context.Background(), script, "string_headers", nil, CallOptions{})
+	want = "name   score\n" +
+		"-----  -----\n" +
+		"alice  12\n" +
+		"bob    7\n"
+	if got.String() != want {
+		t.Fatalf("string headers table =\n%s\nwant\n%s", got.String(), want)
+	}
+
+	requireCallErrorContains(t, script, "missing_headers", nil, CallOptions{}, "to_table requires headers:")
+	requireCallErrorContains(t, script, "bad_row", nil, CallOptions{}, "to_table row 0: expected a hash or array")
+}
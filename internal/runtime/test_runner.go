@@ -0,0 +1,69 @@
+package runtime
+
+import (
+	"context"
+	"errors"
+	"strings"
+)
+
+// TestFunctionPrefix marks the functions RunTests treats as tests.
+const TestFunctionPrefix = "test_"
+
+// TestResult is the outcome of one test function run by RunTests.
+type TestResult struct {
+	Name   string
+	Passed bool
+	// Message and Frames describe a failure: the runtime error message, such
+	// as a failed assert's, and its stack frames. Err is the error itself.
+	Message string
+	Frames  []StackFrame
+	Err     error
+}
+
+// RunTests calls each function whose name starts with "test_", in name
+// order, and reports whether it finished without raising. A failed assert
+// or any other runtime error fails the test; a test function that requires
+// arguments fails without running.
+func (s *Script) RunTests(ctx context.Context) []TestResult {
+	return s.RunTestsMatching(ctx, nil)
+}
+
+// RunTestsMatching is like RunTests but runs only the test functions whose
+// name match accepts. A nil match runs them all.
+func (s *Script) RunTestsMatching(ctx context.Context, match func(name string) bool) []TestResult {
+	var results []TestResult
+	for _, fn := range s.Functions() {
+		if !strings.HasPrefix(fn.Name, TestFunctionPrefix) {
+			continue
+		}
+		if match != nil && !match(fn.Name) {
+			continue
+		}
+		results = append(results, s.runTest(ctx, fn))
+	}
+	return results
+}
+
+func (s *Script) runTest(ctx context.Context, fn *ScriptFunction) TestResult {
+	result := TestResult{Name: fn.Name}
+	for _, param := range fn.Params {
+		if (param.Kind == ParamNormal || param.Kind == ParamKeyword) && param.DefaultVal == nil {
+			result.Err = errors.New("test functions must not require parameters")
+			result.Message = result.Err.Error()
+			return result
+		}
+	}
+	_, err := s.Call(ctx, fn.Name, nil, CallOptions{})
+	if err == nil {
+		result.Passed = true
+		return result
+	}
+	result.Err = err
+	result.Message = err.Error()
+	var runtimeErr *RuntimeError
+	if errors.As(err, &runtimeErr) {
+		result.Message = runtimeErr.Message
+		result.Frames = runtimeErr.Frames
+	}
+	return result
+}
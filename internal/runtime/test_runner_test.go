@@ -0,0 +1,70 @@
+package runtime
+
+import (
+	"context"
+	"testing"
+)
+
+func TestRunTestsReportsOutcomes(t *testing.T) {
+	t.Parallel()
+	script := compileScriptDefault(t, `def double(n)
+  n * 2
+end
+
+def test_double
+  assert double(2) == 4, "double(2) should be 4"
+end
+
+def test_wrong_expectation
+  assert double(3) == 5, "double(3) should be 5"
+end
+
+def test_raises
+  [1, 2].fetch(5)
+end
+
+def test_needs_argument(value)
+  value
+end
+
+def helper_not_a_test
+  assert false, "never runs"
+end`)
+
+	results := script.RunTests(context.Background())
+	names := make([]string, len(results))
+	for i, result := range results {
+		names[i] = result.Name
+	}
+	want := []string{"test_double", "test_needs_argument", "test_raises", "test_wrong_expectation"}
+	if len(names) != len(want) {
+		t.Fatalf("ran %v, want %v", names, want)
+	}
+	for i := range want {
+		if names[i] != want[i] {
+			t.Fatalf("ran %v, want %v", names, want)
+		}
+	}
+
+	if pass := results[0]; !pass.Passed || pass.Err != nil || pass.Message != "" {
+		t.Fatalf("test_double = %+v, want pass", pass)
+	}
+	if args := results[1]; args.Passed || args.Message != "test functions must not require parameters" {
+		t.Fatalf("test_needs_argument = %+v, want parameter failure", args)
+	}
+	if raised := results[2]; raised.Passed || len(raised.Frames) == 0 || raised.Frames[0].Function != "test_raises" {
+		t.Fatalf("test_raises = %+v, want failure framed in test_raises", raised)
+	}
+	failed := results[3]
+	if failed.Passed || failed.Message != "double(3) should be 5" {
+		t.Fatalf("test_wrong_expectation = %+v, want assertion failure", failed)
+	}
+	if len(failed.Frames) == 0 || failed.Frames[0].Function != "test_wrong_expectation" || failed.Frames[0].Pos.Line != 10 {
+		t.Fatalf("test_wrong_expectation frames = %+v, want line 10 of test_wrong_expectation", failed.Frames)
+	}
+
+	filtered := script.RunTestsMatching(context.Background(), func(name string) bool { return name == "test_double" })
+	if len(filtered) != 1 || filtered[0].Name != "test_double" {
+		t.Fatalf("filtered results = %+v, want only test_double", filtered)
+	}
+}
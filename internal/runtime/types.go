@@ -45,11 +45,11 @@ func quickTypeCheck(val Value, ty *TypeExpr) (bool, bool) {
 	case TypeAny:
 		return true, true
 	case TypeInt:
-		return true, val.Kind() == KindInt
+		return true, isIntegerValue(val)
 	case TypeFloat:
 		return true, val.Kind() == KindFloat
 	case TypeNumber:
-		return true, val.Kind() == KindInt || val.Kind() == KindFloat
+		return true, isIntegerValue(val) || val.Kind() == KindFloat
 	case TypeString:
 		return true, val.Kind() == KindString
 	case TypeBool:
@@ -169,11 +169,11 @@ func (s *typeValidationState) matches(val Value, ty *TypeExpr) (bool, error) {
 	case TypeAny:
 		return true, nil
 	case TypeInt:
-		return val.Kind() == KindInt, nil
+		return isIntegerValue(val), nil
 	case TypeFloat:
 		return val.Kind() == KindFloat, nil
 	case TypeNumber:
-		return val.Kind() == KindInt || val.Kind() == KindFloat, nil
+		return isIntegerValue(val) || val.Kind() == KindFloat, nil
 	case TypeString:
 		return val.Kind() == KindString, nil
 	case TypeBool:
@@ -419,6 +419,8 @@ func (s *valueTypeFormatState) format(val Value, depth int) string {
 		return "bool"
 	case KindInt:
 		return "int"
+	case KindBigInt:
+		return "bigint"
 	case KindFloat:
 		return "float"
 	case KindString:
@@ -39,7 +39,7 @@ func normalizeValueForType(val Value, ty *TypeExpr, ctx typeContext) (Value, err
 	case TypeAny:
 		return val, nil
 	case TypeInt:
-		if val.Kind() == KindInt {
+		if isIntegerValue(val) {
 			return val, nil
 		}
 	case TypeFloat:
@@ -47,7 +47,7 @@ func normalizeValueForType(val Value, ty *TypeExpr, ctx typeContext) (Value, err
 			return val, nil
 		}
 	case TypeNumber:
-		if val.Kind() == KindInt || val.Kind() == KindFloat {
+		if isIntegerValue(val) || val.Kind() == KindFloat {
 			return val, nil
 		}
 	case TypeString:
@@ -1,8 +1,12 @@
 package runtime
 
 // ClassDef represents a user-defined class with its methods and class-level state.
+// Methods and ClassMethods hold only the class's own definitions; Superclass
+// (nil for a root class) supplies inherited ones through findMethod and
+// findClassMethod.
 type ClassDef struct {
 	Name         string
+	Superclass   *ClassDef
 	Methods      map[string]*ScriptFunction
 	ClassMethods map[string]*ScriptFunction
 	ClassVars    map[string]Value
@@ -11,6 +15,38 @@ type ClassDef struct {
 	owner        *Script
 }
 
+// findMethod resolves an instance method on the class or its nearest ancestor
+// that defines it.
+func (c *ClassDef) findMethod(name string) (*ScriptFunction, bool) {
+	for cl := c; cl != nil; cl = cl.Superclass {
+		if fn, ok := cl.Methods[name]; ok {
+			return fn, true
+		}
+	}
+	return nil, false
+}
+
+// findClassMethod resolves a class method on the class or its nearest ancestor
+// that defines it.
+func (c *ClassDef) findClassMethod(name string) (*ScriptFunction, bool) {
+	for cl := c; cl != nil; cl = cl.Superclass {
+		if fn, ok := cl.ClassMethods[name]; ok {
+			return fn, true
+		}
+	}
+	return nil, false
+}
+
+// inherits reports whether c is ancestor or one of its subclasses.
+func (c *ClassDef) inherits(ancestor *ClassDef) bool {
+	for cl := c; cl != nil; cl = cl.Superclass {
+		if cl == ancestor {
+			return true
+		}
+	}
+	return false
+}
+
 // Instance represents a runtime instance of a ClassDef with its own instance variables.
 type Instance struct {
 	Class *ClassDef
@@ -43,7 +43,7 @@ func scalarValueKey(v Value) (scalarValueSetKey, bool) {
 		key.intVal = v.Int()
 	case KindFloat:
 		key.floatVal = v.Float()
-	case KindString, KindSymbol:
+	case KindString, KindSymbol, KindBigInt:
 		key.textVal = v.String()
 	case KindMoney:
 		key.moneyVal = v.Money()
@@ -57,10 +57,10 @@ func scalarValueKey(v Value) (scalarValueSetKey, bool) {
 	return key, true
 }
 
-// valueSet tracks membership of Values using value equality, collapsing
+// valueSet tracks membership of Values using eql? equality, collapsing
 // duplicates as values are added. Scalar values are indexed in a map keyed by
-// their content, while composite values (arrays, hashes, and other non-scalar
-// kinds) fall back to a linear scan with Value.Equal. union and uniq build on it
+// their kind and content, while composite values (arrays, hashes, and other
+// non-scalar kinds) fall back to a linear scan with Value.Eql. union and uniq build on it
 // because they need duplicate collapsing; difference and subtract use the
 // non-deduping membershipSet instead.
 type valueSet struct {
@@ -72,7 +72,7 @@ type valueSet struct {
 // hint sizes the scalar map on first use; it is capped by boundedSetCap so a
 // huge input length never drives an oversized map allocation, letting the map
 // grow to the number of distinct scalars actually inserted. Composite values are
-// deduplicated via a linear Value.Equal scan, so add is suited to the
+// deduplicated via a linear Value.Eql scan, so add is suited to the
 // duplicate-collapsing helpers (union, uniq) but not to membership-only callers
 // where that scan would make insertion quadratic.
 func (s *valueSet) add(v Value, hint int) bool {
@@ -93,10 +93,10 @@ func (s *valueSet) add(v Value, hint int) bool {
 	return true
 }
 
-// membershipSet answers contains queries with value equality but, unlike
+// membershipSet answers contains queries with eql? equality but, unlike
 // valueSet, never deduplicates on insertion. Scalars are indexed in a map for
 // O(1) membership. Composites are not copied at all: the set retains references
-// to the caller's own source slices and scans them with Value.Equal only when
+// to the caller's own source slices and scans them with Value.Eql only when
 // contains is asked about a composite. difference and subtract use it because
 // they only need to know whether the removal side holds a value, never how many
 // times. Retaining the source slices rather than flattening their composites
@@ -107,7 +107,7 @@ type membershipSet struct {
 	scalars map[scalarValueSetKey]struct{}
 	// composite holds references to the source slices that contain at least one
 	// composite value. contains scans these directly; scalar elements within
-	// them are skipped cheaply because Value.Equal short-circuits on a kind
+	// them are skipped cheaply because Value.Eql short-circuits on a kind
 	// mismatch with the composite being looked up.
 	composite [][]Value
 }
@@ -251,6 +251,35 @@ func intersectArrayValues(left, right []Value) []Value {
 	return out
 }
 
+// intersectionArrayValues folds intersectArrayValues over every argument,
+// mirroring Ruby's Array#intersection(*others). With no arguments it
+// deduplicates the receiver, as Ruby does.
+func intersectionArrayValues(left []Value, others [][]Value) []Value {
+	if len(others) == 0 {
+		return unionArrayValues(left, nil)
+	}
+	out := left
+	for _, other := range others {
+		out = intersectArrayValues(out, other)
+	}
+	return out
+}
+
+// concatArrayValues appends every argument array to a copy of left, keeping
+// duplicates, like chaining `+` (Ruby's Array#concat without the mutation).
+func concatArrayValues(left []Value, others [][]Value) []Value {
+	total := len(left)
+	for _, other := range others {
+		total += len(other)
+	}
+	out := make([]Value, 0, total)
+	out = append(out, left...)
+	for _, other := range others {
+		out = append(out, other...)
+	}
+	return out
+}
+
 func subtractArrayValues(left, right []Value) []Value {
 	var removal membershipSet
 	removal.addSource(right, len(right))
@@ -264,9 +293,13 @@ func subtractArrayValues(left, right []Value) []Value {
 	return out
 }
 
+// containsEqualValue reports whether values holds an element eql? to target.
+// The set helpers use eql? rather than == at every depth, as Ruby's uniq and
+// array set operators do, so [[1], [1.0]].uniq keeps both elements just as the
+// kind-keyed scalar map keeps both of [1, 1.0].
 func containsEqualValue(values []Value, target Value) bool {
 	for _, candidate := range values {
-		if target.Equal(candidate) {
+		if target.Eql(candidate) {
 			return true
 		}
 	}
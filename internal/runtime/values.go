@@ -7,6 +7,7 @@ import (
 	"math/big"
 	"math/bits"
 	"reflect"
+	"slices"
 	"strings"
 	"time"
 
@@ -168,8 +169,25 @@ var errWidthOutOfRange = errors.New("width is out of range")
 // errIncomparableOperands signals that two operands of different kinds cannot
 // be ordered. The spaceship operator detects it with isIncomparable and yields
 // nil, matching Ruby's `1 <=> "a"`, while relational operators surface it.
+// compareValueOrder reports the pair through incomparableOperandsError, which
+// matches this sentinel under errors.Is.
 var errIncomparableOperands = errors.New("unsupported comparison operands")
 
+// incomparableOperandsError names an operand pair that cannot be ordered, so
+// `1 < "a"` reads "cannot compare int with string" rather than a bare failure.
+// It is errIncomparableOperands under errors.Is.
+type incomparableOperandsError struct {
+	left, right Value
+}
+
+func (e incomparableOperandsError) Error() string {
+	return incomparablePairText(e.left, e.right)
+}
+
+func (e incomparableOperandsError) Is(target error) bool {
+	return target == errIncomparableOperands
+}
+
 // errMoneyCompareMismatch signals that two money values cannot be ordered
 // because their currencies differ. Its message follows the documented
 // comparison convention; the spaceship operator still treats it as
@@ -182,6 +200,15 @@ func isIncomparable(err error) bool {
 	return errors.Is(err, errIncomparableOperands) || errors.Is(err, errMoneyCompareMismatch)
 }
 
+// raisedByScript reports whether a comparison error came out of script code,
+// such as a user-defined <=> that raised, rather than from the built-in
+// ordering. Sort, min, and max surface such errors unchanged instead of
+// recasting them as an incomparable pair.
+func raisedByScript(err error) bool {
+	var runtimeErr *RuntimeError
+	return errors.As(err, &runtimeErr)
+}
+
 // valueToPadWidth converts a numeric width argument to an int, truncating
 // fractional Floats toward zero like Ruby's to_int. Unlike valueToCount it
 // permits negative widths because padding helpers treat a width at or below the
@@ -270,8 +297,77 @@ func sortComparisonResult(val Value) (int, error) {
 	}
 }
 
-func arraySortCompareValues(left, right Value) (int, error) {
+// maxSortKeyNestingDepth bounds how deeply arraySortCompareNested descends
+// into array keys.
+const maxSortKeyNestingDepth = 10000
+
+// arraySortCompareValues orders two values for sort, min, max, and their
+// relatives. An instance operand on the left is ordered by its class's <=>
+// method, which must return an integer, as the relational operators do.
+func arraySortCompareValues(exec *Execution, left, right Value) (int, error) {
+	return arraySortCompareNested(exec, left, right, 0)
+}
+
+// arraySortCompareNested is arraySortCompareValues with the array nesting
+// depth threaded through, so composite keys such as [tier, score] compare
+// element by element while a self-referencing array fails instead of
+// recursing forever.
+//
+// An element pair that cannot be ordered is reported as a sortKeyPairError
+// naming that innermost pair, so describeIncomparablePair can describe it
+// without walking the arrays again.
+func arraySortCompareNested(exec *Execution, left, right Value, depth int) (int, error) {
 	switch {
+	case left.Kind() == KindArray && right.Kind() == KindArray:
+		if depth >= maxSortKeyNestingDepth {
+			return 0, &sortKeyPairError{left: left, right: right, err: errSortKeyTooDeep}
+		}
+		leftElems, rightElems := left.Array(), right.Array()
+		for i := 0; i < len(leftElems) && i < len(rightElems); i++ {
+			cmp, err := arraySortCompareNested(exec, leftElems[i], rightElems[i], depth+1)
+			if err != nil {
+				var pairErr *sortKeyPairError
+				if !errors.As(err, &pairErr) {
+					err = &sortKeyPairError{left: leftElems[i], right: rightElems[i], err: err}
+				}
+				return 0, err
+			}
+			if cmp != 0 {
+				return cmp, nil
+			}
+		}
+		switch {
+		case len(leftElems) < len(rightElems):
+			return -1, nil
+		case len(leftElems) > len(rightElems):
+			return 1, nil
+		default:
+			return 0, nil
+		}
+	case left.Kind() == KindInstance:
+		result, ok, err := exec.callOperatorMethod(left, string(tokenSpaceship), []Value{right}, Position{})
+		switch {
+		case !ok:
+			return 0, fmt.Errorf("values are not comparable")
+		case err != nil:
+			return 0, err
+		case result.Kind() != KindInt:
+			return 0, exec.errorAt(Position{}, "comparison of %s with %s failed", operandName(left), operandName(right))
+		}
+		switch order := result.Int(); {
+		case order < 0:
+			return -1, nil
+		case order > 0:
+			return 1, nil
+		default:
+			return 0, nil
+		}
+	case bigIntComparable(left, right):
+		order, ordered := compareBigIntOrder(left, right)
+		if !ordered {
+			return 0, fmt.Errorf("cannot compare NaN")
+		}
+		return order, nil
 	case left.Kind() == KindInt && right.Kind() == KindInt:
 		switch {
 		case left.Int() < right.Int():
@@ -361,9 +457,59 @@ func arraySortCompareValues(left, right Value) (int, error) {
 	}
 }
 
+// errSortKeyTooDeep reports array sort keys nested past maxSortKeyNestingDepth,
+// which in practice means a self-referencing array.
+var errSortKeyTooDeep = errors.New("values are nested too deeply to compare")
+
+// sortKeyPairError carries the innermost element pair of two array sort keys
+// that could not be ordered.
+type sortKeyPairError struct {
+	left, right Value
+	err         error
+}
+
+func (e *sortKeyPairError) Error() string { return e.err.Error() }
+
+func (e *sortKeyPairError) Unwrap() error { return e.err }
+
+// describeIncomparablePair names the operands of a comparison that
+// arraySortCompareValues rejected. For array sort keys it names the innermost
+// element pair, so a failed sort points at the offending values rather than
+// at the arrays holding them.
+func describeIncomparablePair(exec *Execution, left, right Value) string {
+	if left.Kind() == KindArray && right.Kind() == KindArray {
+		var pairErr *sortKeyPairError
+		if _, err := arraySortCompareValues(exec, left, right); errors.As(err, &pairErr) {
+			if errors.Is(pairErr, errSortKeyTooDeep) {
+				return fmt.Sprintf("arrays nested more than %d levels deep", maxSortKeyNestingDepth)
+			}
+			return describeIncomparablePair(exec, pairErr.left, pairErr.right)
+		}
+	}
+	return incomparablePairText(left, right)
+}
+
+// incomparablePairText is the "cannot compare X with Y" wording shared by the
+// relational operators, sort, and between?/clamp. Two money values name their
+// currencies, since same-kind money differs only by currency, and a NaN float
+// is called out because it orders against nothing, not even another float.
+func incomparablePairText(left, right Value) string {
+	if left.Kind() == KindMoney && right.Kind() == KindMoney {
+		return fmt.Sprintf("cannot compare %s money with %s money", left.Money().Currency(), right.Money().Currency())
+	}
+	return fmt.Sprintf("cannot compare %s with %s", comparisonOperandName(left), comparisonOperandName(right))
+}
+
+func comparisonOperandName(val Value) string {
+	if val.Kind() == KindFloat && math.IsNaN(val.Float()) {
+		return "float NaN"
+	}
+	return val.Kind().String()
+}
+
 // flattenValues recursively flattens nested arrays up to the specified depth.
 // depth=-1 means flatten completely (no limit).
-// depth=0 means don't flatten at all.
+// depth=0 means don't flatten at all and returns a shallow copy.
 // depth=1 means flatten one level, etc.
 // method names the caller (e.g. "array.flatten" or "hash.flatten") so the depth
 // and cycle errors read in terms of the method the script invoked.
@@ -374,6 +520,16 @@ type flattenState struct {
 }
 
 func flattenValues(values []Value, depth int, method string) ([]Value, error) {
+	if depth == 0 {
+		out := make([]Value, len(values))
+		copy(out, values)
+		return out, nil
+	}
+	// Nesting past maxFlattenDepth is rejected on the way down, so any larger
+	// depth behaves exactly like a full flatten.
+	if depth >= maxFlattenDepth {
+		depth = -1
+	}
 	return flattenValuesWithState(values, depth, &flattenState{
 		arrays: make(map[sliceIdentity]struct{}),
 		method: method,
@@ -654,6 +810,8 @@ func timeDifferenceSeconds(left, right time.Time) (float64, error) {
 
 func addValues(left, right Value) (Value, error) {
 	switch {
+	case involvesBigInt(left, right):
+		return bigIntArithmetic('+', "addition", left, right)
 	case left.Kind() == KindInt && right.Kind() == KindInt:
 		sum, ok := addInt64Checked(left.Int(), right.Int())
 		if !ok {
@@ -732,13 +890,21 @@ func addValues(left, right Value) (Value, error) {
 	}
 }
 
-// shovelValues implements the array shovel operator `array << value`. Ruby
-// mutates the receiver in place and returns it, but Vibescript collections are
-// non-mutating, so this returns a new array with the single value appended,
-// matching how Array#push and `array + [value]` behave. The idiomatic
-// accumulator pattern is reassignment (`values = values << x`), which the
-// runtime routes through the same backing-buffer fast path as those forms.
+// shovelValues implements the shovel operator `array << value` and
+// `string << other`. Ruby mutates the receiver in place and returns it, but
+// Vibescript collections and strings are non-mutating, so this returns a new
+// array with the single value appended, matching how Array#push and
+// `array + [value]` behave, or the concatenated string, matching `+`. The
+// idiomatic accumulator pattern is reassignment (`values = values << x`),
+// which the runtime routes through the same backing-buffer fast path as those
+// forms for arrays.
 func shovelValues(left, right Value) (Value, error) {
+	if left.Kind() == KindString {
+		if right.Kind() != KindString {
+			return NewNil(), fmt.Errorf("string << expects a string, got %s", right.Kind())
+		}
+		return NewString(left.String() + right.String()), nil
+	}
 	if left.Kind() != KindArray {
 		return NewNil(), fmt.Errorf("unsupported shovel operands")
 	}
@@ -761,6 +927,8 @@ func intersectValues(left, right Value) (Value, error) {
 
 func subtractValues(left, right Value) (Value, error) {
 	switch {
+	case involvesBigInt(left, right):
+		return bigIntArithmetic('-', "subtraction", left, right)
 	case left.Kind() == KindInt && right.Kind() == KindInt:
 		diff, ok := subInt64Checked(left.Int(), right.Int())
 		if !ok {
@@ -820,6 +988,8 @@ func subtractValues(left, right Value) (Value, error) {
 
 func multiplyValues(left, right Value) (Value, error) {
 	switch {
+	case involvesBigInt(left, right):
+		return bigIntArithmetic('*', "multiplication", left, right)
 	case left.Kind() == KindInt && right.Kind() == KindInt:
 		product, ok := mulInt64Checked(left.Int(), right.Int())
 		if !ok {
@@ -865,6 +1035,51 @@ func multiplyValues(left, right Value) (Value, error) {
 	}
 }
 
+// repeatValues implements the sequence forms of `*` that Ruby defines on
+// String and Array: "ab" * 3 repeats the string, [0] * 3 repeats the
+// elements, and [1, 2] * "," joins like array.join. The result is projected
+// against the memory quota, and an array repeat against
+// Config.MaxCollectionSize, before anything is allocated.
+func (exec *Execution) repeatValues(left, right Value, pos Position) (Value, error) {
+	if left.Kind() == KindArray && right.Kind() == KindString {
+		join, err := exec.getMember(left, "join", pos)
+		if err != nil {
+			return NewNil(), err
+		}
+		return exec.invokeCallable(join, left, []Value{right}, nil, NewNil(), pos)
+	}
+	name := "string"
+	if left.Kind() == KindArray {
+		name = "array"
+	}
+	if right.Kind() != KindInt {
+		return NewNil(), fmt.Errorf("%s repeat count must be an integer", name)
+	}
+	count := right.Int()
+	if count < 0 {
+		return NewNil(), fmt.Errorf("%s repeat count must be non-negative", name)
+	}
+	if left.Kind() == KindString {
+		text := left.String()
+		if err := exec.checkProjectedStringBytes(saturatingMul(len(text), int(count))); err != nil {
+			return NewNil(), err
+		}
+		return NewString(strings.Repeat(text, int(count))), nil
+	}
+	items := left.Array()
+	if len(items) == 0 || count == 0 {
+		return NewArray([]Value{}), nil
+	}
+	total := saturatingMul(len(items), int(count))
+	if err := exec.checkCollectionSize("array *", total); err != nil {
+		return NewNil(), err
+	}
+	if err := exec.checkProjectedIntArrayBytesWithLive(total, 0, left); err != nil {
+		return NewNil(), err
+	}
+	return NewArray(slices.Repeat(items, int(count))), nil
+}
+
 func powerValues(left, right Value) (Value, error) {
 	switch {
 	case left.Kind() == KindInt && right.Kind() == KindInt && right.Int() >= 0:
@@ -914,6 +1129,8 @@ func isNumericValue(val Value) bool {
 
 func divideValues(left, right Value) (Value, error) {
 	switch {
+	case involvesBigInt(left, right):
+		return bigIntArithmetic('/', "division", left, right)
 	case left.Kind() == KindInt && right.Kind() == KindInt:
 		if right.Int() == 0 {
 			return NewNil(), newTypedRuntimeError(runtimeErrorTypeZeroDiv, errors.New("division by zero"))
@@ -966,6 +1183,9 @@ func moduloValues(left, right Value) (Value, error) {
 		}
 		return formatStringValues(left.String(), values)
 	}
+	if involvesBigInt(left, right) {
+		return bigIntArithmetic('%', "modulo", left, right)
+	}
 	if left.Kind() == KindInt && right.Kind() == KindInt {
 		if right.Int() == 0 {
 			return NewNil(), zeroDivisionErrorf("modulo by zero")
@@ -998,7 +1218,14 @@ func floorModInt(left, right int64) int64 {
 	return remainder
 }
 
-func compareValues(left, right Value, cmp func(int) bool) (Value, error) {
+func (exec *Execution) compareValues(left, right Value, cmp func(int) bool) (Value, error) {
+	if left.Kind() == KindHash && right.Kind() == KindHash {
+		order, ordered, err := exec.hashSubsetOrder(left, right)
+		if err != nil || !ordered {
+			return NewBool(false), err
+		}
+		return NewBool(cmp(order)), nil
+	}
 	order, ordered, err := compareValueOrder(left, right)
 	if err != nil {
 		return NewNil(), err
@@ -1011,6 +1238,60 @@ func compareValues(left, right Value, cmp func(int) bool) (Value, error) {
 	return NewBool(cmp(order)), nil
 }
 
+// hashSubsetOrder orders two hashes by containment, as Ruby's Hash#<= and
+// friends do: -1 when every entry of left appears with an equal value in right
+// and right has more entries, 1 for the reverse, and 0 when they hold the same
+// entries. Hashes where neither contains the other are unordered, so every
+// relational comparison between them is false. The spaceship operator and
+// sorting do not use this partial order.
+func (exec *Execution) hashSubsetOrder(left, right Value) (order int, ordered bool, err error) {
+	leftInRight, err := exec.hashContainsEntries(right, left)
+	if err != nil {
+		return 0, false, err
+	}
+	rightInLeft, err := exec.hashContainsEntries(left, right)
+	if err != nil {
+		return 0, false, err
+	}
+	switch {
+	case leftInRight && rightInLeft:
+		return 0, true, nil
+	case leftInRight:
+		return -1, true, nil
+	case rightInLeft:
+		return 1, true, nil
+	default:
+		return 0, false, nil
+	}
+}
+
+// hashContainsEntries reports whether every entry of subset is present in
+// container under the same key with an equal value. Values are compared with
+// valuesEqual, so instances with a user-defined == compare as they do in
+// include?, and each entry is charged a step.
+func (exec *Execution) hashContainsEntries(container, subset Value) (bool, error) {
+	if subset.HashLen() > container.HashLen() {
+		return false, nil
+	}
+	for _, entry := range subset.HashEntries() {
+		if err := exec.step(); err != nil {
+			return false, err
+		}
+		val, ok, err := hashGet(container, entry.Key)
+		if err != nil {
+			return false, err
+		}
+		if !ok {
+			return false, nil
+		}
+		equal, err := exec.valuesEqual(val, entry.Value)
+		if err != nil || !equal {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
 // compareValueOrder reports the relative order of two values as -1, 0, or 1.
 // The ordered result is false when the operands are numeric but unordered (a
 // NaN on either side); callers translate that into false comparisons and a nil
@@ -1020,6 +1301,9 @@ func compareValues(left, right Value, cmp func(int) bool) (Value, error) {
 // operator turns that into nil while relational operators surface it.
 func compareValueOrder(left, right Value) (order int, ordered bool, err error) {
 	switch {
+	case bigIntComparable(left, right):
+		order, ordered := compareBigIntOrder(left, right)
+		return order, ordered, nil
 	case left.Kind() == KindInt && right.Kind() == KindInt:
 		switch {
 		case left.Int() < right.Int():
@@ -1082,6 +1366,6 @@ func compareValueOrder(left, right Value) (order int, ordered bool, err error) {
 			return 0, true, nil
 		}
 	default:
-		return 0, false, errIncomparableOperands
+		return 0, false, incomparableOperandsError{left: left, right: right}
 	}
 }
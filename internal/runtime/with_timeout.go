@@ -0,0 +1,93 @@
+package runtime
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+)
+
+var errBlockTimedOut = errors.New("block timed out")
+
+// builtinWithTimeout runs its block under a budget tighter than the call's
+// own: a deadline derived from the current context and, with steps:, a cap
+// on the interpreter steps the block may charge. Exhausting either raises a
+// rescuable "block timed out" error at the call site instead of aborting the
+// script, while the outer deadline and step quota keep their usual effect.
+func builtinWithTimeout(exec *Execution, receiver Value, args []Value, kwargs map[string]Value, block Value) (Value, error) {
+	if len(args) != 1 {
+		return NewNil(), fmt.Errorf("with_timeout expects a duration")
+	}
+	timeout, err := withTimeoutDuration(args[0])
+	if err != nil {
+		return NewNil(), err
+	}
+	stepBudget := 0
+	for key, val := range kwargs {
+		if key != "steps" {
+			return NewNil(), fmt.Errorf("with_timeout does not accept keyword %s", key)
+		}
+		if val.Kind() != KindInt || val.Int() <= 0 {
+			return NewNil(), fmt.Errorf("with_timeout steps must be a positive integer")
+		}
+		stepBudget = int(min(val.Int(), int64(math.MaxInt32)))
+	}
+	runner, err := newBlockCallRunner(exec, block, "with_timeout", NewNil(), nil, nil)
+	if err != nil {
+		return NewNil(), err
+	}
+
+	parent := exec.ctx
+	if parent == nil {
+		parent = context.Background()
+	}
+	ctx, cancel := context.WithTimeout(parent, timeout)
+	defer cancel()
+	outerQuota := exec.quota
+	innerQuota := 0
+	if stepBudget > 0 && (outerQuota <= 0 || exec.steps+stepBudget < outerQuota) {
+		innerQuota = exec.steps + stepBudget
+		exec.quota = innerQuota
+	}
+	exec.ctx = ctx
+	defer func() {
+		exec.ctx = parent
+		exec.quota = outerQuota
+	}()
+
+	val, err := runner.call(nil)
+	if err == nil {
+		return val, nil
+	}
+	if innerQuota > 0 && exec.steps > innerQuota {
+		return NewNil(), errBlockTimedOut
+	}
+	if isHostControlSignal(err) && ctx.Err() != nil && parent.Err() == nil {
+		return NewNil(), errBlockTimedOut
+	}
+	return NewNil(), err
+}
+
+// withTimeoutDuration converts a duration value or a number of seconds into a
+// positive time.Duration.
+func withTimeoutDuration(val Value) (time.Duration, error) {
+	var seconds float64
+	switch val.Kind() {
+	case KindDuration:
+		seconds = float64(val.Duration().Seconds())
+	case KindInt:
+		seconds = float64(val.Int())
+	case KindFloat:
+		seconds = val.Float()
+	default:
+		return 0, fmt.Errorf("with_timeout duration must be a duration or number of seconds")
+	}
+	if !(seconds > 0) || math.IsInf(seconds, 0) {
+		return 0, fmt.Errorf("with_timeout duration must be positive")
+	}
+	if seconds > float64(maxSleepWholeSeconds) {
+		return 0, guardLimitErrorf("with_timeout duration exceeds maximum")
+	}
+	return time.Duration(seconds * float64(time.Second)), nil
+}
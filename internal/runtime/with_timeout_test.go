@@ -0,0 +1,140 @@
+package runtime
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestWithTimeoutRecoversFromInnerBudget(t *testing.T) {
+	t.Parallel()
+
+	script := compileScriptWithConfig(t, Config{StepQuota: 1 << 40}, `def step_bounded
+  attempts = 0
+  result = nil
+  begin
+    with_timeout(5.seconds, steps: 200) do
+      loop do
+        attempts = attempts + 1
+      end
+    end
+  rescue => err
+    result = err.message
+  end
+  after = [1, 2, 3].map { |n| n * 2 }
+  [result, attempts > 0, after]
+end
+
+def time_bounded
+  begin
+    with_timeout(0.02) do
+      loop do
+        nil
+      end
+    end
+  rescue => err
+    err.message
+  end
+end
+
+def completes
+  with_timeout(1, steps: 1000) do
+    [1, 2, 3].sum
+  end
+end`)
+
+	got := callFunc(t, script, "step_bounded", nil)
+	compareArrays(t, got, []Value{
+		NewString("block timed out"),
+		NewBool(true),
+		NewArray([]Value{NewInt(2), NewInt(4), NewInt(6)}),
+	})
+	if got := callFunc(t, script, "time_bounded", nil); !got.Equal(NewString("block timed out")) {
+		t.Fatalf("time_bounded() = %v, want block timed out", got)
+	}
+	if got := callFunc(t, script, "completes", nil); !got.Equal(NewInt(6)) {
+		t.Fatalf("completes() = %v, want 6", got)
+	}
+}
+
+func TestWithTimeoutKeepsOuterLimits(t *testing.T) {
+	t.Parallel()
+
+	script := compileScriptWithConfig(t, Config{StepQuota: 500}, `def run
+  begin
+    with_timeout(5, steps: 100000) do
+      loop do
+        nil
+      end
+    end
+  rescue => err
+    "rescued"
+  end
+end`)
+
+	requireCallErrorContains(t, script, "run", nil, CallOptions{}, "step quota exceeded")
+
+	script = compileScriptWithConfig(t, Config{StepQuota: 1 << 40}, `def run
+  begin
+    with_timeout(5) do
+      loop do
+        nil
+      end
+    end
+  rescue => err
+    "rescued"
+  end
+end`)
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if _, err := script.Call(ctx, "run", nil, CallOptions{}); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("outer deadline error = %v, want context.DeadlineExceeded", err)
+	}
+}
+
+func TestWithTimeoutArgumentErrors(t *testing.T) {
+	t.Parallel()
+
+	script := compileScript(t, `def no_block
+  with_timeout(1)
+end
+
+def no_duration
+  with_timeout { 1 }
+end
+
+def bad_duration
+  with_timeout("1s") { 1 }
+end
+
+def zero_duration
+  with_timeout(0) { 1 }
+end
+
+def bad_steps
+  with_timeout(1, steps: 0) { 1 }
+end
+
+def bad_keyword
+  with_timeout(1, ticks: 5) { 1 }
+end`)
+
+	tests := []struct {
+		fn   string
+		want string
+	}{
+		{fn: "no_block", want: "with_timeout requires a block"},
+		{fn: "no_duration", want: "with_timeout expects a duration"},
+		{fn: "bad_duration", want: "with_timeout duration must be a duration or number of seconds"},
+		{fn: "zero_duration", want: "with_timeout duration must be positive"},
+		{fn: "bad_steps", want: "with_timeout steps must be a positive integer"},
+		{fn: "bad_keyword", want: "with_timeout does not accept keyword ticks"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.fn, func(t *testing.T) {
+			t.Parallel()
+			requireCallErrorContains(t, script, tt.fn, nil, CallOptions{}, tt.want)
+		})
+	}
+}
@@ -376,6 +376,10 @@ func analyzeMarkdownSnippetProgramDeclarations(program *ast.Program) []Warning {
 			for _, method := range typed.ClassMethods {
 				lintStatements(typed.Name+"."+method.Name, method.Body, &warnings)
 			}
+		case *ast.ModuleStmt:
+			for _, method := range typed.Methods {
+				lintStatements(typed.Name+"#"+method.Name, method.Body, &warnings)
+			}
 		}
 	}
 	sort.SliceStable(warnings, func(i, j int) bool {
@@ -446,7 +450,7 @@ func splitMarkdownSnippetTopLevelSource(source string, program *ast.Program) ([]
 
 func isMarkdownSnippetDeclaration(stmt ast.Statement) bool {
 	switch stmt.(type) {
-	case *ast.FunctionStmt, *ast.ClassStmt, *ast.EnumStmt:
+	case *ast.FunctionStmt, *ast.ClassStmt, *ast.ModuleStmt, *ast.EnumStmt:
 		return true
 	default:
 		return false
@@ -13,3 +13,36 @@ type CapabilityContractProvider = runtime.CapabilityContractProvider
 
 // CapabilityBinding provides execution context for adapters during binding.
 type CapabilityBinding = runtime.CapabilityBinding
+
+// CapabilityMethod describes a capability method bound into a call, as
+// listed by Execution.CapabilityMethods and Script.CapabilityMethods.
+type CapabilityMethod = runtime.CapabilityMethod
+
+// CapabilitySignature declares a capability method's argument, keyword, and
+// return types with script type annotations.
+type CapabilitySignature = runtime.CapabilitySignature
+
+// NewSignatureContract compiles a CapabilitySignature into a method contract.
+func NewSignatureContract(method string, sig CapabilitySignature) (CapabilityMethodContract, error) {
+	return runtime.NewSignatureContract(method, sig)
+}
+
+// MustNewSignatureContract is like NewSignatureContract but panics on a
+// malformed signature.
+func MustNewSignatureContract(method string, sig CapabilitySignature) CapabilityMethodContract {
+	return runtime.MustNewSignatureContract(method, sig)
+}
+
+// EffectLog collects the mutating capability calls of a dry run; see
+// CallOptions.DryRun.
+type EffectLog = runtime.EffectLog
+
+// Effect is one mutating capability call recorded in an EffectLog.
+type Effect = runtime.Effect
+
+// CallRecording captures a call's seed, clock, and capability results for
+// replay; see CallOptions.Record and CallOptions.Replay.
+type CallRecording = runtime.CallRecording
+
+// RecordedResult is one capability result in a CallRecording.
+type RecordedResult = runtime.RecordedResult
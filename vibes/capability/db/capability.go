@@ -89,6 +89,9 @@ type Contract struct {
 	ValidateReturn func(result value.Value) error
 	// CallValidated runs the method after ValidateArgs has already succeeded.
 	CallValidated func(exec ExecutionContext, args []value.Value, kwargs map[string]value.Value, block value.Value) (value.Value, error)
+	// Mutating marks methods that write to the database, which strict-effects
+	// hosts must opt into.
+	Mutating bool
 }
 
 // NewCapability constructs a database capability adapter bound to the
@@ -27,6 +27,7 @@ func (c *Capability) Contracts() map[string]Contract {
 			ValidateArgs:   c.validateUpdateContractArgs,
 			ValidateReturn: capabilitycontract.ValidateAnyReturn(c.name + ".update"),
 			CallValidated:  c.callUpdateValidated,
+			Mutating:       true,
 		},
 		c.name + ".sum": {
 			ValidateArgs:   c.validateSumContractArgs,
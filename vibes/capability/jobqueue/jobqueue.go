@@ -7,6 +7,7 @@ package jobqueue
 import (
 	"context"
 	"fmt"
+	"math"
 	"reflect"
 	"time"
 
@@ -120,9 +121,6 @@ func parseEnqueueOptions(name string, kwargs map[string]value.Value, validate bo
 			if err != nil {
 				return JobQueueEnqueueOptions{}, err
 			}
-			if d < 0 {
-				return JobQueueEnqueueOptions{}, fmt.Errorf("%s.enqueue delay must be non-negative", name)
-			}
 			delay = &d
 		case "key":
 			if v.Kind() != value.KindString {
@@ -151,22 +149,47 @@ func parseEnqueueOptions(name string, kwargs map[string]value.Value, validate bo
 	return opts, nil
 }
 
+// maxDelaySeconds is the longest delay time.Duration can represent in whole
+// seconds; anything larger would wrap when scaled to nanoseconds.
+const maxDelaySeconds = int64(math.MaxInt64 / int64(time.Second))
+
+// valueToTimeDuration normalizes a script delay (a duration or numeric
+// seconds) into a non-negative time.Duration. Float seconds keep their
+// sub-second precision, rounded to the nearest nanosecond, and delays the
+// host cannot represent are rejected rather than wrapped.
 func valueToTimeDuration(name string, val value.Value) (time.Duration, error) {
 	switch val.Kind() {
 	case value.KindDuration:
-		secs := val.Duration().Seconds()
-		return time.Duration(secs) * time.Second, nil
-	case value.KindInt, value.KindFloat:
-		secs, err := value.ValueToInt64(val)
-		if err != nil {
-			return 0, err
+		return delayFromSeconds(name, val.Duration().Seconds())
+	case value.KindInt:
+		return delayFromSeconds(name, val.Int())
+	case value.KindFloat:
+		f := val.Float()
+		if math.IsNaN(f) || math.IsInf(f, 0) {
+			return 0, fmt.Errorf("%s.enqueue delay must be finite", name)
+		}
+		if f < 0 {
+			return 0, fmt.Errorf("%s.enqueue delay must be non-negative", name)
+		}
+		if f > float64(maxDelaySeconds) {
+			return 0, fmt.Errorf("%s.enqueue delay is out of range", name)
 		}
-		return time.Duration(secs) * time.Second, nil
+		return time.Duration(math.Round(f * float64(time.Second))), nil
 	default:
 		return 0, fmt.Errorf("%s.enqueue delay must be duration or numeric seconds", name)
 	}
 }
 
+func delayFromSeconds(name string, secs int64) (time.Duration, error) {
+	if secs < 0 {
+		return 0, fmt.Errorf("%s.enqueue delay must be non-negative", name)
+	}
+	if secs > maxDelaySeconds {
+		return 0, fmt.Errorf("%s.enqueue delay is out of range", name)
+	}
+	return time.Duration(secs) * time.Second, nil
+}
+
 // isNilImpl reports whether impl is an untyped or typed nil. It is
 // duplicated here rather than imported from vibes to keep this package
 // free of an import cycle.
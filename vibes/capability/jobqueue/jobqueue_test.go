@@ -2,6 +2,7 @@ package jobqueue
 
 import (
 	"context"
+	"math"
 	"strings"
 	"testing"
 	"time"
@@ -136,6 +137,16 @@ func TestParseEnqueueOptionsParsesDelayKeyAndExtra(t *testing.T) {
 			kwargs:    map[string]value.Value{"delay": value.NewFloat(45.0)},
 			wantDelay: 45 * time.Second,
 		},
+		{
+			name:      "fractional_seconds_delay",
+			kwargs:    map[string]value.Value{"delay": value.NewFloat(1.5)},
+			wantDelay: 1500 * time.Millisecond,
+		},
+		{
+			name:      "largest_representable_delay",
+			kwargs:    map[string]value.Value{"delay": value.NewInt(maxDelaySeconds)},
+			wantDelay: time.Duration(maxDelaySeconds) * time.Second,
+		},
 		{
 			name:    "key",
 			kwargs:  map[string]value.Value{"key": value.NewString("dedupe-1")},
@@ -252,6 +263,41 @@ func TestParseEnqueueOptionsRejectsInvalidValues(t *testing.T) {
 			kwargs:  map[string]value.Value{"delay": value.NewString("soon")},
 			wantErr: "jobs.enqueue delay must be duration or numeric seconds",
 		},
+		{
+			name:    "negative_fractional_delay",
+			kwargs:  map[string]value.Value{"delay": value.NewFloat(-0.5)},
+			wantErr: "jobs.enqueue delay must be non-negative",
+		},
+		{
+			name:    "negative_duration_delay",
+			kwargs:  map[string]value.Value{"delay": value.NewDuration(value.DurationFromSeconds(-30))},
+			wantErr: "jobs.enqueue delay must be non-negative",
+		},
+		{
+			name:    "nan_delay",
+			kwargs:  map[string]value.Value{"delay": value.NewFloat(math.NaN())},
+			wantErr: "jobs.enqueue delay must be finite",
+		},
+		{
+			name:    "infinite_delay",
+			kwargs:  map[string]value.Value{"delay": value.NewFloat(math.Inf(1))},
+			wantErr: "jobs.enqueue delay must be finite",
+		},
+		{
+			name:    "overflowing_int_delay",
+			kwargs:  map[string]value.Value{"delay": value.NewInt(maxDelaySeconds + 1)},
+			wantErr: "jobs.enqueue delay is out of range",
+		},
+		{
+			name:    "overflowing_duration_delay",
+			kwargs:  map[string]value.Value{"delay": value.NewDuration(value.DurationFromSeconds(math.MaxInt64))},
+			wantErr: "jobs.enqueue delay is out of range",
+		},
+		{
+			name:    "overflowing_float_delay",
+			kwargs:  map[string]value.Value{"delay": value.NewFloat(1e12)},
+			wantErr: "jobs.enqueue delay is out of range",
+		},
 		{
 			name:    "non_string_key",
 			kwargs:  map[string]value.Value{"key": value.NewInt(7)},
@@ -0,0 +1,21 @@
+package vibes
+
+import "github.com/mgomes/vibescript/internal/runtime"
+
+// NewModulesCapability constructs a CapabilityAdapter bound to the provided
+// script-facing name that lets scripts list the approved modules and load
+// one by name at runtime. Loading uses require's resolution and module
+// policy.
+func NewModulesCapability(name string, modules []string) (CapabilityAdapter, error) {
+	return runtime.NewModulesCapability(name, modules)
+}
+
+// MustNewModulesCapability constructs a modules CapabilityAdapter or panics
+// when name or a module name is empty.
+func MustNewModulesCapability(name string, modules []string) CapabilityAdapter {
+	cap, err := NewModulesCapability(name, modules)
+	if err != nil {
+		panic(err)
+	}
+	return cap
+}
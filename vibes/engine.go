@@ -5,6 +5,15 @@ import "github.com/mgomes/vibescript/internal/runtime"
 // Config controls interpreter execution bounds and enforcement modes.
 type Config = runtime.Config
 
+// IntOverflowPolicy selects how integer arithmetic handles int64 overflow.
+type IntOverflowPolicy = runtime.IntOverflowPolicy
+
+const (
+	IntOverflowError   = runtime.IntOverflowError
+	IntOverflowWrap    = runtime.IntOverflowWrap
+	IntOverflowPromote = runtime.IntOverflowPromote
+)
+
 // Engine executes Vibescript programs with deterministic limits.
 type Engine = runtime.Engine
 
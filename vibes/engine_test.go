@@ -31,6 +31,11 @@ func TestNewEngineRejectsInvalidConfig(t *testing.T) {
 			cfg:     vibes.Config{MaxSourceBytes: -1},
 			wantErr: "vibes: max source bytes cannot be negative",
 		},
+		{
+			name:    "negative_max_collection_size",
+			cfg:     vibes.Config{MaxCollectionSize: -1},
+			wantErr: "vibes: max collection size cannot be negative",
+		},
 		{
 			name:    "default_task_concurrency_exceeds_max",
 			cfg:     vibes.Config{DefaultTaskConcurrency: 8, MaxTaskConcurrency: 2},
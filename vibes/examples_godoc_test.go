@@ -65,6 +65,27 @@ end`)
 	// Output: answer
 }
 
+// ExampleParseIssues reports each syntax error with its position instead
+// of scraping the combined error text.
+func ExampleParseIssues() {
+	engine := vibes.MustNewEngine(vibes.Config{})
+	_, err := engine.Compile(`def total(items)
+  sum = )
+end
+
+def average(items)
+  mean = ]
+end`)
+	for _, issue := range vibes.ParseIssues(err) {
+		fmt.Printf("%d:%d %s\n", issue.Pos.Line, issue.Pos.Column, issue.Message)
+	}
+	fmt.Println(strings.HasPrefix(err.Error(), "parse error at 2:9"))
+	// Output:
+	// 2:9 unexpected token ")"
+	// 6:10 unexpected token "]"
+	// true
+}
+
 // ExampleEngine_Execute parses a source string under the active limits
 // without retaining the compiled script; useful as a syntax check.
 func ExampleEngine_Execute() {
@@ -5,5 +5,12 @@ import "github.com/mgomes/vibescript/internal/runtime"
 // Execution holds the runtime state for a single script evaluation.
 // It is the per-call handle passed to builtin functions and capability
 // adapters. Embedders should not rely on its internal shape; treat it
-// as opaque and use the exported methods (Context, Step, CallBlock).
+// as opaque and use the exported methods (Context, Step, CallBlock, Capabilities,
+// CapabilityMethods).
 type Execution = runtime.Execution
+
+// Coverage records the source lines a call ran; see CallOptions.Coverage.
+type Coverage = runtime.Coverage
+
+// Profile counts the steps each function ran; see CallOptions.Profile.
+type Profile = runtime.Profile
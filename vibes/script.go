@@ -1,10 +1,17 @@
 package vibes
 
-import "github.com/mgomes/vibescript/internal/runtime"
+import (
+	"github.com/mgomes/vibescript/internal/runtime"
+	"github.com/mgomes/vibescript/vibes/value"
+)
 
 // Script represents a parsed Vibescript module ready for execution.
 type Script = runtime.Script
 
+// Session reuses a script's prepared root environment across calls.
+// Create one with Script.NewSession.
+type Session = runtime.Session
+
 // ParamKind identifies how a function parameter receives values.
 type ParamKind = runtime.ParamKind
 
@@ -16,5 +23,24 @@ const (
 	ParamBlock       = runtime.ParamBlock
 )
 
+// TestResult is the outcome of one test function; see Script.RunTests.
+type TestResult = runtime.TestResult
+
+// TestFunctionPrefix marks the functions Script.RunTests treats as tests.
+const TestFunctionPrefix = runtime.TestFunctionPrefix
+
 // CallOptions configures globals, capabilities, and other settings for a script invocation.
 type CallOptions = runtime.CallOptions
+
+// Cache is a host-provided store that lets memoize results persist across
+// calls; see CallOptions.Cache.
+type Cache = runtime.Cache
+
+// MemoizeCacheKeyPrefix begins every key memoize passes to a Cache.
+const MemoizeCacheKeyPrefix = runtime.MemoizeCacheKeyPrefix
+
+// NewGlobalsFromGo converts plain Go data into CallOptions.Globals with
+// value.FromGo, naming the offending global when a value cannot convert.
+func NewGlobalsFromGo(globals map[string]any) (map[string]value.Value, error) {
+	return runtime.NewGlobalsFromGo(globals)
+}
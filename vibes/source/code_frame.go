@@ -30,9 +30,22 @@ func FormatCodeFrame(source string, pos Position) string {
 	return NewCodeFrameFormatter(source).Format(pos)
 }
 
+// FormatCodeFrameSpan is FormatCodeFrame with an underline running from pos
+// to the exclusive end position.
+func FormatCodeFrameSpan(source string, pos, end Position) string {
+	return NewCodeFrameFormatter(source).FormatSpan(pos, end)
+}
+
 // Format returns a human-readable source snippet highlighting the column at
 // the given position.
 func (f *CodeFrameFormatter) Format(pos Position) string {
+	return f.FormatSpan(pos, Position{})
+}
+
+// FormatSpan returns a source snippet underlining pos up to the exclusive
+// end position. An end that is unknown, not after pos, or on a later line
+// falls back to the single caret Format draws.
+func (f *CodeFrameFormatter) FormatSpan(pos, end Position) string {
 	if f == nil || len(f.lines) == 0 || pos.Line <= 0 {
 		return ""
 	}
@@ -50,15 +63,26 @@ func (f *CodeFrameFormatter) Format(pos Position) string {
 	lineLabel := strconv.Itoa(pos.Line)
 	gutterPad := strings.Repeat(" ", len(lineLabel))
 	caretPad := strings.Repeat(" ", displayColumn-1)
+	underline := "^"
+	if end.Line == pos.Line && end.Column > column+1 {
+		width := end.Column - column
+		if remaining := utf8.RuneCountInString(displayText) - displayColumn + 1; width > remaining {
+			width = remaining
+		}
+		if width > 1 {
+			underline = strings.Repeat("^", width)
+		}
+	}
 
 	return fmt.Sprintf(
-		"  --> line %d, column %d\n %s | %s\n %s | %s^",
+		"  --> line %d, column %d\n %s | %s\n %s | %s%s",
 		pos.Line,
 		column,
 		lineLabel,
 		displayText,
 		gutterPad,
 		caretPad,
+		underline,
 	)
 }
 
@@ -63,3 +63,29 @@ func allocBytes(t *testing.T, fn func()) uint64 {
 	runtime.ReadMemStats(&after)
 	return after.TotalAlloc - before.TotalAlloc
 }
+
+func TestCodeFrameFormatterUnderlinesSpans(t *testing.T) {
+	t.Parallel()
+	formatter := NewCodeFrameFormatter("total = items.fetch(10) + 1")
+
+	tests := []struct {
+		name string
+		end  Position
+		want string
+	}{
+		{name: "same_line_span", end: Position{Line: 1, Column: 24}, want: "   |         ^^^^^^^^^^^^^^^"},
+		{name: "unknown_end", end: Position{}, want: "   |         ^"},
+		{name: "end_on_later_line", end: Position{Line: 2, Column: 4}, want: "   |         ^"},
+		{name: "end_past_line", end: Position{Line: 1, Column: 99}, want: "   |         ^^^^^^^^^^^^^^^^^^^"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			got := formatter.FormatSpan(Position{Line: 1, Column: 9}, tc.end)
+			lines := strings.Split(got, "\n")
+			if last := lines[len(lines)-1]; last != tc.want {
+				t.Fatalf("FormatSpan() underline = %q, want %q", last, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,48 @@
+package value
+
+import (
+	"math"
+	"math/big"
+)
+
+// NewBigInt returns an arbitrary-precision integer Value. The Value keeps its
+// own copy of n, so later mutation of n by the caller does not leak into the
+// script-visible value.
+func NewBigInt(n *big.Int) Value {
+	return Value{kind: KindBigInt, data: new(big.Int).Set(n)}
+}
+
+// BigInt returns a copy of the arbitrary-precision content of v. Ints are
+// widened, so callers can treat int and bigint operands uniformly; any other
+// kind returns nil.
+func (v Value) BigInt() *big.Int {
+	switch v.kind {
+	case KindBigInt:
+		return new(big.Int).Set(v.data.(*big.Int))
+	case KindInt:
+		return big.NewInt(v.Int())
+	default:
+		return nil
+	}
+}
+
+// bigIntPayload returns the shared payload of a KindBigInt value without
+// copying. Callers must not mutate the result.
+func (v Value) bigIntPayload() *big.Int {
+	return v.data.(*big.Int)
+}
+
+// bigIntToFloat converts n to the nearest float64, saturating to an infinity
+// when the magnitude exceeds the float range.
+func bigIntToFloat(n *big.Int) float64 {
+	f, _ := new(big.Float).SetInt(n).Float64()
+	return f
+}
+
+// bigIntEqualsFloat reports whether f holds exactly the integer n.
+func bigIntEqualsFloat(n *big.Int, f float64) bool {
+	if math.IsNaN(f) || math.IsInf(f, 0) || f != math.Trunc(f) {
+		return false
+	}
+	return new(big.Float).SetInt(n).Cmp(big.NewFloat(f)) == 0
+}
@@ -0,0 +1,263 @@
+package value
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"reflect"
+	"time"
+)
+
+// ToGo converts v into plain Go data so hosts can hand script results to
+// encoders and other Go code without switching on Kind themselves:
+//
+//   - nil, bool, int, float, and string become nil, bool, int64, float64,
+//     and string; a symbol becomes its name as a string.
+//   - bigint becomes a *big.Int copy.
+//   - array becomes []any, and hash and object become map[string]any keyed
+//     by the same strings Hash() uses. A hash whose keys render to the same
+//     string, such as 1 and "1" or :a and "a", returns an error rather than
+//     dropping an entry.
+//   - duration becomes a time.Duration, time becomes a time.Time, and money
+//     and range stay as the Money and Range types from this package, since
+//     Go has no native equivalent.
+//
+// Functions, blocks, builtins, classes, instances, and enums have no data
+// form and return an error, as do cyclic arrays or hashes and durations
+// beyond the range of time.Duration.
+func (v Value) ToGo() (any, error) {
+	conv := goConversion{
+		arrays: make(map[SliceIdentity]struct{}),
+		hashes: make(map[uintptr]struct{}),
+	}
+	return conv.toGo(v)
+}
+
+// goConversion tracks the arrays and hashes on the current conversion path
+// so a cycle is reported instead of recursing forever. Entries are removed
+// on the way back out, so a value shared by two siblings converts twice
+// rather than being mistaken for a cycle.
+type goConversion struct {
+	arrays map[SliceIdentity]struct{}
+	hashes map[uintptr]struct{}
+}
+
+func (c goConversion) toGo(v Value) (any, error) {
+	switch v.kind {
+	case KindNil:
+		return nil, nil
+	case KindBool:
+		return v.Bool(), nil
+	case KindInt:
+		return v.Int(), nil
+	case KindFloat:
+		return v.Float(), nil
+	case KindString, KindSymbol:
+		return v.String(), nil
+	case KindBigInt:
+		return v.BigInt(), nil
+	case KindMoney:
+		return v.Money(), nil
+	case KindDuration:
+		seconds := v.Duration().Seconds()
+		if seconds > math.MaxInt64/int64(time.Second) || seconds < math.MinInt64/int64(time.Second) {
+			return nil, fmt.Errorf("duration %s is out of time.Duration range", v.Duration())
+		}
+		return time.Duration(seconds) * time.Second, nil
+	case KindTime:
+		return v.Time(), nil
+	case KindRange:
+		return v.Range(), nil
+	case KindArray:
+		return c.arrayToGo(v.Array())
+	case KindHash, KindObject:
+		return c.hashToGo(v)
+	default:
+		return nil, fmt.Errorf("cannot convert %s to a Go value", v.kind)
+	}
+}
+
+func (c goConversion) arrayToGo(elems []Value) (any, error) {
+	id := SliceIdentity{Ptr: reflect.ValueOf(elems).Pointer(), Len: len(elems), Cap: cap(elems)}
+	if id.Ptr != 0 {
+		if _, seen := c.arrays[id]; seen {
+			return nil, fmt.Errorf("cannot convert cyclic array to a Go value")
+		}
+		c.arrays[id] = struct{}{}
+		defer delete(c.arrays, id)
+	}
+	out := make([]any, len(elems))
+	for i, elem := range elems {
+		converted, err := c.toGo(elem)
+		if err != nil {
+			return nil, err
+		}
+		out[i] = converted
+	}
+	return out, nil
+}
+
+func (c goConversion) hashToGo(v Value) (any, error) {
+	var id uintptr
+	if v.kind == KindHash {
+		id = HashIdentity(v)
+	} else {
+		id = reflect.ValueOf(v.data).Pointer()
+	}
+	if id != 0 {
+		if _, seen := c.hashes[id]; seen {
+			return nil, fmt.Errorf("cannot convert cyclic %s to a Go value", v.kind)
+		}
+		c.hashes[id] = struct{}{}
+		defer delete(c.hashes, id)
+	}
+	entries := v.HashEntries()
+	out := make(map[string]any, len(entries))
+	owners := make(map[string]Value, len(entries))
+	for _, entry := range entries {
+		key := HashDisplayKey(entry.Key)
+		if prev, dup := owners[key]; dup {
+			return nil, fmt.Errorf("cannot convert hash with keys %s and %s to a Go value: both map to %q", prev.Inspect(), entry.Key.Inspect(), key)
+		}
+		owners[key] = entry.Key
+		converted, err := c.toGo(entry.Value)
+		if err != nil {
+			return nil, err
+		}
+		out[key] = converted
+	}
+	return out, nil
+}
+
+// FromGo converts plain Go data into a Value, the inverse of ToGo, so hosts
+// can build globals and arguments from existing Go structures:
+//
+//   - nil, bool, every integer and float type, and string map to nil, bool,
+//     int, float, and string. Unsigned integers above math.MaxInt64 and
+//     *big.Int become bigints.
+//   - slices and arrays become arrays, and maps with string keys become
+//     hashes.
+//   - time.Duration, time.Time, Money, and Range map to their script kinds;
+//     a time.Duration must be a whole number of seconds.
+//   - a Value is returned unchanged.
+//
+// Structs, pointers other than *big.Int, channels, functions, maps with
+// non-string keys, and cyclic Go data return an error.
+func FromGo(x any) (Value, error) {
+	conv := goConversion{arrays: make(map[SliceIdentity]struct{}), hashes: make(map[uintptr]struct{})}
+	return conv.fromGo(x)
+}
+
+func (c goConversion) fromGo(x any) (Value, error) {
+	switch typed := x.(type) {
+	case nil:
+		return NewNil(), nil
+	case Value:
+		return typed, nil
+	case bool:
+		return NewBool(typed), nil
+	case string:
+		return NewString(typed), nil
+	case int:
+		return NewInt(int64(typed)), nil
+	case int64:
+		return NewInt(typed), nil
+	case float64:
+		return NewFloat(typed), nil
+	case *big.Int:
+		if typed == nil {
+			return NewNil(), nil
+		}
+		return NewBigInt(typed), nil
+	case time.Duration:
+		if typed%time.Second != 0 {
+			return NewNil(), fmt.Errorf("duration %s is not a whole number of seconds", typed)
+		}
+		return NewDuration(DurationFromSeconds(int64(typed / time.Second))), nil
+	case time.Time:
+		return NewTime(typed), nil
+	case Money:
+		return NewMoney(typed), nil
+	case Range:
+		return NewRange(typed), nil
+	case []any:
+		return c.sliceFromGo(reflect.ValueOf(typed))
+	case map[string]any:
+		return c.mapFromGo(reflect.ValueOf(typed))
+	}
+
+	rv := reflect.ValueOf(x)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return NewInt(rv.Int()), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		u := rv.Uint()
+		if u > math.MaxInt64 {
+			return NewBigInt(new(big.Int).SetUint64(u)), nil
+		}
+		return NewInt(int64(u)), nil
+	case reflect.Float32, reflect.Float64:
+		return NewFloat(rv.Float()), nil
+	case reflect.String:
+		return NewString(rv.String()), nil
+	case reflect.Bool:
+		return NewBool(rv.Bool()), nil
+	case reflect.Slice, reflect.Array:
+		return c.sliceFromGo(rv)
+	case reflect.Map:
+		if rv.Type().Key().Kind() != reflect.String {
+			return NewNil(), fmt.Errorf("cannot convert map with %s keys to a Value", rv.Type().Key())
+		}
+		return c.mapFromGo(rv)
+	default:
+		return NewNil(), fmt.Errorf("cannot convert Go %T to a Value", x)
+	}
+}
+
+func (c goConversion) sliceFromGo(rv reflect.Value) (Value, error) {
+	if rv.Kind() == reflect.Slice {
+		if rv.IsNil() {
+			return NewNil(), nil
+		}
+		id := SliceIdentity{Ptr: rv.Pointer(), Len: rv.Len(), Cap: rv.Cap()}
+		if id.Ptr != 0 {
+			if _, seen := c.arrays[id]; seen {
+				return NewNil(), fmt.Errorf("cannot convert cyclic Go slice to a Value")
+			}
+			c.arrays[id] = struct{}{}
+			defer delete(c.arrays, id)
+		}
+	}
+	elems := make([]Value, rv.Len())
+	for i := range elems {
+		elem, err := c.fromGo(rv.Index(i).Interface())
+		if err != nil {
+			return NewNil(), err
+		}
+		elems[i] = elem
+	}
+	return NewArray(elems), nil
+}
+
+func (c goConversion) mapFromGo(rv reflect.Value) (Value, error) {
+	if rv.IsNil() {
+		return NewNil(), nil
+	}
+	id := rv.Pointer()
+	if _, seen := c.hashes[id]; seen {
+		return NewNil(), fmt.Errorf("cannot convert cyclic Go map to a Value")
+	}
+	c.hashes[id] = struct{}{}
+	defer delete(c.hashes, id)
+
+	entries := make(map[string]Value, rv.Len())
+	iter := rv.MapRange()
+	for iter.Next() {
+		entry, err := c.fromGo(iter.Value().Interface())
+		if err != nil {
+			return NewNil(), err
+		}
+		entries[iter.Key().String()] = entry
+	}
+	return NewHash(entries), nil
+}
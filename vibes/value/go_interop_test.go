@@ -0,0 +1,219 @@
+package value_test
+
+import (
+	"math"
+	"math/big"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mgomes/vibescript/vibes/value"
+)
+
+func TestToGoRoundTripsNestedStructures(t *testing.T) {
+	t.Parallel()
+	price, err := value.NewMoneyFromCents(1999, "USD")
+	if err != nil {
+		t.Fatalf("NewMoneyFromCents: %v", err)
+	}
+	at := time.Date(2026, 3, 14, 9, 26, 53, 0, time.UTC)
+	big2e70, _ := new(big.Int).SetString("1180591620717411303424", 10)
+
+	goData := map[string]any{
+		"name":    "widget",
+		"count":   int64(3),
+		"ratio":   0.25,
+		"active":  true,
+		"missing": nil,
+		"tags":    []any{"a", "b", []any{int64(1), 2.5}},
+		"meta": map[string]any{
+			"price":   price,
+			"ttl":     90 * time.Second,
+			"created": at,
+			"span":    value.Range{Start: 1, End: 5},
+			"huge":    big2e70,
+		},
+	}
+
+	v, err := value.FromGo(goData)
+	if err != nil {
+		t.Fatalf("FromGo: %v", err)
+	}
+	if v.Kind() != value.KindHash {
+		t.Fatalf("FromGo kind = %v, want hash", v.Kind())
+	}
+	if got := v.Hash()["tags"].Array()[2].Array()[1]; got.Kind() != value.KindFloat || got.Float() != 2.5 {
+		t.Fatalf("nested float = %v (%v)", got, got.Kind())
+	}
+	if got := v.Hash()["meta"].Hash()["ttl"]; got.Kind() != value.KindDuration || got.Duration().Seconds() != 90 {
+		t.Fatalf("duration = %v (%v)", got, got.Kind())
+	}
+
+	back, err := v.ToGo()
+	if err != nil {
+		t.Fatalf("ToGo: %v", err)
+	}
+	if !reflect.DeepEqual(back, goData) {
+		t.Fatalf("round trip = %#v, want %#v", back, goData)
+	}
+}
+
+func TestToGoScalarAndSymbolConversions(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name string
+		in   value.Value
+		want any
+	}{
+		{name: "nil", in: value.NewNil(), want: nil},
+		{name: "int", in: value.NewInt(-7), want: int64(-7)},
+		{name: "float", in: value.NewFloat(1.5), want: 1.5},
+		{name: "symbol", in: value.NewSymbol("ready"), want: "ready"},
+		{name: "object", in: value.NewObject(map[string]value.Value{"id": value.NewInt(1)}), want: map[string]any{"id": int64(1)}},
+		{name: "empty_array", in: value.NewArray(nil), want: []any{}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := tc.in.ToGo()
+			if err != nil {
+				t.Fatalf("ToGo: %v", err)
+			}
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Fatalf("ToGo = %#v, want %#v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestFromGoWidensGoTypes(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name string
+		in   any
+		want value.Value
+	}{
+		{name: "int32", in: int32(-4), want: value.NewInt(-4)},
+		{name: "uint8", in: uint8(200), want: value.NewInt(200)},
+		{name: "float32", in: float32(0.5), want: value.NewFloat(0.5)},
+		{name: "huge_uint64", in: uint64(math.MaxUint64), want: value.NewBigInt(new(big.Int).SetUint64(math.MaxUint64))},
+		{name: "typed_slice", in: []string{"x", "y"}, want: value.NewArray([]value.Value{value.NewString("x"), value.NewString("y")})},
+		{name: "typed_map", in: map[string]int{"n": 2}, want: value.NewHash(map[string]value.Value{"n": value.NewInt(2)})},
+		{name: "value_passthrough", in: value.NewSymbol("s"), want: value.NewSymbol("s")},
+		{name: "nil_slice", in: []any(nil), want: value.NewNil()},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := value.FromGo(tc.in)
+			if err != nil {
+				t.Fatalf("FromGo: %v", err)
+			}
+			if got.Kind() != tc.want.Kind() || !got.Equal(tc.want) {
+				t.Fatalf("FromGo(%#v) = %v (%v), want %v (%v)", tc.in, got, got.Kind(), tc.want, tc.want.Kind())
+			}
+		})
+	}
+}
+
+func TestGoConversionErrors(t *testing.T) {
+	t.Parallel()
+
+	cyclicArray := value.NewArray(make([]value.Value, 1))
+	cyclicArray.Array()[0] = cyclicArray
+	cyclicHash := value.NewHash(map[string]value.Value{})
+	cyclicHash.Hash()["self"] = cyclicHash
+
+	toGo := []struct {
+		name string
+		in   value.Value
+		want string
+	}{
+		{name: "cyclic_array", in: cyclicArray, want: "cannot convert cyclic array"},
+		{name: "cyclic_hash", in: cyclicHash, want: "cannot convert cyclic hash"},
+		{name: "long_duration", in: value.NewDuration(value.DurationFromSeconds(math.MaxInt64)), want: "out of time.Duration range"},
+	}
+	for _, tc := range toGo {
+		if _, err := tc.in.ToGo(); err == nil || !strings.Contains(err.Error(), tc.want) {
+			t.Fatalf("%s: ToGo err = %v, want %q", tc.name, err, tc.want)
+		}
+	}
+
+	cyclicSlice := make([]any, 1)
+	cyclicSlice[0] = cyclicSlice
+	cyclicMap := map[string]any{}
+	cyclicMap["self"] = cyclicMap
+
+	fromGo := []struct {
+		name string
+		in   any
+		want string
+	}{
+		{name: "cyclic_slice", in: cyclicSlice, want: "cannot convert cyclic Go slice"},
+		{name: "cyclic_map", in: cyclicMap, want: "cannot convert cyclic Go map"},
+		{name: "struct", in: struct{ A int }{A: 1}, want: "cannot convert Go struct"},
+		{name: "int_keys", in: map[int]string{1: "a"}, want: "cannot convert map with int keys"},
+		{name: "sub_second_duration", in: 1500 * time.Millisecond, want: "not a whole number of seconds"},
+	}
+	for _, tc := range fromGo {
+		if _, err := value.FromGo(tc.in); err == nil || !strings.Contains(err.Error(), tc.want) {
+			t.Fatalf("%s: FromGo err = %v, want %q", tc.name, err, tc.want)
+		}
+	}
+}
+
+func TestToGoRejectsCollidingHashKeys(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		keys []value.Value
+		want string
+	}{
+		{name: "int_and_string", keys: []value.Value{value.NewInt(1), value.NewString("1")}, want: `both map to "1"`},
+		{name: "symbol_and_string", keys: []value.Value{value.NewSymbol("a"), value.NewString("a")}, want: `both map to "a"`},
+	}
+	for _, tc := range tests {
+		h := value.NewHash(nil)
+		for _, key := range tc.keys {
+			if err := h.HashSet(key, value.NewString(key.Kind().String())); err != nil {
+				t.Fatalf("%s: HashSet: %v", tc.name, err)
+			}
+		}
+		if h.HashLen() != len(tc.keys) {
+			t.Fatalf("%s: hash has %d entries, want %d", tc.name, h.HashLen(), len(tc.keys))
+		}
+		if _, err := h.ToGo(); err == nil || !strings.Contains(err.Error(), tc.want) {
+			t.Fatalf("%s: ToGo err = %v, want %q", tc.name, err, tc.want)
+		}
+	}
+
+	distinct := value.NewHash(nil)
+	for _, key := range []value.Value{value.NewInt(1), value.NewSymbol("a")} {
+		if err := distinct.HashSet(key, value.NewBool(true)); err != nil {
+			t.Fatalf("HashSet: %v", err)
+		}
+	}
+	got, err := distinct.ToGo()
+	if err != nil {
+		t.Fatalf("ToGo: %v", err)
+	}
+	if m := got.(map[string]any); len(m) != 2 || m["1"] != true || m["a"] != true {
+		t.Fatalf("ToGo = %#v, want keys 1 and a", got)
+	}
+}
+
+func TestToGoConvertsSharedSiblingsTwice(t *testing.T) {
+	t.Parallel()
+	shared := value.NewArray([]value.Value{value.NewInt(1)})
+	v := value.NewArray([]value.Value{shared, shared})
+	got, err := v.ToGo()
+	if err != nil {
+		t.Fatalf("ToGo: %v", err)
+	}
+	want := []any{[]any{int64(1)}, []any{int64(1)}}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("ToGo = %#v, want %#v", got, want)
+	}
+}
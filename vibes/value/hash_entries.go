@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"math"
 	"reflect"
+	"sort"
 	"strconv"
 	"strings"
 )
@@ -51,6 +52,8 @@ func NewHashLookupKey(key Value) (HashLookupKey, error) {
 			f = 0
 		}
 		return HashLookupKey{kind: KindFloat, bits: math.Float64bits(f)}, nil
+	case KindBigInt:
+		return HashLookupKey{kind: KindBigInt, text: key.String()}, nil
 	case KindString:
 		return HashLookupKey{kind: KindString, text: key.String()}, nil
 	case KindSymbol:
@@ -110,6 +113,8 @@ func hashKey(key Value, arrays map[SliceIdentity]struct{}) (string, error) {
 			f = 0
 		}
 		return "float:" + strconv.FormatUint(math.Float64bits(f), 16), nil
+	case KindBigInt:
+		return "bigint:" + key.String(), nil
 	case KindString:
 		return "string:" + encodeHashKeyString(key.String()), nil
 	case KindSymbol:
@@ -165,6 +170,120 @@ func HashDisplayKey(key Value) string {
 	}
 }
 
+// hashEntryOrder is the precomputed sort key that orders hash entries for
+// deterministic iteration and rendering; every walk over a hash (keys, values,
+// each, to_a, inspect) orders entries by it. Keys group by kind rank (nil,
+// bools, ints, floats, strings, symbols, arrays, ranges, then everything
+// else), so a string key and a symbol key with the same text never
+// interleave. Within a kind, ints and floats sort numerically through bits and
+// other keys sort by text.
+type hashEntryOrder struct {
+	rank uint8
+	bits uint64
+	text string
+}
+
+func hashEntryOrderOf(key Value) hashEntryOrder {
+	switch key.kind {
+	case KindNil:
+		return hashEntryOrder{rank: 0}
+	case KindBool:
+		if key.Bool() {
+			return hashEntryOrder{rank: 1, bits: 1}
+		}
+		return hashEntryOrder{rank: 1}
+	case KindInt:
+		// Flipping the sign bit maps int64 onto uint64 in the same order.
+		return hashEntryOrder{rank: 2, bits: uint64(key.Int()) ^ (1 << 63)}
+	case KindFloat:
+		return hashEntryOrder{rank: 3, bits: sortableFloatBits(key.Float())}
+	case KindString:
+		return hashEntryOrder{rank: 4, text: key.String()}
+	case KindSymbol:
+		return hashEntryOrder{rank: 5, text: key.String()}
+	case KindArray:
+		return hashEntryOrder{rank: 6, text: key.Inspect()}
+	case KindRange:
+		return hashEntryOrder{rank: 7, text: key.Inspect()}
+	default:
+		return hashEntryOrder{rank: 8, text: key.Inspect()}
+	}
+}
+
+func (o hashEntryOrder) less(other hashEntryOrder) bool {
+	if o.rank != other.rank {
+		return o.rank < other.rank
+	}
+	if o.bits != other.bits {
+		return o.bits < other.bits
+	}
+	return o.text < other.text
+}
+
+// sortableFloatBits maps f onto a uint64 whose order matches numeric order:
+// positive floats set the sign bit and negative floats invert every bit, the
+// usual IEEE 754 total-order trick. Negative zero maps like zero because the
+// two are the same hash key.
+func sortableFloatBits(f float64) uint64 {
+	if f == 0 {
+		f = 0
+	}
+	bits := math.Float64bits(f)
+	if bits&(1<<63) == 0 {
+		return bits ^ 1<<63
+	}
+	return ^bits
+}
+
+// hashEntriesByOrder sorts entries alongside their precomputed sort keys.
+type hashEntriesByOrder struct {
+	entries []HashEntry
+	keys    []hashEntryOrder
+}
+
+func (s hashEntriesByOrder) Len() int           { return len(s.entries) }
+func (s hashEntriesByOrder) Less(i, j int) bool { return s.keys[i].less(s.keys[j]) }
+func (s hashEntriesByOrder) Swap(i, j int) {
+	s.entries[i], s.entries[j] = s.entries[j], s.entries[i]
+	s.keys[i], s.keys[j] = s.keys[j], s.keys[i]
+}
+
+// SortHashEntries orders entries in place in hash iteration order, the order
+// keys, each, and inspect walk a hash in. It is exported for the interpreter,
+// which sorts the entry buffers it fills with HashEntriesInto. Each entry's
+// sort key is computed once before sorting.
+func SortHashEntries(entries []HashEntry) {
+	keys := make([]hashEntryOrder, len(entries))
+	for i, entry := range entries {
+		keys[i] = hashEntryOrderOf(entry.Key)
+	}
+	sort.Stable(hashEntriesByOrder{entries: entries, keys: keys})
+}
+
+// sortedHashKeys returns the keys of a string-keyed hash map in ascending
+// order, so renderings do not depend on Go's randomized map iteration. Every
+// key of such a map surfaces as a symbol, so this is the hashEntryOrder
+// order without the per-key encoding.
+func sortedHashKeys(entries map[string]Value) []string {
+	keys := make([]string, 0, len(entries))
+	for key := range entries {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// sortedHashEntries returns the entries of a typed hash ordered by
+// hashEntryOrder.
+func sortedHashEntries(entries map[HashLookupKey]HashEntry) []HashEntry {
+	sorted := make([]HashEntry, 0, len(entries))
+	for _, entry := range entries {
+		sorted = append(sorted, entry)
+	}
+	SortHashEntries(sorted)
+	return sorted
+}
+
 // HashLen returns the number of entries in a hash or object.
 func (v Value) HashLen() int {
 	switch v.kind {
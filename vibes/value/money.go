@@ -8,6 +8,7 @@ import (
 	"errors"
 	"fmt"
 	"math"
+	"math/big"
 	"math/bits"
 	"strconv"
 	"strings"
@@ -151,6 +152,77 @@ func (m Money) DivInt(divisor int64) (Money, error) {
 	return Money{cents: m.cents / divisor, currency: m.currency}, nil
 }
 
+// Rounding selects how Mul and Div settle a fractional cent.
+type Rounding int
+
+const (
+	// RoundTruncate drops the fractional cent toward zero, like DivInt.
+	RoundTruncate Rounding = iota
+	// RoundHalfEven rounds a tie to the even cent (banker's rounding).
+	RoundHalfEven
+	// RoundHalfUp rounds a tie away from zero.
+	RoundHalfUp
+	// RoundFloor rounds toward negative infinity.
+	RoundFloor
+	// RoundCeil rounds toward positive infinity.
+	RoundCeil
+)
+
+// Mul multiplies m by an exact rational factor, rounding the product to a
+// whole cent under mode, or returns an error if the result would overflow the
+// int64 cents range.
+func (m Money) Mul(factor *big.Rat, mode Rounding) (Money, error) {
+	amount := new(big.Rat).SetInt64(m.cents)
+	return m.rounded(amount.Mul(amount, factor), mode)
+}
+
+// Div divides m by an exact rational divisor, rounding the quotient to a whole
+// cent under mode. Unlike DivInt it can round to nearest or toward either
+// infinity; with RoundTruncate and an integer divisor the two agree.
+func (m Money) Div(divisor *big.Rat, mode Rounding) (Money, error) {
+	if divisor.Sign() == 0 {
+		return Money{}, errors.New("division by zero")
+	}
+	amount := new(big.Rat).SetInt64(m.cents)
+	return m.rounded(amount.Quo(amount, divisor), mode)
+}
+
+// rounded rounds amount, in cents, to an integer under mode and returns it in
+// m's currency.
+func (m Money) rounded(amount *big.Rat, mode Rounding) (Money, error) {
+	q, rem := new(big.Int).QuoRem(amount.Num(), amount.Denom(), new(big.Int))
+	if rem.Sign() != 0 {
+		sign := int64(amount.Sign())
+		twice := new(big.Int).Abs(rem)
+		twice.Lsh(twice, 1)
+		half := twice.Cmp(amount.Denom())
+		step := int64(0)
+		switch mode {
+		case RoundFloor:
+			if sign < 0 {
+				step = -1
+			}
+		case RoundCeil:
+			if sign > 0 {
+				step = 1
+			}
+		case RoundHalfUp:
+			if half >= 0 {
+				step = sign
+			}
+		case RoundHalfEven:
+			if half > 0 || (half == 0 && q.Bit(0) == 1) {
+				step = sign
+			}
+		}
+		q.Add(q, big.NewInt(step))
+	}
+	if !q.IsInt64() {
+		return Money{}, errMoneyOverflow
+	}
+	return Money{cents: q.Int64(), currency: m.currency}, nil
+}
+
 // ParseMoneyLiteral parses a textual money literal of the form "X.XX CUR".
 func ParseMoneyLiteral(input string) (Money, error) {
 	parts := strings.Fields(input)
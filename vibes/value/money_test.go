@@ -2,6 +2,7 @@ package value_test
 
 import (
 	"math"
+	"math/big"
 	"testing"
 
 	"github.com/mgomes/vibescript/vibes/value"
@@ -256,6 +257,62 @@ func TestMoneyDivInt(t *testing.T) {
 	}
 }
 
+func TestMoneyMulDivRounding(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		cents   int64
+		divide  bool
+		num     int64
+		den     int64
+		mode    value.Rounding
+		want    int64
+		wantErr string
+	}{
+		{name: "div_truncate", cents: 25, divide: true, num: 10, den: 1, mode: value.RoundTruncate, want: 2},
+		{name: "div_half_even_down", cents: 25, divide: true, num: 10, den: 1, mode: value.RoundHalfEven, want: 2},
+		{name: "div_half_even_up", cents: 35, divide: true, num: 10, den: 1, mode: value.RoundHalfEven, want: 4},
+		{name: "div_half_up", cents: 25, divide: true, num: 10, den: 1, mode: value.RoundHalfUp, want: 3},
+		{name: "div_negative_half_up", cents: -25, divide: true, num: 10, den: 1, mode: value.RoundHalfUp, want: -3},
+		{name: "div_floor", cents: -1000, divide: true, num: 3, den: 1, mode: value.RoundFloor, want: -334},
+		{name: "div_ceil", cents: 1000, divide: true, num: 3, den: 1, mode: value.RoundCeil, want: 334},
+		{name: "div_by_fraction", cents: 1000, divide: true, num: 1, den: 2, mode: value.RoundTruncate, want: 2000},
+		{name: "div_by_zero", cents: 100, divide: true, num: 0, den: 1, wantErr: "division by zero"},
+		{name: "mul_half_even_tie", cents: 1000, num: 825, den: 10000, mode: value.RoundHalfEven, want: 82},
+		{name: "mul_half_up_tie", cents: 1000, num: 825, den: 10000, mode: value.RoundHalfUp, want: 83},
+		{name: "mul_overflow", cents: math.MaxInt64, num: 2, den: 1, wantErr: "money arithmetic overflow"},
+		{name: "div_min_by_negative_one", cents: math.MinInt64, divide: true, num: -1, den: 1, wantErr: "money arithmetic overflow"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			m := mustMoney(t, tc.cents, "USD")
+			operand := big.NewRat(tc.num, tc.den)
+			var got value.Money
+			var err error
+			if tc.divide {
+				got, err = m.Div(operand, tc.mode)
+			} else {
+				got, err = m.Mul(operand, tc.mode)
+			}
+			if tc.wantErr != "" {
+				if err == nil || err.Error() != tc.wantErr {
+					t.Fatalf("error = %v, want %q", err, tc.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got.Cents() != tc.want || got.Currency() != "USD" {
+				t.Fatalf("got %d %s, want %d USD", got.Cents(), got.Currency(), tc.want)
+			}
+		})
+	}
+}
+
 func TestParseMoneyLiteral(t *testing.T) {
 	t.Parallel()
 
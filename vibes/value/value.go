@@ -25,6 +25,8 @@ const (
 	KindEnumValue
 	KindClass
 	KindInstance
+	// KindBigInt is an arbitrary-precision integer backed by math/big.
+	KindBigInt
 )
 
 // Value is a tagged union holding any Vibescript runtime value.
@@ -55,6 +55,8 @@ func (v Value) Float() float64 {
 		return 0
 	case KindInt:
 		return float64(v.Int())
+	case KindBigInt:
+		return bigIntToFloat(v.bigIntPayload())
 	default:
 		return 0
 	}
@@ -148,7 +148,7 @@ func (v Value) appendInspectHash(buf *strings.Builder, state *valueStringState,
 	if v.kind == KindHash {
 		if typed := v.data.(*hashData).typedEntries; typed != nil {
 			first := true
-			for _, entry := range typed {
+			for _, entry := range sortedHashEntries(typed) {
 				if !first {
 					if err := appendBounded(buf, elementSeparator, limit); err != nil {
 						return err
@@ -169,7 +169,8 @@ func (v Value) appendInspectHash(buf *strings.Builder, state *valueStringState,
 		}
 	}
 	first := true
-	for k, val := range entries {
+	for _, k := range sortedHashKeys(entries) {
+		val := entries[k]
 		if !first {
 			if err := appendBounded(buf, elementSeparator, limit); err != nil {
 				return err
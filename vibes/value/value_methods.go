@@ -54,6 +54,8 @@ func (k ValueKind) String() string {
 		return "class"
 	case KindInstance:
 		return "instance"
+	case KindBigInt:
+		return "bigint"
 	default:
 		return fmt.Sprintf("kind(%d)", int(k))
 	}
@@ -98,6 +100,8 @@ func (v Value) String() string {
 		return strconv.FormatInt(v.Int(), 10)
 	case KindFloat:
 		return FormatFloat(v.Float())
+	case KindBigInt:
+		return v.bigIntPayload().String()
 	case KindSymbol:
 		return v.data.(string)
 	case KindMoney:
@@ -280,8 +284,11 @@ func (v Value) appendString(buf *strings.Builder, state *valueStringState, limit
 		if err := appendByteBounded(buf, '{', limit); err != nil {
 			return err
 		}
+		// Entries render in sorted key order so interpolation, output, and
+		// error messages are reproducible across runs.
 		first := true
-		for k, val := range entries {
+		for _, k := range sortedHashKeys(entries) {
+			val := entries[k]
 			if !first {
 				// The entry separator counts against the budget like any other
 				// byte, so a packed hash trips the limit on the separator rather
@@ -335,7 +342,7 @@ func (v Value) appendStringTypedHash(buf *strings.Builder, state *valueStringSta
 		return err
 	}
 	first := true
-	for _, entry := range entries {
+	for _, entry := range sortedHashEntries(entries) {
 		if !first {
 			if err := appendBounded(buf, elementSeparator, limit); err != nil {
 				return err
@@ -1016,6 +1023,8 @@ func (v Value) Truthy() bool {
 		return v.Int() != 0
 	case KindFloat:
 		return v.Float() != 0
+	case KindBigInt:
+		return v.bigIntPayload().Sign() != 0
 	case KindString:
 		return v.data.(string) != ""
 	case KindArray:
@@ -1032,15 +1041,11 @@ func (v Value) Truthy() bool {
 // Eql reports whether v and other are equal under hash-key semantics: they
 // must share the same kind and compare equal, so an Int never eql-matches a
 // Float even when their numeric values coincide. It backs the Ruby-style
-// `eql?` predicate. Because Equal already requires matching kinds (Vibescript
-// `==` performs no cross-kind numeric coercion), Eql currently coincides with
-// Equal; it exists as a distinct, documented contract aligned with hash-key
-// equality rather than with broad value equivalence.
+// `eql?` predicate. Unlike Equal, the kind check also applies to nested array,
+// hash, and object members, so `[1].eql?([1.0])` is false while
+// `[1] == [1.0]` is true.
 func (v Value) Eql(other Value) bool {
-	if v.kind != other.kind {
-		return false
-	}
-	return v.Equal(other)
+	return valuesEqual(v, other, valueEquality{seen: make(map[valueEqualityPair]struct{}), strictKinds: true})
 }
 
 // Identical reports whether v and other refer to the same object, backing the
@@ -1132,9 +1137,20 @@ func (v Value) Identical(other Value) bool {
 	}
 }
 
-// Equal reports whether v and other hold the same kind and value.
+// Equal reports whether v and other hold the same value. Values of different
+// kinds are never equal, with one exception: ints and floats compare
+// numerically, so `1 == 1.0` holds just as `1 <=> 1.0` is 0. The kinds
+// themselves stay distinct; use Eql for kind-sensitive equality.
 func (v Value) Equal(other Value) bool {
-	return valuesEqual(v, other, make(map[valueEqualityPair]struct{}))
+	return valuesEqual(v, other, valueEquality{seen: make(map[valueEqualityPair]struct{})})
+}
+
+// valueEquality carries the state of one recursive equality check: the
+// composite pairs already on the comparison path (for cycles) and whether
+// int/float cross-kind matches are rejected, as Eql requires.
+type valueEquality struct {
+	seen        map[valueEqualityPair]struct{}
+	strictKinds bool
 }
 
 type valueEqualityPair struct {
@@ -1145,9 +1161,25 @@ type valueEqualityPair struct {
 	rightLen int
 }
 
-func valuesEqual(v, other Value, seen map[valueEqualityPair]struct{}) bool {
+func valuesEqual(v, other Value, eq valueEquality) bool {
 	if v.kind != other.kind {
-		return false
+		if eq.strictKinds {
+			return false
+		}
+		switch {
+		case v.kind == KindInt && other.kind == KindFloat:
+			return intEqualsFloat(v.Int(), other.Float())
+		case v.kind == KindFloat && other.kind == KindInt:
+			return intEqualsFloat(other.Int(), v.Float())
+		case v.kind == KindBigInt && other.kind == KindInt, v.kind == KindInt && other.kind == KindBigInt:
+			return v.BigInt().Cmp(other.BigInt()) == 0
+		case v.kind == KindBigInt && other.kind == KindFloat:
+			return bigIntEqualsFloat(v.bigIntPayload(), other.Float())
+		case v.kind == KindFloat && other.kind == KindBigInt:
+			return bigIntEqualsFloat(other.bigIntPayload(), v.Float())
+		default:
+			return false
+		}
 	}
 	switch v.kind {
 	case KindNil:
@@ -1158,6 +1190,8 @@ func valuesEqual(v, other Value, seen map[valueEqualityPair]struct{}) bool {
 		return v.Int() == other.Int()
 	case KindFloat:
 		return v.Float() == other.Float()
+	case KindBigInt:
+		return v.bigIntPayload().Cmp(other.bigIntPayload()) == 0
 	case KindString, KindSymbol:
 		return v.data.(string) == other.data.(string)
 	case KindMoney:
@@ -1195,13 +1229,13 @@ func valuesEqual(v, other Value, seen map[valueEqualityPair]struct{}) bool {
 			rightLen: len(right),
 		}
 		if pair.leftPtr != 0 || pair.rightPtr != 0 {
-			if _, ok := seen[pair]; ok {
+			if _, ok := eq.seen[pair]; ok {
 				return true
 			}
-			seen[pair] = struct{}{}
+			eq.seen[pair] = struct{}{}
 		}
 		for i := range left {
-			if !valuesEqual(left[i], right[i], seen) {
+			if !valuesEqual(left[i], right[i], eq) {
 				return false
 			}
 		}
@@ -1225,13 +1259,13 @@ func valuesEqual(v, other Value, seen map[valueEqualityPair]struct{}) bool {
 			rightLen: len(right),
 		}
 		if pair.leftPtr != 0 || pair.rightPtr != 0 {
-			if _, ok := seen[pair]; ok {
+			if _, ok := eq.seen[pair]; ok {
 				return true
 			}
-			seen[pair] = struct{}{}
+			eq.seen[pair] = struct{}{}
 		}
 		if !v.HashHasTypedEntries() || !other.HashHasTypedEntries() {
-			return hashEntriesEqualByDisplayKey(left, right, seen)
+			return hashEntriesEqualByDisplayKey(left, right, eq)
 		}
 		rightByKey, ok := hashEntriesByLookupKey(right)
 		if !ok {
@@ -1246,7 +1280,7 @@ func valuesEqual(v, other Value, seen map[valueEqualityPair]struct{}) bool {
 			if !ok {
 				return false
 			}
-			if !valuesEqual(leftEntry.Value, rightEntry.Value, seen) {
+			if !valuesEqual(leftEntry.Value, rightEntry.Value, eq) {
 				return false
 			}
 		}
@@ -1270,17 +1304,17 @@ func valuesEqual(v, other Value, seen map[valueEqualityPair]struct{}) bool {
 			rightLen: len(right),
 		}
 		if pair.leftPtr != 0 || pair.rightPtr != 0 {
-			if _, ok := seen[pair]; ok {
+			if _, ok := eq.seen[pair]; ok {
 				return true
 			}
-			seen[pair] = struct{}{}
+			eq.seen[pair] = struct{}{}
 		}
 		for key, leftValue := range left {
 			rightValue, ok := right[key]
 			if !ok {
 				return false
 			}
-			if !valuesEqual(leftValue, rightValue, seen) {
+			if !valuesEqual(leftValue, rightValue, eq) {
 				return false
 			}
 		}
@@ -1295,7 +1329,17 @@ func valuesEqual(v, other Value, seen map[valueEqualityPair]struct{}) bool {
 	}
 }
 
-func hashEntriesEqualByDisplayKey(left, right []HashEntry, seen map[valueEqualityPair]struct{}) bool {
+// intEqualsFloat reports whether f holds exactly the integer i. Converting i
+// to float64 would round integers beyond 2^53 and report false matches, so the
+// float is checked for an exact in-range integral value and compared as int64.
+func intEqualsFloat(i int64, f float64) bool {
+	if f != math.Trunc(f) || f < math.MinInt64 || f >= math.MaxInt64 {
+		return false
+	}
+	return int64(f) == i
+}
+
+func hashEntriesEqualByDisplayKey(left, right []HashEntry, eq valueEquality) bool {
 	leftByKey, ok := hashEntriesByDisplayKey(left)
 	if !ok {
 		return false
@@ -1312,7 +1356,7 @@ func hashEntriesEqualByDisplayKey(left, right []HashEntry, seen map[valueEqualit
 		if !ok {
 			return false
 		}
-		if !valuesEqual(leftEntry.Value, rightEntry.Value, seen) {
+		if !valuesEqual(leftEntry.Value, rightEntry.Value, eq) {
 			return false
 		}
 	}
@@ -3,6 +3,7 @@ package value_test
 import (
 	"errors"
 	"math"
+	"math/big"
 	"runtime"
 	"runtime/debug"
 	"strconv"
@@ -222,6 +223,33 @@ func TestHashDataRoundTrip(t *testing.T) {
 	})
 }
 
+func TestSortHashEntriesOrdersNumbersNumerically(t *testing.T) {
+	t.Parallel()
+
+	entries := []value.HashEntry{
+		{Key: value.NewFloat(10.5)},
+		{Key: value.NewInt(3)},
+		{Key: value.NewFloat(-0.5)},
+		{Key: value.NewInt(-10)},
+		{Key: value.NewFloat(-20)},
+		{Key: value.NewInt(-5)},
+		{Key: value.NewFloat(2.25)},
+		{Key: value.NewInt(math.MinInt64)},
+		{Key: value.NewInt(math.MaxInt64)},
+	}
+	value.SortHashEntries(entries)
+
+	want := []value.Value{
+		value.NewInt(math.MinInt64), value.NewInt(-10), value.NewInt(-5), value.NewInt(3), value.NewInt(math.MaxInt64),
+		value.NewFloat(-20), value.NewFloat(-0.5), value.NewFloat(2.25), value.NewFloat(10.5),
+	}
+	for i, entry := range entries {
+		if !entry.Key.Equal(want[i]) || entry.Key.Kind() != want[i].Kind() {
+			t.Fatalf("entry %d key = %s, want %s", i, entry.Key.Inspect(), want[i].Inspect())
+		}
+	}
+}
+
 func TestTypedHashMaterializesLegacyMapLazily(t *testing.T) {
 	t.Parallel()
 
@@ -664,6 +692,61 @@ func TestTypedHashStringCycleDetection(t *testing.T) {
 	}
 }
 
+// TestHashStringSortsKeys pins hash String and Inspect output to sorted key
+// order, independent of insertion order and Go's map iteration.
+func TestHashStringSortsKeys(t *testing.T) {
+	t.Parallel()
+
+	keys := []string{"zeta", "alpha", "mid", "beta", "omega", "delta"}
+	orders := [][]int{{0, 1, 2, 3, 4, 5}, {5, 4, 3, 2, 1, 0}, {2, 0, 5, 1, 3, 4}}
+
+	t.Run("string_keyed", func(t *testing.T) {
+		t.Parallel()
+		const want = "{alpha: 1, beta: 3, delta: 5, mid: 2, omega: 4, zeta: 0}"
+		for _, order := range orders {
+			entries := make(map[string]value.Value)
+			for _, i := range order {
+				entries[keys[i]] = value.NewInt(int64(i))
+			}
+			hash := value.NewHash(entries)
+			for range 10 {
+				if got := hash.String(); got != want {
+					t.Fatalf("String() = %q, want %q", got, want)
+				}
+			}
+		}
+	})
+
+	t.Run("typed_keys", func(t *testing.T) {
+		t.Parallel()
+		const wantString = "{2: int, b: string, a: symbol, c: symbol}"
+		const wantInspect = `{2: "int", "b": "string", a: "symbol", c: "symbol"}`
+		pairs := []struct {
+			key value.Value
+			val string
+		}{
+			{value.NewSymbol("c"), "symbol"},
+			{value.NewString("b"), "string"},
+			{value.NewSymbol("a"), "symbol"},
+			{value.NewInt(2), "int"},
+		}
+		for _, order := range [][]int{{0, 1, 2, 3}, {3, 2, 1, 0}, {1, 3, 0, 2}} {
+			hash := value.NewHash(map[string]value.Value{})
+			for _, i := range order {
+				if err := hash.HashSet(pairs[i].key, value.NewString(pairs[i].val)); err != nil {
+					t.Fatalf("HashSet(%v) error = %v", pairs[i].key, err)
+				}
+			}
+			if got := hash.String(); got != wantString {
+				t.Fatalf("String() = %q, want %q", got, wantString)
+			}
+			if got := hash.Inspect(); got != wantInspect {
+				t.Fatalf("Inspect() = %q, want %q", got, wantInspect)
+			}
+		}
+	})
+}
+
 func requireTypedHashCollisionString(t *testing.T, got string) {
 	t.Helper()
 	if !strings.HasPrefix(got, "{") || !strings.HasSuffix(got, "}") {
@@ -1734,7 +1817,20 @@ func TestValueEqual(t *testing.T) {
 		{"bools", value.NewBool(true), value.NewBool(true), true},
 		{"bool_mismatch", value.NewBool(true), value.NewBool(false), false},
 		{"ints", value.NewInt(7), value.NewInt(7), true},
-		{"int_vs_float_kind_mismatch", value.NewInt(1), value.NewFloat(1), false},
+		{"int_vs_integral_float", value.NewInt(1), value.NewFloat(1), true},
+		{"float_vs_int", value.NewFloat(-3), value.NewInt(-3), true},
+		{"int_vs_fractional_float", value.NewInt(1), value.NewFloat(1.5), false},
+		{"int_vs_nan", value.NewInt(0), value.NewFloat(math.NaN()), false},
+		{"int_vs_infinity", value.NewInt(math.MaxInt64), value.NewFloat(math.Inf(1)), false},
+		// 2^53 + 1 rounds to 2^53 as a float64; equality must stay exact.
+		{"int_beyond_float_precision", value.NewInt(1<<53 + 1), value.NewFloat(1 << 53), false},
+		{"max_int_vs_two_pow_63", value.NewInt(math.MaxInt64), value.NewFloat(math.MaxInt64), false},
+		{"min_int_vs_float", value.NewInt(math.MinInt64), value.NewFloat(math.MinInt64), true},
+		{"int_vs_string_kind_mismatch", value.NewInt(1), value.NewString("1"), false},
+		{"bigints", value.NewBigInt(new(big.Int).Lsh(big.NewInt(1), 70)), value.NewBigInt(new(big.Int).Lsh(big.NewInt(1), 70)), true},
+		{"bigint_vs_int", value.NewBigInt(big.NewInt(7)), value.NewInt(7), true},
+		{"bigint_vs_float", value.NewBigInt(new(big.Int).Lsh(big.NewInt(1), 70)), value.NewFloat(math.Ldexp(1, 70)), true},
+		{"bigint_vs_fractional_float", value.NewBigInt(big.NewInt(7)), value.NewFloat(7.5), false},
 		{"floats", value.NewFloat(2.5), value.NewFloat(2.5), true},
 		{"nan_not_equal", value.NewFloat(math.NaN()), value.NewFloat(math.NaN()), false},
 		{"strings", value.NewString("a"), value.NewString("a"), true},
@@ -1876,6 +1972,8 @@ func TestValueEql(t *testing.T) {
 		{"arrays_by_content", value.NewArray([]value.Value{value.NewInt(1)}), value.NewArray([]value.Value{value.NewInt(1)}), true},
 		{"arrays_shared", value.NewArray(sharedSlice), value.NewArray(sharedSlice), true},
 		{"hashes_by_content", value.NewHash(map[string]value.Value{"a": value.NewInt(1)}), value.NewHash(map[string]value.Value{"a": value.NewInt(1)}), true},
+		{"nested_int_vs_float", value.NewArray([]value.Value{value.NewInt(1)}), value.NewArray([]value.Value{value.NewFloat(1)}), false},
+		{"hash_value_int_vs_float", value.NewHash(map[string]value.Value{"a": value.NewInt(1)}), value.NewHash(map[string]value.Value{"a": value.NewFloat(1)}), false},
 	}
 
 	for _, tc := range tests {